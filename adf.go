@@ -0,0 +1,437 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+)
+
+// These constants are the types of the nodes of an Atlassian Document Format document
+const (
+	ADFNodeTypeDoc         = "doc"
+	ADFNodeTypeParagraph   = "paragraph"
+	ADFNodeTypeText        = "text"
+	ADFNodeTypeHardBreak   = "hardBreak"
+	ADFNodeTypeHeading     = "heading"
+	ADFNodeTypeBulletList  = "bulletList"
+	ADFNodeTypeOrderedList = "orderedList"
+	ADFNodeTypeListItem    = "listItem"
+	ADFNodeTypeCodeBlock   = "codeBlock"
+	ADFNodeTypeBlockquote  = "blockquote"
+	ADFNodeTypeRule        = "rule"
+	ADFNodeTypeMention     = "mention"
+	ADFNodeTypeEmoji       = "emoji"
+	ADFNodeTypeInlineCard  = "inlineCard"
+	ADFNodeTypePanel       = "panel"
+	ADFNodeTypeTable       = "table"
+	ADFNodeTypeTableRow    = "tableRow"
+	ADFNodeTypeTableHeader = "tableHeader"
+	ADFNodeTypeTableCell   = "tableCell"
+)
+
+// These constants are the types of the panels of an Atlassian Document Format document
+const (
+	ADFPanelTypeInfo    = "info"
+	ADFPanelTypeNote    = "note"
+	ADFPanelTypeWarning = "warning"
+	ADFPanelTypeSuccess = "success"
+	ADFPanelTypeError   = "error"
+)
+
+// These constants are the types of the marks which format the text nodes of an Atlassian Document Format document
+const (
+	ADFMarkTypeStrong    = "strong"
+	ADFMarkTypeEm        = "em"
+	ADFMarkTypeCode      = "code"
+	ADFMarkTypeStrike    = "strike"
+	ADFMarkTypeUnderline = "underline"
+	ADFMarkTypeLink      = "link"
+)
+
+// adfVersion is the version of the Atlassian Document Format set on new documents.
+const adfVersion = 1
+
+// ADFNode represents a node of an Atlassian Document Format (ADF) document.
+// The REST API v3 sends and returns rich text like descriptions and comments as ADF documents,
+// which are nodes of the type ADFNodeTypeDoc holding the block nodes in their Content.
+//
+// ADF docs: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+type ADFNode struct {
+	Type    string                 `json:"type" structs:"type"`
+	Version int                    `json:"version,omitempty" structs:"version,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty" structs:"attrs,omitempty"`
+	Content []*ADFNode             `json:"content,omitempty" structs:"content,omitempty"`
+	Text    string                 `json:"text,omitempty" structs:"text,omitempty"`
+	Marks   []*ADFMark             `json:"marks,omitempty" structs:"marks,omitempty"`
+}
+
+// ADFMark represents the formatting of a text node, e.g. bold text or a link.
+type ADFMark struct {
+	Type  string                 `json:"type" structs:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty" structs:"attrs,omitempty"`
+}
+
+// NewADFDocument returns an ADF document holding the given block nodes.
+func NewADFDocument(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFNodeTypeDoc, Version: adfVersion, Content: content}
+}
+
+// NewADFParagraph returns a paragraph holding the given inline nodes.
+func NewADFParagraph(content ...*ADFNode) *ADFNode {
+	return &ADFNode{Type: ADFNodeTypeParagraph, Content: content}
+}
+
+// NewADFText returns a text node formatted by the given marks.
+func NewADFText(text string, marks ...*ADFMark) *ADFNode {
+	return &ADFNode{Type: ADFNodeTypeText, Text: text, Marks: marks}
+}
+
+// NewADFHardBreak returns a line break inside of a paragraph.
+func NewADFHardBreak() *ADFNode {
+	return &ADFNode{Type: ADFNodeTypeHardBreak}
+}
+
+// NewADFMention returns a mention of the user with the given account id.
+// text is shown for the mention, e.g. "@Fred F. User".
+func NewADFMention(accountID, text string) *ADFNode {
+	return &ADFNode{Type: ADFNodeTypeMention, Attrs: map[string]interface{}{"id": accountID, "text": text}}
+}
+
+// NewADFLink returns a text node linking to href.
+func NewADFLink(text, href string) *ADFNode {
+	return NewADFText(text, NewADFLinkMark(href))
+}
+
+// NewADFMark returns a mark of the given type without attributes, e.g. ADFMarkTypeStrong.
+func NewADFMark(markType string) *ADFMark {
+	return &ADFMark{Type: markType}
+}
+
+// NewADFLinkMark returns a mark linking the marked text to href.
+func NewADFLinkMark(href string) *ADFMark {
+	return &ADFMark{Type: ADFMarkTypeLink, Attrs: map[string]interface{}{"href": href}}
+}
+
+// NewADFDocumentFromText returns an ADF document for plain text.
+// Paragraphs are separated by empty lines, single line breaks become hard breaks.
+func NewADFDocumentFromText(text string) *ADFNode {
+	doc := NewADFDocument()
+	for _, block := range strings.Split(text, "\n\n") {
+		paragraph := NewADFParagraph()
+		for i, line := range strings.Split(block, "\n") {
+			if i > 0 {
+				paragraph.Content = append(paragraph.Content, NewADFHardBreak())
+			}
+			if line != "" {
+				paragraph.Content = append(paragraph.Content, NewADFText(line))
+			}
+		}
+		doc.Content = append(doc.Content, paragraph)
+	}
+	return doc
+}
+
+// ADFBuilder builds an ADF document block by block:
+//
+//	doc := jira.NewADFBuilder().
+//		Heading(2, "Deployment").
+//		Paragraph(jira.NewADFText("Deployed by "), jira.NewADFMention(accountID, "@Fred")).
+//		CodeBlock("bash", "make deploy").
+//		Build()
+type ADFBuilder struct {
+	doc *ADFNode
+}
+
+// NewADFBuilder returns a builder for an empty ADF document.
+func NewADFBuilder() *ADFBuilder {
+	return &ADFBuilder{doc: NewADFDocument()}
+}
+
+// Build returns the document.
+func (b *ADFBuilder) Build() *ADFNode {
+	return b.doc
+}
+
+// Node adds the given block node to the document.
+func (b *ADFBuilder) Node(node *ADFNode) *ADFBuilder {
+	b.doc.Content = append(b.doc.Content, node)
+	return b
+}
+
+// Paragraph adds a paragraph holding the given inline nodes.
+func (b *ADFBuilder) Paragraph(content ...*ADFNode) *ADFBuilder {
+	return b.Node(NewADFParagraph(content...))
+}
+
+// Text adds a paragraph of plain text.
+func (b *ADFBuilder) Text(text string) *ADFBuilder {
+	return b.Paragraph(NewADFText(text))
+}
+
+// Heading adds a heading of the given level, from 1 to 6.
+func (b *ADFBuilder) Heading(level int, text string) *ADFBuilder {
+	return b.Node(&ADFNode{
+		Type:    ADFNodeTypeHeading,
+		Attrs:   map[string]interface{}{"level": level},
+		Content: []*ADFNode{NewADFText(text)},
+	})
+}
+
+// CodeBlock adds a block of code. language may be empty.
+func (b *ADFBuilder) CodeBlock(language, code string) *ADFBuilder {
+	node := &ADFNode{Type: ADFNodeTypeCodeBlock, Content: []*ADFNode{NewADFText(code)}}
+	if language != "" {
+		node.Attrs = map[string]interface{}{"language": language}
+	}
+	return b.Node(node)
+}
+
+// Panel adds a panel of the given type holding the given block nodes.
+// panelType is one of the ADFPanelType constants.
+func (b *ADFBuilder) Panel(panelType string, content ...*ADFNode) *ADFBuilder {
+	return b.Node(&ADFNode{
+		Type:    ADFNodeTypePanel,
+		Attrs:   map[string]interface{}{"panelType": panelType},
+		Content: content,
+	})
+}
+
+// Quote adds a block quote holding the given block nodes.
+func (b *ADFBuilder) Quote(content ...*ADFNode) *ADFBuilder {
+	return b.Node(&ADFNode{Type: ADFNodeTypeBlockquote, Content: content})
+}
+
+// BulletList adds a bulleted list with an item for each of the given texts.
+func (b *ADFBuilder) BulletList(items ...string) *ADFBuilder {
+	return b.Node(newADFList(ADFNodeTypeBulletList, items))
+}
+
+// OrderedList adds a numbered list with an item for each of the given texts.
+func (b *ADFBuilder) OrderedList(items ...string) *ADFBuilder {
+	return b.Node(newADFList(ADFNodeTypeOrderedList, items))
+}
+
+// Table adds a table with a header row holding header and a row for each of rows.
+// An empty header adds a table without header row.
+func (b *ADFBuilder) Table(header []string, rows ...[]string) *ADFBuilder {
+	table := &ADFNode{Type: ADFNodeTypeTable}
+	if len(header) > 0 {
+		table.Content = append(table.Content, newADFTableRow(ADFNodeTypeTableHeader, header))
+	}
+	for _, row := range rows {
+		table.Content = append(table.Content, newADFTableRow(ADFNodeTypeTableCell, row))
+	}
+	return b.Node(table)
+}
+
+// Rule adds a horizontal line.
+func (b *ADFBuilder) Rule() *ADFBuilder {
+	return b.Node(&ADFNode{Type: ADFNodeTypeRule})
+}
+
+func newADFList(listType string, items []string) *ADFNode {
+	list := &ADFNode{Type: listType}
+	for _, item := range items {
+		list.Content = append(list.Content, &ADFNode{
+			Type:    ADFNodeTypeListItem,
+			Content: []*ADFNode{NewADFParagraph(NewADFText(item))},
+		})
+	}
+	return list
+}
+
+func newADFTableRow(cellType string, cells []string) *ADFNode {
+	row := &ADFNode{Type: ADFNodeTypeTableRow}
+	for _, cell := range cells {
+		row.Content = append(row.Content, &ADFNode{
+			Type:    cellType,
+			Content: []*ADFNode{NewADFParagraph(NewADFText(cell))},
+		})
+	}
+	return row
+}
+
+// PlainText returns the text of the node and all of its children without any formatting.
+// Block nodes like paragraphs and headings are ended by a line break.
+func (n *ADFNode) PlainText() string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	n.writePlainText(&b)
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (n *ADFNode) writePlainText(b *strings.Builder) {
+	switch n.Type {
+	case ADFNodeTypeText:
+		b.WriteString(n.Text)
+		return
+	case ADFNodeTypeHardBreak:
+		b.WriteString("\n")
+		return
+	case ADFNodeTypeMention, ADFNodeTypeEmoji:
+		b.WriteString(n.stringAttr("text"))
+		return
+	case ADFNodeTypeInlineCard:
+		b.WriteString(n.stringAttr("url"))
+		return
+	case ADFNodeTypeTableRow:
+		// The cells of a row are separated by tabs
+		for i, cell := range n.Content {
+			if i > 0 {
+				b.WriteString("\t")
+			}
+			b.WriteString(strings.Replace(cell.PlainText(), "\n", " ", -1))
+		}
+		b.WriteString("\n")
+		return
+	}
+
+	for _, child := range n.Content {
+		child.writePlainText(b)
+	}
+
+	switch n.Type {
+	case ADFNodeTypeParagraph, ADFNodeTypeHeading, ADFNodeTypeCodeBlock, ADFNodeTypeRule:
+		b.WriteString("\n")
+	}
+}
+
+// Markdown renders the node and all of its children as Markdown.
+// Panels are rendered as block quotes, marks without Markdown equivalent like underline are dropped.
+func (n *ADFNode) Markdown() string {
+	if n == nil {
+		return ""
+	}
+	if n.Type == ADFNodeTypeDoc {
+		return markdownBlocks(n.Content, "\n\n")
+	}
+	return markdownBlock(n)
+}
+
+func markdownBlocks(nodes []*ADFNode, separator string) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		blocks = append(blocks, markdownBlock(node))
+	}
+	return strings.Join(blocks, separator)
+}
+
+func markdownBlock(n *ADFNode) string {
+	switch n.Type {
+	case ADFNodeTypeParagraph:
+		return markdownInline(n.Content)
+	case ADFNodeTypeHeading:
+		level := n.intAttr("level")
+		if level < 1 {
+			level = 1
+		}
+		return strings.Repeat("#", level) + " " + markdownInline(n.Content)
+	case ADFNodeTypeCodeBlock:
+		return "```" + n.stringAttr("language") + "\n" + n.PlainText() + "\n```"
+	case ADFNodeTypeBlockquote, ADFNodeTypePanel:
+		lines := strings.Split(markdownBlocks(n.Content, "\n\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	case ADFNodeTypeBulletList, ADFNodeTypeOrderedList:
+		items := make([]string, 0, len(n.Content))
+		for i, item := range n.Content {
+			marker := "- "
+			if n.Type == ADFNodeTypeOrderedList {
+				marker = fmt.Sprintf("%d. ", i+1)
+			}
+			lines := strings.Split(markdownBlocks(item.Content, "\n"), "\n")
+			for j := 1; j < len(lines); j++ {
+				lines[j] = strings.Repeat(" ", len(marker)) + lines[j]
+			}
+			items = append(items, marker+strings.Join(lines, "\n"))
+		}
+		return strings.Join(items, "\n")
+	case ADFNodeTypeTable:
+		rows := make([]string, 0, len(n.Content)+1)
+		for i, row := range n.Content {
+			cells := make([]string, 0, len(row.Content))
+			for _, cell := range row.Content {
+				text := strings.Replace(markdownBlocks(cell.Content, " "), "|", "\\|", -1)
+				cells = append(cells, strings.Replace(text, "\n", " ", -1))
+			}
+			rows = append(rows, "| "+strings.Join(cells, " | ")+" |")
+			// Markdown tables need a header row, so the first row is always used as header
+			if i == 0 {
+				rows = append(rows, "|"+strings.Repeat(" --- |", len(cells)))
+			}
+		}
+		return strings.Join(rows, "\n")
+	case ADFNodeTypeRule:
+		return "---"
+	}
+	if len(n.Content) > 0 {
+		return markdownBlocks(n.Content, "\n\n")
+	}
+	return markdownInline([]*ADFNode{n})
+}
+
+func markdownInline(nodes []*ADFNode) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Type {
+		case ADFNodeTypeText:
+			b.WriteString(markdownMarks(n.Text, n.Marks))
+		case ADFNodeTypeHardBreak:
+			b.WriteString("  \n")
+		case ADFNodeTypeMention, ADFNodeTypeEmoji:
+			b.WriteString(n.stringAttr("text"))
+		case ADFNodeTypeInlineCard:
+			b.WriteString("<" + n.stringAttr("url") + ">")
+		default:
+			b.WriteString(markdownInline(n.Content))
+		}
+	}
+	return b.String()
+}
+
+// markdownMarks applies the marks to text. Code is applied first and links last,
+// so that e.g. a bold link is rendered as [**text**](href).
+func markdownMarks(text string, marks []*ADFMark) string {
+	var href string
+	for _, markType := range []string{ADFMarkTypeCode, ADFMarkTypeStrong, ADFMarkTypeEm, ADFMarkTypeStrike, ADFMarkTypeLink} {
+		for _, mark := range marks {
+			if mark.Type != markType {
+				continue
+			}
+			switch markType {
+			case ADFMarkTypeCode:
+				text = "`" + text + "`"
+			case ADFMarkTypeStrong:
+				text = "**" + text + "**"
+			case ADFMarkTypeEm:
+				text = "_" + text + "_"
+			case ADFMarkTypeStrike:
+				text = "~~" + text + "~~"
+			case ADFMarkTypeLink:
+				href, _ = mark.Attrs["href"].(string)
+				text = "[" + text + "](" + href + ")"
+			}
+		}
+	}
+	return text
+}
+
+func (n *ADFNode) stringAttr(key string) string {
+	value, _ := n.Attrs[key].(string)
+	return value
+}
+
+// intAttr returns the attribute as int.
+// It is an int for nodes created in Go and a float64 for nodes decoded from JSON.
+func (n *ADFNode) intAttr(key string) int {
+	switch value := n.Attrs[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return 0
+}
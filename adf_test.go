@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestADFNode_PlainText(t *testing.T) {
+	data := []byte(`{
+		"type": "doc",
+		"version": 1,
+		"content": [
+			{"type": "heading", "attrs": {"level": 1}, "content": [{"type": "text", "text": "Outage"}]},
+			{"type": "paragraph", "content": [
+				{"type": "text", "text": "Reported by "},
+				{"type": "mention", "attrs": {"id": "5b10a2844c20165700ede21g", "text": "@Fred"}},
+				{"type": "hardBreak"},
+				{"type": "text", "text": "bold", "marks": [{"type": "strong"}]}
+			]},
+			{"type": "bulletList", "content": [
+				{"type": "listItem", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "first"}]}]},
+				{"type": "listItem", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "second"}]}]}
+			]}
+		]
+	}`)
+	doc := new(ADFNode)
+	if err := json.Unmarshal(data, doc); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	expected := "Outage\nReported by @Fred\nbold\nfirst\nsecond"
+	if text := doc.PlainText(); text != expected {
+		t.Errorf("Expected %q. Got %q", expected, text)
+	}
+	if doc.Content[1].Content[3].Marks[0].Type != ADFMarkTypeStrong {
+		t.Errorf("Expected strong mark. Got %+v", doc.Content[1].Content[3].Marks)
+	}
+}
+
+func TestNewADFDocumentFromText(t *testing.T) {
+	doc := NewADFDocumentFromText("first line\nsecond line\n\nnext paragraph")
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	expected := `{"type":"doc","version":1,"content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"first line"},{"type":"hardBreak"},{"type":"text","text":"second line"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"next paragraph"}]}]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s. Got %s", expected, data)
+	}
+	if text := doc.PlainText(); text != "first line\nsecond line\nnext paragraph" {
+		t.Errorf("Expected the plain text of the document. Got %q", text)
+	}
+}
+
+func TestIssueFields_DescriptionADF(t *testing.T) {
+	fields := new(IssueFields)
+	if err := json.Unmarshal([]byte(`{"description":"wiki *markup*"}`), fields); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fields.Description != "wiki *markup*" || fields.DescriptionADF != nil {
+		t.Errorf("Expected a wiki markup description. Got %q and %+v", fields.Description, fields.DescriptionADF)
+	}
+
+	fields = &IssueFields{Summary: "Outage", DescriptionADF: NewADFDocumentFromText("Rich text")}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if string(data) != `{"summary":"Outage"}` {
+		t.Errorf("Expected no ADF description for the REST API v2. Got %s", data)
+	}
+
+	data, err = json.Marshal((*adfIssueFields)(fields))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	decoded := new(IssueFields)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if decoded.DescriptionADF == nil || decoded.Description != "Rich text" {
+		t.Errorf("Expected an ADF description. Got %s", data)
+	}
+	if _, ok := decoded.Unknowns["description"]; ok {
+		t.Error("Expected description not to be reported as unknown field")
+	}
+}
+
+func TestADFBuilder_Markdown(t *testing.T) {
+	doc := NewADFBuilder().
+		Heading(2, "Deployment").
+		Paragraph(
+			NewADFText("Deployed by "),
+			NewADFMention("5b10a2844c20165700ede21g", "@Fred"),
+			NewADFText(" to "),
+			NewADFText("production", NewADFMark(ADFMarkTypeStrong)),
+			NewADFText(", see "),
+			NewADFLink("the log", "https://ci.example.com/42"),
+		).
+		CodeBlock("bash", "make deploy").
+		Panel(ADFPanelTypeWarning, NewADFParagraph(NewADFText("Check the alerts"))).
+		BulletList("api", "worker").
+		OrderedList("build", "release").
+		Table([]string{"Service", "Status"}, []string{"api", "ok"}, []string{"worker", "failed | retried"}).
+		Rule().
+		Build()
+
+	expected := "## Deployment\n\n" +
+		"Deployed by @Fred to **production**, see [the log](https://ci.example.com/42)\n\n" +
+		"```bash\nmake deploy\n```\n\n" +
+		"> Check the alerts\n\n" +
+		"- api\n- worker\n\n" +
+		"1. build\n2. release\n\n" +
+		"| Service | Status |\n| --- | --- |\n| api | ok |\n| worker | failed \\| retried |\n\n" +
+		"---"
+	if markdown := doc.Markdown(); markdown != expected {
+		t.Errorf("Expected Markdown\n%s\nGot\n%s", expected, markdown)
+	}
+
+	// The document has to survive a round trip through JSON, e.g. the heading level becomes a float64
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	decoded := new(ADFNode)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if markdown := decoded.Markdown(); markdown != expected {
+		t.Errorf("Expected the same Markdown after decoding. Got\n%s", markdown)
+	}
+
+	expectedText := "Deployment\nDeployed by @Fred to production, see the log\nmake deploy\nCheck the alerts\napi\nworker\nbuild\nrelease\n" +
+		"Service\tStatus\napi\tok\nworker\tfailed | retried"
+	if text := doc.PlainText(); text != expectedText {
+		t.Errorf("Expected plain text %q. Got %q", expectedText, text)
+	}
+}
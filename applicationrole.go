@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ApplicationRoleService handles the application roles of the JIRA instance / API,
+// e.g. jira-software, which grant the access to the applications and use up their license seats.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole
+type ApplicationRoleService struct {
+	client *Client
+}
+
+// ApplicationRole represents an application role with its groups and license seats.
+// The users of the Groups have access to the application, new users are added to the DefaultGroups
+// if the role is SelectedByDefault.
+type ApplicationRole struct {
+	Key                  string   `json:"key,omitempty" structs:"key,omitempty"`
+	Name                 string   `json:"name,omitempty" structs:"name,omitempty"`
+	Groups               []string `json:"groups,omitempty" structs:"groups,omitempty"`
+	DefaultGroups        []string `json:"defaultGroups,omitempty" structs:"defaultGroups,omitempty"`
+	SelectedByDefault    bool     `json:"selectedByDefault,omitempty" structs:"selectedByDefault,omitempty"`
+	Defined              bool     `json:"defined,omitempty" structs:"defined,omitempty"`
+	Platform             bool     `json:"platform,omitempty" structs:"platform,omitempty"`
+	NumberOfSeats        int      `json:"numberOfSeats,omitempty" structs:"numberOfSeats,omitempty"`
+	RemainingSeats       int      `json:"remainingSeats,omitempty" structs:"remainingSeats,omitempty"`
+	UserCount            int      `json:"userCount,omitempty" structs:"userCount,omitempty"`
+	UserCountDescription string   `json:"userCountDescription,omitempty" structs:"userCountDescription,omitempty"`
+	HasUnlimitedSeats    bool     `json:"hasUnlimitedSeats,omitempty" structs:"hasUnlimitedSeats,omitempty"`
+}
+
+// ApplicationRoleUpdate holds the groups and flags of an application role to update.
+// Groups and DefaultGroups replace the ones of the role, a nil SelectedByDefault leaves the flag as it is.
+type ApplicationRoleUpdate struct {
+	Groups            []string `json:"groups" structs:"groups"`
+	DefaultGroups     []string `json:"defaultGroups" structs:"defaultGroups"`
+	SelectedByDefault *bool    `json:"selectedByDefault,omitempty" structs:"selectedByDefault,omitempty"`
+}
+
+// GetListWithContext returns all application roles.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole-getAll
+func (s *ApplicationRoleService) GetListWithContext(ctx context.Context) ([]ApplicationRole, *Response, error) {
+	apiEndpoint := "rest/api/2/applicationrole"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []ApplicationRole
+	resp, err := s.client.Do(req, &roles)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return roles, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ApplicationRoleService) GetList() ([]ApplicationRole, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns the application role for a given key, e.g. "jira-software".
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole-get
+func (s *ApplicationRoleService) GetWithContext(ctx context.Context, key string) (*ApplicationRole, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/applicationrole/%s", key)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(ApplicationRole)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ApplicationRoleService) Get(key string) (*ApplicationRole, *Response, error) {
+	return s.GetWithContext(context.Background(), key)
+}
+
+// UpdateWithContext updates the groups, default groups and selected by default flag of an application role.
+// The default groups have to be among the groups of the role. It is only available on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole-put
+func (s *ApplicationRoleService) UpdateWithContext(ctx context.Context, key string, update *ApplicationRoleUpdate) (*ApplicationRole, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/applicationrole/%s", key)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(ApplicationRole)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ApplicationRoleService) Update(key string, update *ApplicationRoleUpdate) (*ApplicationRole, *Response, error) {
+	return s.UpdateWithContext(context.Background(), key, update)
+}
+
+// RemoveGroupWithContext removes a group from the groups and default groups of an application role,
+// so its users no longer use up seats of the application unless they are members of another group of the role.
+func (s *ApplicationRoleService) RemoveGroupWithContext(ctx context.Context, key, group string) (*ApplicationRole, *Response, error) {
+	role, resp, err := s.GetWithContext(ctx, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	update := &ApplicationRoleUpdate{
+		Groups:        withoutString(role.Groups, group),
+		DefaultGroups: withoutString(role.DefaultGroups, group),
+	}
+	return s.UpdateWithContext(ctx, key, update)
+}
+
+// RemoveGroup wraps RemoveGroupWithContext using the background context.
+func (s *ApplicationRoleService) RemoveGroup(key, group string) (*ApplicationRole, *Response, error) {
+	return s.RemoveGroupWithContext(context.Background(), key, group)
+}
+
+// withoutString returns a copy of values without s. The copy is never nil, so it is sent to JIRA as empty list.
+func withoutString(values []string, s string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value != s {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestApplicationRoleService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/applicationrole"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"key":"jira-software","groups":["jira-software-users","jira-testers"],"name":"Jira Software","defaultGroups":["jira-software-users"],
+			"selectedByDefault":false,"defined":false,"numberOfSeats":10,"remainingSeats":5,"userCount":5,"userCountDescription":"5 developers","hasUnlimitedSeats":false,"platform":false},
+			{"key":"jira-core","groups":["jira-core-users"],"name":"Jira Core","platform":true,"hasUnlimitedSeats":true}]`)
+	})
+
+	roles, _, err := testClient.ApplicationRole.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(roles) != 2 || roles[0].RemainingSeats != 5 || len(roles[0].Groups) != 2 || !roles[1].Platform {
+		t.Errorf("Expected 2 application roles. Got %+v", roles)
+	}
+}
+
+func TestApplicationRoleService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/applicationrole/jira-software"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"jira-software","name":"Jira Software","groups":["jira-software-users"],"numberOfSeats":10}`)
+	})
+
+	role, _, err := testClient.ApplicationRole.Get("jira-software")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || role.Name != "Jira Software" || role.NumberOfSeats != 10 {
+		t.Errorf("Expected application role Jira Software. Got %+v", role)
+	}
+}
+
+func TestApplicationRoleService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/applicationrole/jira-software"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"groups":["jira-software-users"],"defaultGroups":["jira-software-users"],"selectedByDefault":true}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"key":"jira-software","groups":["jira-software-users"],"defaultGroups":["jira-software-users"],"selectedByDefault":true}`)
+	})
+
+	selected := true
+	role, _, err := testClient.ApplicationRole.Update("jira-software", &ApplicationRoleUpdate{
+		Groups:            []string{"jira-software-users"},
+		DefaultGroups:     []string{"jira-software-users"},
+		SelectedByDefault: &selected,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || !role.SelectedByDefault {
+		t.Errorf("Expected the role to be selected by default. Got %+v", role)
+	}
+}
+
+func TestApplicationRoleService_RemoveGroup(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/applicationrole/jira-software"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"key":"jira-software","groups":["jira-software-users","all-staff"],"defaultGroups":["all-staff"]}`)
+		case "PUT":
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"groups":["jira-software-users"],"defaultGroups":[]}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			fmt.Fprint(w, `{"key":"jira-software","groups":["jira-software-users"],"defaultGroups":[]}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	role, _, err := testClient.ApplicationRole.RemoveGroup("jira-software", "all-staff")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || len(role.Groups) != 1 {
+		t.Errorf("Expected the group all-staff to be removed. Got %+v", role)
+	}
+}
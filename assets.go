@@ -0,0 +1,499 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// AssetsService handles the objects of Assets (formerly Insight), the CMDB of JIRA Service Management.
+// On JIRA Data Center the API is part of the JIRA instance.
+// On JIRA Cloud it is served by api.atlassian.com for the Assets workspace of the site,
+// whose id is requested from the JIRA instance on first use unless WorkspaceID is set.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/
+type AssetsService struct {
+	client *Client
+
+	// WorkspaceID is the id of the Assets workspace of a JIRA Cloud site.
+	WorkspaceID string
+	workspaceMu sync.Mutex
+}
+
+// assetsCloudAPIURL is the URL of the Assets API of a workspace on JIRA Cloud
+var assetsCloudAPIURL = "https://api.atlassian.com/jsm/assets/workspace/%s/v1"
+
+// AssetsID is the id of an Assets entity, like an object or object type.
+// JIRA Cloud returns the ids as strings and JIRA Data Center as numbers, both are decoded into an AssetsID.
+type AssetsID string
+
+// UnmarshalJSON decodes the id from a string or a number
+func (id *AssetsID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = AssetsID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = AssetsID(n)
+	return nil
+}
+
+// ObjectSchema represents an Assets object schema, which holds object types and their objects.
+type ObjectSchema struct {
+	ID              AssetsID `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string   `json:"name,omitempty" structs:"name,omitempty"`
+	ObjectSchemaKey string   `json:"objectSchemaKey,omitempty" structs:"objectSchemaKey,omitempty"`
+	Description     string   `json:"description,omitempty" structs:"description,omitempty"`
+	Status          string   `json:"status,omitempty" structs:"status,omitempty"`
+	ObjectCount     int      `json:"objectCount,omitempty" structs:"objectCount,omitempty"`
+	ObjectTypeCount int      `json:"objectTypeCount,omitempty" structs:"objectTypeCount,omitempty"`
+}
+
+// ObjectType represents an Assets object type, e.g. "Server", which defines the attributes of its objects.
+type ObjectType struct {
+	ID                 AssetsID `json:"id,omitempty" structs:"id,omitempty"`
+	Name               string   `json:"name,omitempty" structs:"name,omitempty"`
+	Description        string   `json:"description,omitempty" structs:"description,omitempty"`
+	ObjectSchemaID     AssetsID `json:"objectSchemaId,omitempty" structs:"objectSchemaId,omitempty"`
+	ParentObjectTypeID AssetsID `json:"parentObjectTypeId,omitempty" structs:"parentObjectTypeId,omitempty"`
+	ObjectCount        int      `json:"objectCount,omitempty" structs:"objectCount,omitempty"`
+	Inherited          bool     `json:"inherited,omitempty" structs:"inherited,omitempty"`
+	AbstractObjectType bool     `json:"abstractObjectType,omitempty" structs:"abstractObjectType,omitempty"`
+}
+
+// ObjectTypeAttribute represents an attribute of an Assets object type.
+// Type is 0 for a default attribute, e.g. text or integer as given by DefaultType, 1 for a reference to another object,
+// 2 for a user, 4 for a group and 7 for a status.
+type ObjectTypeAttribute struct {
+	ID                    AssetsID                        `json:"id,omitempty" structs:"id,omitempty"`
+	Name                  string                          `json:"name,omitempty" structs:"name,omitempty"`
+	Label                 bool                            `json:"label,omitempty" structs:"label,omitempty"`
+	Type                  int                             `json:"type" structs:"type"`
+	DefaultType           *ObjectTypeAttributeDefaultType `json:"defaultType,omitempty" structs:"defaultType,omitempty"`
+	ReferenceObjectTypeID AssetsID                        `json:"referenceObjectTypeId,omitempty" structs:"referenceObjectTypeId,omitempty"`
+	Editable              bool                            `json:"editable,omitempty" structs:"editable,omitempty"`
+	System                bool                            `json:"system,omitempty" structs:"system,omitempty"`
+	Unique                bool                            `json:"uniqueAttribute,omitempty" structs:"uniqueAttribute,omitempty"`
+	MinimumCardinality    int                             `json:"minimumCardinality,omitempty" structs:"minimumCardinality,omitempty"`
+	MaximumCardinality    int                             `json:"maximumCardinality,omitempty" structs:"maximumCardinality,omitempty"`
+}
+
+// ObjectTypeAttributeDefaultType is the data type of a default attribute, e.g. "Text" or "Integer".
+type ObjectTypeAttributeDefaultType struct {
+	ID   int    `json:"id" structs:"id"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// AssetObject represents an Assets object, e.g. a server in the CMDB.
+type AssetObject struct {
+	ID         AssetsID               `json:"id,omitempty" structs:"id,omitempty"`
+	Label      string                 `json:"label,omitempty" structs:"label,omitempty"`
+	ObjectKey  string                 `json:"objectKey,omitempty" structs:"objectKey,omitempty"`
+	ObjectType *ObjectType            `json:"objectType,omitempty" structs:"objectType,omitempty"`
+	Created    string                 `json:"created,omitempty" structs:"created,omitempty"`
+	Updated    string                 `json:"updated,omitempty" structs:"updated,omitempty"`
+	Attributes []AssetObjectAttribute `json:"attributes,omitempty" structs:"attributes,omitempty"`
+}
+
+// AssetObjectAttribute is the value of an attribute of an object. Attributes can have several values.
+type AssetObjectAttribute struct {
+	ID                    AssetsID                    `json:"id,omitempty" structs:"id,omitempty"`
+	ObjectTypeAttributeID AssetsID                    `json:"objectTypeAttributeId" structs:"objectTypeAttributeId"`
+	ObjectAttributeValues []AssetObjectAttributeValue `json:"objectAttributeValues" structs:"objectAttributeValues"`
+}
+
+// AssetObjectAttributeValue is a single value of an attribute.
+// Value is the value as it is sent to JIRA, e.g. the key of a referenced object, DisplayValue the value as it is shown.
+type AssetObjectAttributeValue struct {
+	Value            interface{}  `json:"value,omitempty" structs:"value,omitempty"`
+	DisplayValue     interface{}  `json:"displayValue,omitempty" structs:"displayValue,omitempty"`
+	ReferencedObject *AssetObject `json:"referencedObject,omitempty" structs:"referencedObject,omitempty"`
+}
+
+// AssetObjectInput holds the object type and attribute values used to create or update an object.
+type AssetObjectInput struct {
+	ObjectTypeID AssetsID               `json:"objectTypeId" structs:"objectTypeId"`
+	Attributes   []AssetObjectAttribute `json:"attributes" structs:"attributes"`
+}
+
+// NewAssetObjectInput returns an AssetObjectInput for an object of the given object type.
+func NewAssetObjectInput(objectTypeID AssetsID) *AssetObjectInput {
+	return &AssetObjectInput{ObjectTypeID: objectTypeID, Attributes: []AssetObjectAttribute{}}
+}
+
+// SetAttribute sets the values of the attribute with the given id, replacing values set before.
+func (i *AssetObjectInput) SetAttribute(attributeID AssetsID, values ...interface{}) *AssetObjectInput {
+	attribute := AssetObjectAttribute{ObjectTypeAttributeID: attributeID, ObjectAttributeValues: []AssetObjectAttributeValue{}}
+	for _, value := range values {
+		attribute.ObjectAttributeValues = append(attribute.ObjectAttributeValues, AssetObjectAttributeValue{Value: value})
+	}
+
+	for n, existing := range i.Attributes {
+		if existing.ObjectTypeAttributeID == attributeID {
+			i.Attributes[n] = attribute
+			return i
+		}
+	}
+	i.Attributes = append(i.Attributes, attribute)
+	return i
+}
+
+// AssetsSearchOptions specifies the optional parameters to the AssetsService.FindObjects
+type AssetsSearchOptions struct {
+	// StartAt is the index of the first object to return. Base index: 0.
+	StartAt int
+	// MaxResults is the maximum number of objects to return per page. Default: 50.
+	MaxResults int
+	// IncludeAttributes specifies whether the attributes of the objects are returned.
+	IncludeAttributes bool
+}
+
+// assetsWorkspaces is the response of the request for the Assets workspace of a JIRA Cloud site
+type assetsWorkspaces struct {
+	Values []struct {
+		WorkspaceID string `json:"workspaceId"`
+	} `json:"values"`
+}
+
+// objectSchemasResult is the list of object schemas, which JIRA Cloud returns in pages and JIRA Data Center at once
+type objectSchemasResult struct {
+	Values        []ObjectSchema `json:"values"`
+	IsLast        bool           `json:"isLast"`
+	ObjectSchemas []ObjectSchema `json:"objectschemas"`
+}
+
+// assetsObjectsResult is a page of the objects found by an AQL query.
+// JIRA Cloud returns StartAt, MaxResults, Total and Values, JIRA Data Center ObjectEntries and TotalFilterCount.
+type assetsObjectsResult struct {
+	StartAt          int           `json:"startAt"`
+	MaxResults       int           `json:"maxResults"`
+	Total            int           `json:"total"`
+	Values           []AssetObject `json:"values"`
+	ObjectEntries    []AssetObject `json:"objectEntries"`
+	TotalFilterCount int           `json:"totalFilterCount"`
+}
+
+// assetsAQLQuery is the request of an AQL search on JIRA Cloud
+type assetsAQLQuery struct {
+	QLQuery string `json:"qlQuery"`
+}
+
+// assetsCloudSearchOptions are the query parameters of an AQL search on JIRA Cloud
+type assetsCloudSearchOptions struct {
+	StartAt           int  `url:"startAt"`
+	MaxResults        int  `url:"maxResults"`
+	IncludeAttributes bool `url:"includeAttributes"`
+}
+
+// assetsServerSearchOptions are the query parameters of an AQL search on JIRA Data Center, which counts pages from 1
+type assetsServerSearchOptions struct {
+	QLQuery           string `url:"qlQuery"`
+	Page              int    `url:"page"`
+	ResultPerPage     int    `url:"resultPerPage"`
+	IncludeAttributes bool   `url:"includeAttributes"`
+}
+
+// GetWorkspaceIDWithContext returns the id of the Assets workspace of the JIRA Cloud site.
+// The id is remembered in WorkspaceID, so it is only requested once.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-assets/#api-rest-servicedeskapi-assets-workspace-get
+func (s *AssetsService) GetWorkspaceIDWithContext(ctx context.Context) (string, *Response, error) {
+	s.workspaceMu.Lock()
+	defer s.workspaceMu.Unlock()
+	if s.WorkspaceID != "" {
+		return s.WorkspaceID, nil, nil
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "rest/servicedeskapi/assets/workspace", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := new(assetsWorkspaces)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	if len(result.Values) == 0 {
+		return "", resp, fmt.Errorf("No Assets workspace found")
+	}
+	s.WorkspaceID = result.Values[0].WorkspaceID
+	return s.WorkspaceID, resp, nil
+}
+
+// GetWorkspaceID wraps GetWorkspaceIDWithContext using the background context.
+func (s *AssetsService) GetWorkspaceID() (string, *Response, error) {
+	return s.GetWorkspaceIDWithContext(context.Background())
+}
+
+// apiEndpoint returns the URL of the given path of the Assets API in the dialect of the client.
+func (s *AssetsService) apiEndpoint(ctx context.Context, format string, a ...interface{}) (string, error) {
+	path := fmt.Sprintf(format, a...)
+	if s.client.dialect(ctx) == DialectServer {
+		return "rest/insight/1.0/" + path, nil
+	}
+
+	workspaceID, _, err := s.GetWorkspaceIDWithContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(assetsCloudAPIURL, workspaceID) + "/" + path, nil
+}
+
+// do sends a request to the given path of the Assets API and decodes the response into v.
+func (s *AssetsService) do(ctx context.Context, method, path string, body, v interface{}) (*Response, error) {
+	apiEndpoint, err := s.apiEndpoint(ctx, "%s", path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetObjectSchemasWithContext returns all object schemas.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-list-get
+func (s *AssetsService) GetObjectSchemasWithContext(ctx context.Context) ([]ObjectSchema, *Response, error) {
+	var schemas []ObjectSchema
+	startAt := 0
+	for {
+		result := new(objectSchemasResult)
+		resp, err := s.do(ctx, "GET", fmt.Sprintf("objectschema/list?startAt=%d", startAt), nil, result)
+		if err != nil {
+			return nil, resp, err
+		}
+		if result.ObjectSchemas != nil {
+			return result.ObjectSchemas, resp, nil
+		}
+
+		schemas = append(schemas, result.Values...)
+		if result.IsLast || len(result.Values) == 0 {
+			return schemas, resp, nil
+		}
+		startAt += len(result.Values)
+	}
+}
+
+// GetObjectSchemas wraps GetObjectSchemasWithContext using the background context.
+func (s *AssetsService) GetObjectSchemas() ([]ObjectSchema, *Response, error) {
+	return s.GetObjectSchemasWithContext(context.Background())
+}
+
+// GetObjectSchemaWithContext returns the object schema for a given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-id-get
+func (s *AssetsService) GetObjectSchemaWithContext(ctx context.Context, schemaID AssetsID) (*ObjectSchema, *Response, error) {
+	schema := new(ObjectSchema)
+	resp, err := s.do(ctx, "GET", fmt.Sprintf("objectschema/%s", schemaID), nil, schema)
+	if err != nil {
+		return nil, resp, err
+	}
+	return schema, resp, nil
+}
+
+// GetObjectSchema wraps GetObjectSchemaWithContext using the background context.
+func (s *AssetsService) GetObjectSchema(schemaID AssetsID) (*ObjectSchema, *Response, error) {
+	return s.GetObjectSchemaWithContext(context.Background(), schemaID)
+}
+
+// GetObjectTypesWithContext returns all object types of an object schema.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-id-objecttypes-flat-get
+func (s *AssetsService) GetObjectTypesWithContext(ctx context.Context, schemaID AssetsID) ([]ObjectType, *Response, error) {
+	var objectTypes []ObjectType
+	resp, err := s.do(ctx, "GET", fmt.Sprintf("objectschema/%s/objecttypes/flat", schemaID), nil, &objectTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return objectTypes, resp, nil
+}
+
+// GetObjectTypes wraps GetObjectTypesWithContext using the background context.
+func (s *AssetsService) GetObjectTypes(schemaID AssetsID) ([]ObjectType, *Response, error) {
+	return s.GetObjectTypesWithContext(context.Background(), schemaID)
+}
+
+// GetObjectTypeWithContext returns the object type for a given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objecttype/#api-objecttype-id-get
+func (s *AssetsService) GetObjectTypeWithContext(ctx context.Context, objectTypeID AssetsID) (*ObjectType, *Response, error) {
+	objectType := new(ObjectType)
+	resp, err := s.do(ctx, "GET", fmt.Sprintf("objecttype/%s", objectTypeID), nil, objectType)
+	if err != nil {
+		return nil, resp, err
+	}
+	return objectType, resp, nil
+}
+
+// GetObjectType wraps GetObjectTypeWithContext using the background context.
+func (s *AssetsService) GetObjectType(objectTypeID AssetsID) (*ObjectType, *Response, error) {
+	return s.GetObjectTypeWithContext(context.Background(), objectTypeID)
+}
+
+// GetObjectTypeAttributesWithContext returns the attributes of an object type.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objecttype/#api-objecttype-id-attributes-get
+func (s *AssetsService) GetObjectTypeAttributesWithContext(ctx context.Context, objectTypeID AssetsID) ([]ObjectTypeAttribute, *Response, error) {
+	var attributes []ObjectTypeAttribute
+	resp, err := s.do(ctx, "GET", fmt.Sprintf("objecttype/%s/attributes", objectTypeID), nil, &attributes)
+	if err != nil {
+		return nil, resp, err
+	}
+	return attributes, resp, nil
+}
+
+// GetObjectTypeAttributes wraps GetObjectTypeAttributesWithContext using the background context.
+func (s *AssetsService) GetObjectTypeAttributes(objectTypeID AssetsID) ([]ObjectTypeAttribute, *Response, error) {
+	return s.GetObjectTypeAttributesWithContext(context.Background(), objectTypeID)
+}
+
+// GetObjectWithContext returns the object for a given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-get
+func (s *AssetsService) GetObjectWithContext(ctx context.Context, objectID AssetsID) (*AssetObject, *Response, error) {
+	object := new(AssetObject)
+	resp, err := s.do(ctx, "GET", fmt.Sprintf("object/%s", objectID), nil, object)
+	if err != nil {
+		return nil, resp, err
+	}
+	return object, resp, nil
+}
+
+// GetObject wraps GetObjectWithContext using the background context.
+func (s *AssetsService) GetObject(objectID AssetsID) (*AssetObject, *Response, error) {
+	return s.GetObjectWithContext(context.Background(), objectID)
+}
+
+// CreateObjectWithContext creates an object.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-create-post
+func (s *AssetsService) CreateObjectWithContext(ctx context.Context, input *AssetObjectInput) (*AssetObject, *Response, error) {
+	object := new(AssetObject)
+	resp, err := s.do(ctx, "POST", "object/create", input, object)
+	if err != nil {
+		return nil, resp, err
+	}
+	return object, resp, nil
+}
+
+// CreateObject wraps CreateObjectWithContext using the background context.
+func (s *AssetsService) CreateObject(input *AssetObjectInput) (*AssetObject, *Response, error) {
+	return s.CreateObjectWithContext(context.Background(), input)
+}
+
+// UpdateObjectWithContext updates the attributes of an object given in the input. Other attributes are left as they are.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-put
+func (s *AssetsService) UpdateObjectWithContext(ctx context.Context, objectID AssetsID, input *AssetObjectInput) (*AssetObject, *Response, error) {
+	object := new(AssetObject)
+	resp, err := s.do(ctx, "PUT", fmt.Sprintf("object/%s", objectID), input, object)
+	if err != nil {
+		return nil, resp, err
+	}
+	return object, resp, nil
+}
+
+// UpdateObject wraps UpdateObjectWithContext using the background context.
+func (s *AssetsService) UpdateObject(objectID AssetsID, input *AssetObjectInput) (*AssetObject, *Response, error) {
+	return s.UpdateObjectWithContext(context.Background(), objectID, input)
+}
+
+// DeleteObjectWithContext deletes an object.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-delete
+func (s *AssetsService) DeleteObjectWithContext(ctx context.Context, objectID AssetsID) (*Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("object/%s", objectID), nil, nil)
+}
+
+// DeleteObject wraps DeleteObjectWithContext using the background context.
+func (s *AssetsService) DeleteObject(objectID AssetsID) (*Response, error) {
+	return s.DeleteObjectWithContext(context.Background(), objectID)
+}
+
+// FindObjectsWithContext returns a page of the objects matching the given AQL (Assets Query Language) query,
+// e.g. `objectType = "Server" AND Name = "web-01"`.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-aql-post
+func (s *AssetsService) FindObjectsWithContext(ctx context.Context, aql string, options *AssetsSearchOptions) ([]AssetObject, *Response, error) {
+	opt := AssetsSearchOptions{}
+	if options != nil {
+		opt = *options
+	}
+	if opt.MaxResults <= 0 {
+		opt.MaxResults = defaultMaxResults
+	}
+
+	result := new(assetsObjectsResult)
+	if s.client.dialect(ctx) != DialectServer {
+		path, err := addOptions("object/aql", &assetsCloudSearchOptions{StartAt: opt.StartAt, MaxResults: opt.MaxResults, IncludeAttributes: opt.IncludeAttributes})
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := s.do(ctx, "POST", path, &assetsAQLQuery{QLQuery: aql}, result)
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.Values, resp, nil
+	}
+
+	path, err := addOptions("aql/objects", &assetsServerSearchOptions{
+		QLQuery:           aql,
+		Page:              opt.StartAt/opt.MaxResults + 1,
+		ResultPerPage:     opt.MaxResults,
+		IncludeAttributes: opt.IncludeAttributes,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.do(ctx, "GET", path, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.StartAt = opt.StartAt
+	resp.MaxResults = opt.MaxResults
+	resp.Total = result.TotalFilterCount
+	return result.ObjectEntries, resp, nil
+}
+
+// FindObjects wraps FindObjectsWithContext using the background context.
+func (s *AssetsService) FindObjects(aql string, options *AssetsSearchOptions) ([]AssetObject, *Response, error) {
+	return s.FindObjectsWithContext(context.Background(), aql, options)
+}
+
+// UpsertObjectWithContext updates the object matching the given AQL query, e.g. the server with a given name,
+// or creates it if no object matches. It returns an error if more than one object matches.
+// This lets sync jobs keep objects up to date with their source.
+func (s *AssetsService) UpsertObjectWithContext(ctx context.Context, aql string, input *AssetObjectInput) (*AssetObject, *Response, error) {
+	objects, resp, err := s.FindObjectsWithContext(ctx, aql, &AssetsSearchOptions{MaxResults: 2})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	switch len(objects) {
+	case 0:
+		return s.CreateObjectWithContext(ctx, input)
+	case 1:
+		return s.UpdateObjectWithContext(ctx, objects[0].ID, input)
+	}
+	return nil, resp, fmt.Errorf("More than one object matches %q", aql)
+}
+
+// UpsertObject wraps UpsertObjectWithContext using the background context.
+func (s *AssetsService) UpsertObject(aql string, input *AssetObjectInput) (*AssetObject, *Response, error) {
+	return s.UpsertObjectWithContext(context.Background(), aql, input)
+}
@@ -0,0 +1,278 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+// setupAssetsCloud points the Assets API of JIRA Cloud to the test server
+func setupAssetsCloud() {
+	setup()
+	testClient.Dialect = DialectCloud
+	assetsCloudAPIURL = testServer.URL + "/jsm/assets/workspace/%s/v1"
+}
+
+// teardownAssetsCloud restores the URL of the Assets API of JIRA Cloud
+func teardownAssetsCloud() {
+	assetsCloudAPIURL = "https://api.atlassian.com/jsm/assets/workspace/%s/v1"
+	teardown()
+}
+
+func TestAssetsService_GetWorkspaceID(t *testing.T) {
+	setupAssetsCloud()
+	defer teardownAssetsCloud()
+	requests := 0
+	testMux.HandleFunc("/rest/servicedeskapi/assets/workspace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"workspaceId":"g2778e1d-939d-581d-c8e2-9d5g59de456b"}]}`)
+	})
+	testMux.HandleFunc("/jsm/assets/workspace/g2778e1d-939d-581d-c8e2-9d5g59de456b/v1/objectschema/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"3","name":"IT Assets","objectSchemaKey":"ITA","objectCount":95,"objectTypeCount":34}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		schema, _, err := testClient.Assets.GetObjectSchema("3")
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if schema == nil || schema.ObjectSchemaKey != "ITA" {
+			t.Errorf("Expected object schema ITA. Got %+v", schema)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the workspace to be requested once. Got %d requests", requests)
+	}
+}
+
+func TestAssetsService_GetObjectSchemas(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testMux.HandleFunc("/rest/insight/1.0/objectschema/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"objectschemas":[{"id":1,"name":"IT Assets","objectSchemaKey":"ITA"},{"id":2,"name":"HR","objectSchemaKey":"HR"}]}`)
+	})
+
+	schemas, _, err := testClient.Assets.GetObjectSchemas()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemas) != 2 || schemas[1].ID != "2" {
+		t.Errorf("Expected 2 object schemas with numeric ids. Got %+v", schemas)
+	}
+}
+
+func TestAssetsService_GetObjectSchemas_Cloud(t *testing.T) {
+	setupAssetsCloud()
+	defer teardownAssetsCloud()
+	testClient.Assets.WorkspaceID = "ws"
+	testMux.HandleFunc("/jsm/assets/workspace/ws/v1/objectschema/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"1","name":"IT Assets"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"2","name":"HR"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	schemas, _, err := testClient.Assets.GetObjectSchemas()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemas) != 2 || schemas[1].Name != "HR" {
+		t.Errorf("Expected the object schemas of both pages. Got %+v", schemas)
+	}
+}
+
+func TestAssetsService_GetObjectTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testMux.HandleFunc("/rest/insight/1.0/objectschema/1/objecttypes/flat", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":23,"name":"Server","objectSchemaId":1,"objectCount":12},{"id":24,"name":"Host","objectSchemaId":1,"parentObjectTypeId":23,"inherited":true}]`)
+	})
+	testMux.HandleFunc("/rest/insight/1.0/objecttype/23/attributes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":134,"name":"Key","type":0,"defaultType":{"id":0,"name":"Text"},"system":true},
+			{"id":135,"name":"Name","label":true,"type":0,"defaultType":{"id":0,"name":"Text"},"editable":true,"uniqueAttribute":true},
+			{"id":140,"name":"Owner","type":2,"maximumCardinality":-1}]`)
+	})
+
+	objectTypes, _, err := testClient.Assets.GetObjectTypes("1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(objectTypes) != 2 || objectTypes[1].ParentObjectTypeID != "23" {
+		t.Errorf("Expected 2 object types. Got %+v", objectTypes)
+	}
+
+	attributes, _, err := testClient.Assets.GetObjectTypeAttributes("23")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(attributes) != 3 || !attributes[1].Label || !attributes[1].Unique || attributes[2].Type != 2 {
+		t.Errorf("Expected 3 attributes with the label Name. Got %+v", attributes)
+	}
+}
+
+func TestAssetsService_FindObjects(t *testing.T) {
+	setupAssetsCloud()
+	defer teardownAssetsCloud()
+	testClient.Assets.WorkspaceID = "ws"
+	testAPIEndpoint := "/jsm/assets/workspace/ws/v1/object/aql"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint+"?includeAttributes=true&maxResults=10&startAt=0")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"qlQuery":"objectType = \"Server\""}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":10,"total":12,"isLast":false,"values":[
+			{"id":"88","label":"web-01","objectKey":"ITA-88","objectType":{"id":"23","name":"Server"},
+				"attributes":[{"id":"637","objectTypeAttributeId":"135","objectAttributeValues":[{"value":"web-01","displayValue":"web-01"}]}]}]}`)
+	})
+
+	objects, resp, err := testClient.Assets.FindObjects(`objectType = "Server"`, &AssetsSearchOptions{MaxResults: 10, IncludeAttributes: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(objects) != 1 || objects[0].ObjectKey != "ITA-88" || objects[0].Attributes[0].ObjectAttributeValues[0].Value != "web-01" {
+		t.Errorf("Expected object ITA-88. Got %+v", objects)
+	}
+	if resp.Total != 12 {
+		t.Errorf("Expected total 12. Got %d", resp.Total)
+	}
+}
+
+func TestAssetsService_FindObjects_Server(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testAPIEndpoint := "/rest/insight/1.0/aql/objects"
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?includeAttributes=false&page=3&qlQuery=Name+%3D+web-01&resultPerPage=10")
+		fmt.Fprint(w, `{"objectEntries":[{"id":88,"label":"web-01","objectKey":"ITA-88"}],"totalFilterCount":21,"pageSize":3}`)
+	})
+
+	objects, resp, err := testClient.Assets.FindObjects("Name = web-01", &AssetsSearchOptions{StartAt: 20, MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(objects) != 1 || objects[0].ID != "88" {
+		t.Errorf("Expected object 88. Got %+v", objects)
+	}
+	if resp.StartAt != 20 || resp.MaxResults != 10 || resp.Total != 21 {
+		t.Errorf("Expected the page 20-30 of 21 objects. Got %d, %d and %d", resp.StartAt, resp.MaxResults, resp.Total)
+	}
+}
+
+func TestAssetsService_Objects(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testMux.HandleFunc("/rest/insight/1.0/object/create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"objectTypeId":"23","attributes":[{"objectTypeAttributeId":"135","objectAttributeValues":[{"value":"web-02"}]},` +
+			`{"objectTypeAttributeId":"141","objectAttributeValues":[{"value":"10.0.0.1"},{"value":"10.0.0.2"}]}]}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":89,"label":"web-02","objectKey":"ITA-89"}`)
+	})
+	testMux.HandleFunc("/rest/insight/1.0/object/89", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET", "PUT":
+			fmt.Fprint(w, `{"id":89,"label":"web-02","objectKey":"ITA-89"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	input := NewAssetObjectInput("23").
+		SetAttribute("135", "web-01").
+		SetAttribute("141", "10.0.0.1", "10.0.0.2").
+		SetAttribute("135", "web-02")
+	object, _, err := testClient.Assets.CreateObject(input)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if object == nil || object.ID != "89" {
+		t.Errorf("Expected object 89. Got %+v", object)
+	}
+
+	if object, _, err = testClient.Assets.GetObject("89"); err != nil || object.ObjectKey != "ITA-89" {
+		t.Errorf("Expected object ITA-89. Got %+v and error %v", object, err)
+	}
+	if object, _, err = testClient.Assets.UpdateObject("89", input); err != nil || object.ObjectKey != "ITA-89" {
+		t.Errorf("Expected object ITA-89. Got %+v and error %v", object, err)
+	}
+	if _, err = testClient.Assets.DeleteObject("89"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAssetsService_UpsertObject(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	found := `[]`
+	var method string
+	testMux.HandleFunc("/rest/insight/1.0/aql/objects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"objectEntries":%s,"totalFilterCount":0}`, found)
+	})
+	testMux.HandleFunc("/rest/insight/1.0/object/create", func(w http.ResponseWriter, r *http.Request) {
+		method = "create"
+		fmt.Fprint(w, `{"id":90}`)
+	})
+	testMux.HandleFunc("/rest/insight/1.0/object/88", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		method = "update"
+		fmt.Fprint(w, `{"id":88}`)
+	})
+
+	input := NewAssetObjectInput("23").SetAttribute("135", "web-01")
+	for _, test := range []struct {
+		found    []AssetObject
+		method   string
+		expected AssetsID
+	}{
+		{nil, "create", "90"},
+		{[]AssetObject{{ID: "88"}}, "update", "88"},
+		{[]AssetObject{{ID: "88"}, {ID: "91"}}, "", ""},
+	} {
+		data, _ := json.Marshal(test.found)
+		found, method = string(data), ""
+		object, _, err := testClient.Assets.UpsertObject(`Name = "web-01"`, input)
+		if method != test.method {
+			t.Errorf("Expected %q for %d objects found. Got %q", test.method, len(test.found), method)
+		}
+		if test.expected == "" {
+			if err == nil {
+				t.Error("Expected an error if several objects match")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if object == nil || object.ID != test.expected {
+			t.Errorf("Expected object %s. Got %+v", test.expected, object)
+		}
+	}
+}
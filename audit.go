@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"time"
+)
+
+// AuditService handles the audit records of the JIRA instance / API.
+// The current user has to be a JIRA administrator.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/auditing
+type AuditService struct {
+	client *Client
+}
+
+// AuditRecord represents a change recorded in the audit log, e.g. a user added to a group.
+type AuditRecord struct {
+	ID              int64                 `json:"id,omitempty" structs:"id,omitempty"`
+	Summary         string                `json:"summary,omitempty" structs:"summary,omitempty"`
+	RemoteAddress   string                `json:"remoteAddress,omitempty" structs:"remoteAddress,omitempty"`
+	AuthorKey       string                `json:"authorKey,omitempty" structs:"authorKey,omitempty"`
+	AuthorAccountID string                `json:"authorAccountId,omitempty" structs:"authorAccountId,omitempty"`
+	Created         *Time                 `json:"created,omitempty" structs:"created,omitempty"`
+	Category        string                `json:"category,omitempty" structs:"category,omitempty"`
+	EventSource     string                `json:"eventSource,omitempty" structs:"eventSource,omitempty"`
+	Description     string                `json:"description,omitempty" structs:"description,omitempty"`
+	ObjectItem      *AuditAssociatedItem  `json:"objectItem,omitempty" structs:"objectItem,omitempty"`
+	ChangedValues   []AuditChangedValue   `json:"changedValues,omitempty" structs:"changedValues,omitempty"`
+	AssociatedItems []AuditAssociatedItem `json:"associatedItems,omitempty" structs:"associatedItems,omitempty"`
+}
+
+// AuditAssociatedItem is an entity an audit record is about, e.g. a user or a group.
+type AuditAssociatedItem struct {
+	ID         string `json:"id,omitempty" structs:"id,omitempty"`
+	Name       string `json:"name,omitempty" structs:"name,omitempty"`
+	TypeName   string `json:"typeName,omitempty" structs:"typeName,omitempty"`
+	ParentID   string `json:"parentId,omitempty" structs:"parentId,omitempty"`
+	ParentName string `json:"parentName,omitempty" structs:"parentName,omitempty"`
+}
+
+// AuditChangedValue is a value changed by the change an audit record is about.
+type AuditChangedValue struct {
+	FieldName   string `json:"fieldName,omitempty" structs:"fieldName,omitempty"`
+	ChangedFrom string `json:"changedFrom,omitempty" structs:"changedFrom,omitempty"`
+	ChangedTo   string `json:"changedTo,omitempty" structs:"changedTo,omitempty"`
+}
+
+// AuditRecordOptions specifies the optional parameters to the AuditService.GetRecords
+type AuditRecordOptions struct {
+	// Offset is the index of the first record to return. Base index: 0.
+	Offset int `url:"offset,omitempty"`
+	// Limit is the maximum number of records to return per page. Default: 1000.
+	Limit int `url:"limit,omitempty"`
+	// Filter is a text the records have to contain, e.g. in their summary, category or author.
+	Filter string `url:"filter,omitempty"`
+	// From restricts the records to the ones created at or after the time.
+	From time.Time `url:"from,omitempty"`
+	// To restricts the records to the ones created at or before the time.
+	To time.Time `url:"to,omitempty"`
+	// Category restricts the records to the ones of the category, e.g. "user management".
+	// JIRA cannot filter by category, so the records are filtered after they were fetched.
+	Category string `url:"-"`
+}
+
+// auditRecordsResult is a single page of audit records
+type auditRecordsResult struct {
+	Offset  int           `json:"offset" structs:"offset"`
+	Limit   int           `json:"limit" structs:"limit"`
+	Total   int           `json:"total" structs:"total"`
+	Records []AuditRecord `json:"records" structs:"records"`
+}
+
+// GetRecordsWithContext returns a page of the audit records, the most recent first.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched,
+// before the records were filtered by the Category of the options.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/auditing-getRecords
+func (s *AuditService) GetRecordsWithContext(ctx context.Context, options *AuditRecordOptions) ([]AuditRecord, *Response, error) {
+	result, resp, err := s.getRecords(ctx, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var records []AuditRecord
+	for _, record := range result.Records {
+		if options.matches(&record) {
+			records = append(records, record)
+		}
+	}
+	return records, resp, nil
+}
+
+// GetRecords wraps GetRecordsWithContext using the background context.
+func (s *AuditService) GetRecords(options *AuditRecordOptions) ([]AuditRecord, *Response, error) {
+	return s.GetRecordsWithContext(context.Background(), options)
+}
+
+// GetRecordsPagesWithContext calls f for the audit records of all pages, e.g. to ship them to a SIEM.
+// It stops at the first error returned by f.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/auditing-getRecords
+func (s *AuditService) GetRecordsPagesWithContext(ctx context.Context, options *AuditRecordOptions, f func(AuditRecord) error) error {
+	opt := AuditRecordOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	return fetchPages(opt.Offset, opt.Limit, func(startAt, maxResults int) (*Response, int, error) {
+		opt.Offset = startAt
+		opt.Limit = maxResults
+		result, resp, err := s.getRecords(ctx, &opt)
+		if err != nil {
+			return resp, 0, err
+		}
+		for _, record := range result.Records {
+			if !opt.matches(&record) {
+				continue
+			}
+			if err := f(record); err != nil {
+				return resp, 0, err
+			}
+		}
+		return resp, len(result.Records), nil
+	})
+}
+
+// GetRecordsPages wraps GetRecordsPagesWithContext using the background context.
+func (s *AuditService) GetRecordsPages(options *AuditRecordOptions, f func(AuditRecord) error) error {
+	return s.GetRecordsPagesWithContext(context.Background(), options, f)
+}
+
+// getRecords requests a page of the audit records, which are not filtered by category.
+func (s *AuditService) getRecords(ctx context.Context, options *AuditRecordOptions) (*auditRecordsResult, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/auditing/record", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(auditRecordsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// matches reports whether the record is of the category of the options, if any.
+func (o *AuditRecordOptions) matches(record *AuditRecord) bool {
+	return o == nil || o.Category == "" || o.Category == record.Category
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAuditService_GetRecords(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/auditing/record"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?filter=admin&from=2020-03-01T00%3A00%3A00Z&limit=2&to=2020-03-02T00%3A00%3A00Z")
+		fmt.Fprint(w, `{"offset":0,"limit":2,"total":3,"records":[
+			{"id":1,"summary":"User added to group","remoteAddress":"192.168.1.1","authorKey":"admin","created":"2020-03-01T18:45:42.967+0000",
+				"category":"group management","eventSource":"JIRA Connect Plugin",
+				"objectItem":{"id":"jira-administrators","name":"jira-administrators","typeName":"GROUP"},
+				"associatedItems":[{"id":"fred","name":"fred","typeName":"USER","parentId":"1","parentName":"JIRA Internal Directory"}]},
+			{"id":2,"summary":"User updated","authorKey":"admin","created":"2020-03-01T19:00:00.000+0000","category":"user management",
+				"changedValues":[{"fieldName":"email","changedFrom":"fred@example.com","changedTo":"fred@example.org"}]}]}`)
+	})
+
+	records, resp, err := testClient.Audit.GetRecords(&AuditRecordOptions{
+		Filter: "admin",
+		From:   time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC),
+		Limit:  2,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(records) != 2 || records[0].ObjectItem.TypeName != "GROUP" || records[1].ChangedValues[0].ChangedTo != "fred@example.org" {
+		t.Errorf("Expected 2 records. Got %+v", records)
+	}
+	if created := time.Time(*records[0].Created); created.Hour() != 18 {
+		t.Errorf("Expected the record to be created at 18:45. Got %v", created)
+	}
+	if resp.MaxResults != 2 || resp.Total != 3 {
+		t.Errorf("Expected a page of 2 of 3 records. Got %d and %d", resp.MaxResults, resp.Total)
+	}
+}
+
+func TestAuditService_GetRecordsPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/auditing/record"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"offset":0,"limit":2,"total":3,"records":[{"id":1,"category":"user management"},{"id":2,"category":"group management"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"offset":2,"limit":2,"total":3,"records":[{"id":3,"category":"user management"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var ids []int64
+	err := testClient.Audit.GetRecordsPages(&AuditRecordOptions{Limit: 2, Category: "user management"}, func(record AuditRecord) error {
+		ids = append(ids, record.ID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Errorf("Expected the user management records of both pages. Got %v", ids)
+	}
+}
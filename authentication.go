@@ -1,10 +1,13 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -47,16 +50,17 @@ type Session struct {
 	Cookies []*http.Cookie
 }
 
-// AcquireSessionCookie creates a new session for a user in JIRA.
+// AcquireSessionCookieWithContext creates a new session for a user in JIRA.
 // Once a session has been successfully created it can be used to access any of JIRA's remote APIs and also the web UI by passing the appropriate HTTP Cookie header.
 // The header will by automatically applied to every API request.
 // Note that it is generally preferrable to use HTTP BASIC authentication with the REST API.
 // However, this resource may be used to mimic the behaviour of JIRA's log-in page (e.g. to display log-in errors to a user).
+// If JIRA refuses the login, the cause of the error is a JIRA Error, e.g. IsCaptchaRequired reports whether the user has to solve a CAPTCHA.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 //
 // Deprecated: Use CookieAuthTransport instead
-func (s *AuthenticationService) AcquireSessionCookie(username, password string) (bool, error) {
+func (s *AuthenticationService) AcquireSessionCookieWithContext(ctx context.Context, username, password string) (bool, error) {
 	apiEndpoint := "rest/auth/1/session"
 	body := struct {
 		Username string `json:"username"`
@@ -66,7 +70,7 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 		password,
 	}
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, body)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, body)
 	if err != nil {
 		return false, err
 	}
@@ -79,10 +83,13 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 	}
 
 	if err != nil {
-		return false, fmt.Errorf("Auth at JIRA instance failed (HTTP(S) request). %s", err)
+		return false, errors.Wrap(NewJiraError(resp, err), "Auth at JIRA instance failed (HTTP(S) request)")
 	}
 	if resp != nil && resp.StatusCode != 200 {
-		return false, fmt.Errorf("Auth at JIRA instance failed (HTTP(S) request). Status code: %d", resp.StatusCode)
+		return false, &Error{
+			HTTPError:      fmt.Errorf("Auth at JIRA instance failed (HTTP(S) request). Status code: %d", resp.StatusCode),
+			HTTPStatusCode: resp.StatusCode,
+		}
 	}
 
 	s.client.session = session
@@ -91,6 +98,11 @@ func (s *AuthenticationService) AcquireSessionCookie(username, password string)
 	return true, nil
 }
 
+// AcquireSessionCookie wraps AcquireSessionCookieWithContext using the background context.
+func (s *AuthenticationService) AcquireSessionCookie(username, password string) (bool, error) {
+	return s.AcquireSessionCookieWithContext(context.Background(), username, password)
+}
+
 // SetBasicAuth sets username and password for the basic auth against the JIRA instance.
 //
 // Deprecated: Use BasicAuthTransport instead
@@ -113,19 +125,19 @@ func (s *AuthenticationService) Authenticated() bool {
 	return false
 }
 
-// Logout logs out the current user that has been authenticated and the session in the client is destroyed.
+// LogoutWithContext logs out the current user that has been authenticated and the session in the client is destroyed.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 //
 // Deprecated: Use CookieAuthTransport to create base client.  Logging out is as simple as not using the
 // client anymore
-func (s *AuthenticationService) Logout() error {
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context) error {
 	if s.authType != authTypeSession || s.client.session == nil {
 		return fmt.Errorf("no user is authenticated")
 	}
 
 	apiEndpoint := "rest/auth/1/session"
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return fmt.Errorf("Creating the request to log the user out failed : %s", err)
 	}
@@ -145,10 +157,15 @@ func (s *AuthenticationService) Logout() error {
 
 }
 
-// GetCurrentUser gets the details of the current user.
+// Logout wraps LogoutWithContext using the background context.
+func (s *AuthenticationService) Logout() error {
+	return s.LogoutWithContext(context.Background())
+}
+
+// GetCurrentUserWithContext gets the details of the current user.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
-func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
+func (s *AuthenticationService) GetCurrentUserWithContext(ctx context.Context) (*Session, error) {
 	if s == nil {
 		return nil, fmt.Errorf("AUthenticaiton Service is not instantiated")
 	}
@@ -157,7 +174,7 @@ func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
 	}
 
 	apiEndpoint := "rest/auth/1/session"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("Could not create request for getting user info : %s", err)
 	}
@@ -185,3 +202,8 @@ func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
 
 	return ret, nil
 }
+
+// GetCurrentUser wraps GetCurrentUserWithContext using the background context.
+func (s *AuthenticationService) GetCurrentUser() (*Session, error) {
+	return s.GetCurrentUserWithContext(context.Background())
+}
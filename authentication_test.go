@@ -43,6 +43,29 @@ func TestAuthenticationService_AcquireSessionCookie_Failure(t *testing.T) {
 	}
 }
 
+func TestAuthenticationService_AcquireSessionCookie_CaptchaRequired(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATION_DENIED")
+		w.Header().Set("X-Authentication-Denied-Reason", "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["Login denied"],"errors":{}}`)
+	})
+
+	res, err := testClient.Authentication.AcquireSessionCookie("foo", "bar")
+	if res {
+		t.Error("Expected error, but result was true")
+	}
+	if !IsCaptchaRequired(err) || !IsForbidden(err) {
+		t.Errorf("Expected a forbidden error requiring a CAPTCHA. Got %v", err)
+	}
+	if testClient.Authentication.Authenticated() {
+		t.Error("Expected false, but result was true")
+	}
+}
+
 func TestAuthenticationService_AcquireSessionCookie_Success(t *testing.T) {
 	setup()
 	defer teardown()
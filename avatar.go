@@ -0,0 +1,335 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// AvatarService handles the avatars of projects and users for the JIRA instance / API.
+// A custom avatar is uploaded as temporary avatar first, then cropped to a square to create the avatar,
+// which can then be assigned. UploadProjectAvatar and UploadUserAvatar run all three steps.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/avatar
+type AvatarService struct {
+	client *Client
+}
+
+// These constants are the types of avatars
+const (
+	AvatarTypeProject   = "project"
+	AvatarTypeUser      = "user"
+	AvatarTypeIssueType = "issuetype"
+)
+
+// Avatar represents an avatar of a project, user or issue type.
+// URLs maps the sizes of the avatar, e.g. "48x48", to the URL of the image in the size.
+type Avatar struct {
+	ID             string            `json:"id,omitempty" structs:"id,omitempty"`
+	Owner          string            `json:"owner,omitempty" structs:"owner,omitempty"`
+	IsSystemAvatar bool              `json:"isSystemAvatar,omitempty" structs:"isSystemAvatar,omitempty"`
+	IsSelected     bool              `json:"isSelected,omitempty" structs:"isSelected,omitempty"`
+	IsDeletable    bool              `json:"isDeletable,omitempty" structs:"isDeletable,omitempty"`
+	FileName       string            `json:"fileName,omitempty" structs:"fileName,omitempty"`
+	URLs           map[string]string `json:"urls,omitempty" structs:"urls,omitempty"`
+}
+
+// Avatars holds the system avatars and the custom avatars of a project or user
+type Avatars struct {
+	System []Avatar `json:"system,omitempty" structs:"system,omitempty"`
+	Custom []Avatar `json:"custom,omitempty" structs:"custom,omitempty"`
+}
+
+// AvatarCropping holds the square of a temporary avatar the avatar is cropped to, and where the temporary avatar can be viewed.
+// It is returned for a temporary avatar with the largest possible square in its center,
+// and is passed back to create the avatar, possibly with another square.
+type AvatarCropping struct {
+	CropperWidth   int    `json:"cropperWidth" structs:"cropperWidth"`
+	CropperOffsetX int    `json:"cropperOffsetX" structs:"cropperOffsetX"`
+	CropperOffsetY int    `json:"cropperOffsetY" structs:"cropperOffsetY"`
+	URL            string `json:"url,omitempty" structs:"url,omitempty"`
+	NeedsCropping  bool   `json:"needsCropping,omitempty" structs:"needsCropping,omitempty"`
+}
+
+// avatarID is the request to assign an avatar
+type avatarID struct {
+	ID string `json:"id"`
+}
+
+// avatarOwner is the project or user whose avatars are managed at path, identified by query.
+type avatarOwner struct {
+	path     string
+	listPath string
+	query    url.Values
+}
+
+func projectAvatarOwner(projectKey string) avatarOwner {
+	return avatarOwner{
+		path:     fmt.Sprintf("rest/api/2/project/%s/avatar", projectKey),
+		listPath: fmt.Sprintf("rest/api/2/project/%s/avatars", projectKey),
+		query:    url.Values{},
+	}
+}
+
+func userAvatarOwner(username string) avatarOwner {
+	return avatarOwner{
+		path:     "rest/api/2/user/avatar",
+		listPath: "rest/api/2/user/avatars",
+		query:    url.Values{"username": []string{username}},
+	}
+}
+
+// endpoint returns the URL of path with the query identifying the owner and the given parameters.
+func (o avatarOwner) endpoint(path string, params url.Values) string {
+	query := url.Values{}
+	for key, values := range o.query {
+		query[key] = values
+	}
+	for key, values := range params {
+		query[key] = values
+	}
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
+
+// GetSystemAvatarsWithContext returns the system avatars of the given type, e.g. AvatarTypeProject.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/avatar-getAllSystemAvatars
+func (s *AvatarService) GetSystemAvatarsWithContext(ctx context.Context, avatarType string) ([]Avatar, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/avatar/%s/system", avatarType)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(Avatars)
+	resp, err := s.client.Do(req, avatars)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return avatars.System, resp, nil
+}
+
+// GetSystemAvatars wraps GetSystemAvatarsWithContext using the background context.
+func (s *AvatarService) GetSystemAvatars(avatarType string) ([]Avatar, *Response, error) {
+	return s.GetSystemAvatarsWithContext(context.Background(), avatarType)
+}
+
+// GetProjectAvatarsWithContext returns the system and custom avatars which can be assigned to a project.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/avatars-getAllAvatars
+func (s *AvatarService) GetProjectAvatarsWithContext(ctx context.Context, projectKey string) (*Avatars, *Response, error) {
+	return s.list(ctx, projectAvatarOwner(projectKey))
+}
+
+// GetProjectAvatars wraps GetProjectAvatarsWithContext using the background context.
+func (s *AvatarService) GetProjectAvatars(projectKey string) (*Avatars, *Response, error) {
+	return s.GetProjectAvatarsWithContext(context.Background(), projectKey)
+}
+
+// LoadTemporaryProjectAvatarWithContext uploads an image, e.g. a PNG with the contentType "image/png", as temporary avatar of a project.
+// It returns the cropping to pass to CreateProjectAvatar.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/avatar-storeTemporaryAvatar
+func (s *AvatarService) LoadTemporaryProjectAvatarWithContext(ctx context.Context, projectKey, filename, contentType string, data []byte) (*AvatarCropping, *Response, error) {
+	return s.loadTemporary(ctx, projectAvatarOwner(projectKey), filename, contentType, data)
+}
+
+// LoadTemporaryProjectAvatar wraps LoadTemporaryProjectAvatarWithContext using the background context.
+func (s *AvatarService) LoadTemporaryProjectAvatar(projectKey, filename, contentType string, data []byte) (*AvatarCropping, *Response, error) {
+	return s.LoadTemporaryProjectAvatarWithContext(context.Background(), projectKey, filename, contentType, data)
+}
+
+// CreateProjectAvatarWithContext crops the temporary avatar of a project and creates a custom avatar from it.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/avatar-createAvatarFromTemporary
+func (s *AvatarService) CreateProjectAvatarWithContext(ctx context.Context, projectKey string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	return s.create(ctx, projectAvatarOwner(projectKey), cropping)
+}
+
+// CreateProjectAvatar wraps CreateProjectAvatarWithContext using the background context.
+func (s *AvatarService) CreateProjectAvatar(projectKey string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	return s.CreateProjectAvatarWithContext(context.Background(), projectKey, cropping)
+}
+
+// SetProjectAvatarWithContext assigns a system or custom avatar to a project.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/avatar-updateProjectAvatar
+func (s *AvatarService) SetProjectAvatarWithContext(ctx context.Context, projectKey, avatarID string) (*Response, error) {
+	return s.set(ctx, projectAvatarOwner(projectKey), avatarID)
+}
+
+// SetProjectAvatar wraps SetProjectAvatarWithContext using the background context.
+func (s *AvatarService) SetProjectAvatar(projectKey, avatarID string) (*Response, error) {
+	return s.SetProjectAvatarWithContext(context.Background(), projectKey, avatarID)
+}
+
+// DeleteProjectAvatarWithContext deletes a custom avatar of a project.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectIdOrKey}/avatar-deleteAvatar
+func (s *AvatarService) DeleteProjectAvatarWithContext(ctx context.Context, projectKey, avatarID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/avatar/%s", projectKey, avatarID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteProjectAvatar wraps DeleteProjectAvatarWithContext using the background context.
+func (s *AvatarService) DeleteProjectAvatar(projectKey, avatarID string) (*Response, error) {
+	return s.DeleteProjectAvatarWithContext(context.Background(), projectKey, avatarID)
+}
+
+// UploadProjectAvatarWithContext uploads an image, crops it to the largest square in its center and assigns it to a project.
+func (s *AvatarService) UploadProjectAvatarWithContext(ctx context.Context, projectKey, filename, contentType string, data []byte) (*Avatar, *Response, error) {
+	return s.upload(ctx, projectAvatarOwner(projectKey), filename, contentType, data)
+}
+
+// UploadProjectAvatar wraps UploadProjectAvatarWithContext using the background context.
+func (s *AvatarService) UploadProjectAvatar(projectKey, filename, contentType string, data []byte) (*Avatar, *Response, error) {
+	return s.UploadProjectAvatarWithContext(context.Background(), projectKey, filename, contentType, data)
+}
+
+// GetUserAvatarsWithContext returns the system and custom avatars which can be assigned to a user.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-getAllAvatars
+func (s *AvatarService) GetUserAvatarsWithContext(ctx context.Context, username string) (*Avatars, *Response, error) {
+	return s.list(ctx, userAvatarOwner(username))
+}
+
+// GetUserAvatars wraps GetUserAvatarsWithContext using the background context.
+func (s *AvatarService) GetUserAvatars(username string) (*Avatars, *Response, error) {
+	return s.GetUserAvatarsWithContext(context.Background(), username)
+}
+
+// LoadTemporaryUserAvatarWithContext uploads an image, e.g. a PNG with the contentType "image/png", as temporary avatar of a user.
+// It returns the cropping to pass to CreateUserAvatar.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-storeTemporaryAvatar
+func (s *AvatarService) LoadTemporaryUserAvatarWithContext(ctx context.Context, username, filename, contentType string, data []byte) (*AvatarCropping, *Response, error) {
+	return s.loadTemporary(ctx, userAvatarOwner(username), filename, contentType, data)
+}
+
+// LoadTemporaryUserAvatar wraps LoadTemporaryUserAvatarWithContext using the background context.
+func (s *AvatarService) LoadTemporaryUserAvatar(username, filename, contentType string, data []byte) (*AvatarCropping, *Response, error) {
+	return s.LoadTemporaryUserAvatarWithContext(context.Background(), username, filename, contentType, data)
+}
+
+// CreateUserAvatarWithContext crops the temporary avatar of a user and creates a custom avatar from it.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-createAvatarFromTemporary
+func (s *AvatarService) CreateUserAvatarWithContext(ctx context.Context, username string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	return s.create(ctx, userAvatarOwner(username), cropping)
+}
+
+// CreateUserAvatar wraps CreateUserAvatarWithContext using the background context.
+func (s *AvatarService) CreateUserAvatar(username string, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	return s.CreateUserAvatarWithContext(context.Background(), username, cropping)
+}
+
+// SetUserAvatarWithContext assigns a system or custom avatar to a user.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/user-updateUserAvatar
+func (s *AvatarService) SetUserAvatarWithContext(ctx context.Context, username, avatarID string) (*Response, error) {
+	return s.set(ctx, userAvatarOwner(username), avatarID)
+}
+
+// SetUserAvatar wraps SetUserAvatarWithContext using the background context.
+func (s *AvatarService) SetUserAvatar(username, avatarID string) (*Response, error) {
+	return s.SetUserAvatarWithContext(context.Background(), username, avatarID)
+}
+
+// UploadUserAvatarWithContext uploads an image, crops it to the largest square in its center and assigns it to a user.
+func (s *AvatarService) UploadUserAvatarWithContext(ctx context.Context, username, filename, contentType string, data []byte) (*Avatar, *Response, error) {
+	return s.upload(ctx, userAvatarOwner(username), filename, contentType, data)
+}
+
+// UploadUserAvatar wraps UploadUserAvatarWithContext using the background context.
+func (s *AvatarService) UploadUserAvatar(username, filename, contentType string, data []byte) (*Avatar, *Response, error) {
+	return s.UploadUserAvatarWithContext(context.Background(), username, filename, contentType, data)
+}
+
+func (s *AvatarService) list(ctx context.Context, owner avatarOwner) (*Avatars, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", owner.endpoint(owner.listPath, nil), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(Avatars)
+	resp, err := s.client.Do(req, avatars)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return avatars, resp, nil
+}
+
+func (s *AvatarService) loadTemporary(ctx context.Context, owner avatarOwner, filename, contentType string, data []byte) (*AvatarCropping, *Response, error) {
+	params := url.Values{"filename": []string{filename}, "size": []string{strconv.Itoa(len(data))}}
+	req, err := s.client.NewRawRequestWithContext(ctx, "POST", owner.endpoint(owner.path+"/temporary", params), bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	cropping := new(AvatarCropping)
+	resp, err := s.client.Do(req, cropping)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return cropping, resp, nil
+}
+
+func (s *AvatarService) create(ctx context.Context, owner avatarOwner, cropping *AvatarCropping) (*Avatar, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", owner.endpoint(owner.path, nil), cropping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatar := new(Avatar)
+	resp, err := s.client.Do(req, avatar)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return avatar, resp, nil
+}
+
+func (s *AvatarService) set(ctx context.Context, owner avatarOwner, id string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", owner.endpoint(owner.path, nil), &avatarID{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// upload runs the three steps of uploading a custom avatar: loading it as temporary avatar, creating and assigning it.
+func (s *AvatarService) upload(ctx context.Context, owner avatarOwner, filename, contentType string, data []byte) (*Avatar, *Response, error) {
+	cropping, resp, err := s.loadTemporary(ctx, owner, filename, contentType, data)
+	if err != nil {
+		return nil, resp, err
+	}
+	avatar, resp, err := s.create(ctx, owner, cropping)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp, err = s.set(ctx, owner, avatar.ID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return avatar, resp, nil
+}
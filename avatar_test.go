@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestAvatarService_GetSystemAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/avatar/project/system"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"1000","isSystemAvatar":true,"isSelected":false,"isDeletable":false,"urls":{"16x16":"https://jira.example.com/secure/projectavatar?size=xsmall&avatarId=1000"}}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetSystemAvatars(AvatarTypeProject)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(avatars) != 1 || !avatars[0].IsSystemAvatar || avatars[0].URLs["16x16"] == "" {
+		t.Errorf("Expected 1 system avatar. Got %+v", avatars)
+	}
+}
+
+func TestAvatarService_GetProjectAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/EX/avatars"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"system":[{"id":"1000","isSystemAvatar":true}],"custom":[{"id":"1010","owner":"10000","isSelected":true,"isDeletable":true}]}`)
+	})
+
+	avatars, _, err := testClient.Avatar.GetProjectAvatars("EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if avatars == nil || len(avatars.System) != 1 || len(avatars.Custom) != 1 || !avatars.Custom[0].IsSelected {
+		t.Errorf("Expected a system and a selected custom avatar. Got %+v", avatars)
+	}
+}
+
+func TestAvatarService_UploadProjectAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	image := []byte("\x89PNG not really")
+	var steps []string
+
+	testMux.HandleFunc("/rest/api/2/project/EX/avatar/temporary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, fmt.Sprintf("/rest/api/2/project/EX/avatar/temporary?filename=logo.png&size=%d", len(image)))
+		if r.Header.Get("Content-Type") != "image/png" || r.Header.Get("X-Atlassian-Token") != "nocheck" {
+			t.Errorf("Unexpected headers %v", r.Header)
+		}
+		if body, _ := ioutil.ReadAll(r.Body); string(body) != string(image) {
+			t.Errorf("Expected the image as body. Got %q", body)
+		}
+		steps = append(steps, "temporary")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"cropperWidth":120,"cropperOffsetX":50,"cropperOffsetY":50,"url":"https://jira.example.com/tmp","needsCropping":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/project/EX/avatar", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			var cropping AvatarCropping
+			if err := json.NewDecoder(r.Body).Decode(&cropping); err != nil || cropping.CropperWidth != 120 || cropping.CropperOffsetX != 50 {
+				t.Errorf("Expected the cropping of the temporary avatar. Got %+v", cropping)
+			}
+			steps = append(steps, "create")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":"1010","owner":"10000","isSystemAvatar":false,"isDeletable":true}`)
+		case "PUT":
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"id":"1010"}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			steps = append(steps, "set")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	avatar, _, err := testClient.Avatar.UploadProjectAvatar("EX", "logo.png", "image/png", image)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if avatar == nil || avatar.ID != "1010" {
+		t.Errorf("Expected avatar 1010. Got %+v", avatar)
+	}
+	if fmt.Sprint(steps) != "[temporary create set]" {
+		t.Errorf("Expected the avatar to be loaded, created and set. Got %v", steps)
+	}
+}
+
+func TestAvatarService_DeleteProjectAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/EX/avatar/1010"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Avatar.DeleteProjectAvatar("EX", "1010"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAvatarService_UserAvatars(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/user/avatars", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/avatars?username=fred")
+		fmt.Fprint(w, `{"system":[{"id":"10100","isSystemAvatar":true}],"custom":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/user/avatar/temporary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/user/avatar/temporary?filename=me.jpg&size=3&username=fred")
+		fmt.Fprint(w, `{"cropperWidth":48,"cropperOffsetX":0,"cropperOffsetY":0,"needsCropping":false}`)
+	})
+	testMux.HandleFunc("/rest/api/2/user/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/rest/api/2/user/avatar?username=fred")
+		switch r.Method {
+		case "POST":
+			fmt.Fprint(w, `{"id":"10200","owner":"fred"}`)
+		case "PUT":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	avatars, _, err := testClient.Avatar.GetUserAvatars("fred")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if avatars == nil || len(avatars.System) != 1 {
+		t.Errorf("Expected 1 system avatar. Got %+v", avatars)
+	}
+
+	cropping, _, err := testClient.Avatar.LoadTemporaryUserAvatar("fred", "me.jpg", "image/jpeg", []byte("jpg"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	avatar, _, err := testClient.Avatar.CreateUserAvatar("fred", cropping)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if avatar == nil || avatar.Owner != "fred" {
+		t.Errorf("Expected an avatar of fred. Got %+v", avatar)
+	}
+	if _, err := testClient.Avatar.SetUserAvatar("fred", "10200"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,70 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// BacklogService handles the backlog of boards in JIRA Agile API.
+// Issues are ranked within the backlog with IssueService.Rank.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/backlog
+type BacklogService struct {
+	client *Client
+}
+
+// BacklogMoveOptions specifies the issues to move to the backlog of a board and where to rank them.
+// At most one of RankBeforeIssue and RankAfterIssue can be set.
+type BacklogMoveOptions struct {
+	Issues            []string `json:"issues" structs:"issues"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty" structs:"rankBeforeIssue,omitempty"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty" structs:"rankAfterIssue,omitempty"`
+	RankCustomFieldID int      `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// MoveIssuesWithContext moves issues to the backlog, i.e. removes them from all sprints.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/backlog-moveIssuesToBacklog
+func (s *BacklogService) MoveIssuesWithContext(ctx context.Context, issueIDs []string) (*Response, error) {
+	apiEndpoint := "rest/agile/1.0/backlog/issue"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, IssuesWrapper{Issues: issueIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// MoveIssues wraps MoveIssuesWithContext using the background context.
+func (s *BacklogService) MoveIssues(issueIDs []string) (*Response, error) {
+	return s.MoveIssuesWithContext(context.Background(), issueIDs)
+}
+
+// MoveIssuesToBoardWithContext moves issues to the backlog of the board with the given id
+// and ranks them before or after another issue.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/backlog-moveIssuesToBacklogForBoard
+func (s *BacklogService) MoveIssuesToBoardWithContext(ctx context.Context, boardID int, options *BacklogMoveOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/backlog/%d/issue", boardID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// MoveIssuesToBoard wraps MoveIssuesToBoardWithContext using the background context.
+func (s *BacklogService) MoveIssuesToBoard(boardID int, options *BacklogMoveOptions) (*Response, error) {
+	return s.MoveIssuesToBoardWithContext(context.Background(), boardID, options)
+}
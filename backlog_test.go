@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestBacklogService_MoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/backlog/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"issues":["PR-1","10001"]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Backlog.MoveIssues([]string{"PR-1", "10001"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBacklogService_MoveIssuesToBoard(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/backlog/84/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"issues":["PR-1"],"rankAfterIssue":"PR-4"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Backlog.MoveIssuesToBoard(84, &BacklogMoveOptions{Issues: []string{"PR-1"}, RankAfterIssue: "PR-4"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
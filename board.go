@@ -1,8 +1,10 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -72,18 +74,158 @@ type Sprint struct {
 	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
 	Self          string     `json:"self" structs:"self"`
 	State         string     `json:"state" structs:"state"`
+	Goal          string     `json:"goal,omitempty" structs:"goal,omitempty"`
 }
 
-// GetAllBoards will returns all boards. This only includes boards that the user has permission to view.
+// BoardIssuesOptions specifies the optional parameters to the BoardService.GetIssuesForBoard, SprintService.GetIssuesForSprintWithOptions and EpicService.GetIssues
+type BoardIssuesOptions struct {
+	// JQL filters the issues of the board further.
+	JQL string `url:"jql,omitempty"`
+	// ValidateQuery specifies whether to validate the JQL query or not. Default: true.
+	ValidateQuery *bool `url:"validateQuery,omitempty"`
+	// Fields is a comma-separated list of the fields to return for each issue. By default, all navigable and agile fields are returned.
+	Fields string `url:"fields,omitempty"`
+	// Expand is a comma-separated list of the parameters to expand.
+	Expand string `url:"expand,omitempty"`
+	// StartAt is the index of the first issue to return. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of issues to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// BoardConfiguration represents the configuration of a JIRA agile board
+type BoardConfiguration struct {
+	ID           int                            `json:"id" structs:"id"`
+	Name         string                         `json:"name" structs:"name"`
+	Self         string                         `json:"self" structs:"self"`
+	Type         string                         `json:"type" structs:"type"`
+	Location     BoardConfigurationLocation     `json:"location" structs:"location"`
+	Filter       BoardConfigurationFilter       `json:"filter" structs:"filter"`
+	SubQuery     BoardConfigurationSubQuery     `json:"subQuery" structs:"subQuery"`
+	ColumnConfig BoardConfigurationColumnConfig `json:"columnConfig" structs:"columnConfig"`
+	Estimation   BoardConfigurationEstimation   `json:"estimation" structs:"estimation"`
+	Ranking      BoardConfigurationRanking      `json:"ranking" structs:"ranking"`
+}
+
+// BoardConfigurationLocation references the project or user the board belongs to
+type BoardConfigurationLocation struct {
+	Type string `json:"type" structs:"type"`
+	Key  string `json:"key" structs:"key"`
+	ID   string `json:"id" structs:"id"`
+	Self string `json:"self" structs:"self"`
+	Name string `json:"name" structs:"name"`
+}
+
+// BoardConfigurationFilter references the filter the board is based on
+type BoardConfigurationFilter struct {
+	ID   string `json:"id" structs:"id"`
+	Self string `json:"self" structs:"self"`
+}
+
+// BoardConfigurationSubQuery is the kanban sub-filter of a board
+type BoardConfigurationSubQuery struct {
+	Query string `json:"query" structs:"query"`
+}
+
+// BoardConfigurationColumnConfig holds the columns of a board and how they are constrained
+type BoardConfigurationColumnConfig struct {
+	Columns        []BoardConfigurationColumn `json:"columns" structs:"columns"`
+	ConstraintType string                     `json:"constraintType" structs:"constraintType"`
+}
+
+// BoardConfigurationColumn is a single column of a board with the statuses mapped to it
+type BoardConfigurationColumn struct {
+	Name   string                           `json:"name" structs:"name"`
+	Status []BoardConfigurationColumnStatus `json:"statuses" structs:"statuses"`
+	Min    int                              `json:"min,omitempty" structs:"min,omitempty"`
+	Max    int                              `json:"max,omitempty" structs:"max,omitempty"`
+}
+
+// BoardConfigurationColumnStatus references a status mapped to a board column
+type BoardConfigurationColumnStatus struct {
+	ID   string `json:"id" structs:"id"`
+	Self string `json:"self" structs:"self"`
+}
+
+// These constants are the types of the BoardConfigurationEstimation
+const (
+	BoardEstimationTypeNone       = "none"
+	BoardEstimationTypeIssueCount = "issueCount"
+	BoardEstimationTypeField      = "field"
+)
+
+// BoardConfigurationEstimation describes how issues on the board are estimated
+type BoardConfigurationEstimation struct {
+	// Type is one of the BoardEstimationType constants
+	Type  string `json:"type" structs:"type"`
+	Field struct {
+		FieldID     string `json:"fieldId" structs:"fieldId"`
+		DisplayName string `json:"displayName" structs:"displayName"`
+	} `json:"field" structs:"field"`
+}
+
+// BoardConfigurationRanking references the custom field used to rank issues on the board
+type BoardConfigurationRanking struct {
+	RankCustomFieldID int `json:"rankCustomFieldId" structs:"rankCustomFieldId"`
+}
+
+// Estimate returns the estimate of the issue as it is used by the board, e.g. for the velocity chart.
+// Issues are estimated by the value of the estimation field, the original time estimate in seconds,
+// or 1 if the board counts issues. It returns false if the board does not estimate or the issue has no estimate.
+// The estimation field has to be among the fields requested for the issue.
+func (c *BoardConfiguration) Estimate(issue *Issue) (float64, bool) {
+	if c.Estimation.Type == BoardEstimationTypeIssueCount {
+		return 1, true
+	}
+	if c.Estimation.Type != BoardEstimationTypeField || issue == nil || issue.Fields == nil {
+		return 0, false
+	}
+
+	switch fieldID := c.Estimation.Field.FieldID; fieldID {
+	case "timeoriginalestimate":
+		return float64(issue.Fields.TimeOriginalEstimate), issue.Fields.TimeOriginalEstimate != 0
+	case "timeestimate":
+		return float64(issue.Fields.TimeEstimate), issue.Fields.TimeEstimate != 0
+	default:
+		return issue.Fields.CustomFieldNumber(fieldID)
+	}
+}
+
+// TotalEstimate returns the sum of the estimates of the issues, e.g. the completed issues of a sprint.
+// Issues without an estimate are left out.
+func (c *BoardConfiguration) TotalEstimate(issues []Issue) float64 {
+	total := 0.0
+	for i := range issues {
+		if estimate, ok := c.Estimate(&issues[i]); ok {
+			total += estimate
+		}
+	}
+	return total
+}
+
+// ColumnForStatus returns the column of the board the status with the given id is mapped to.
+// It returns false if the status is not mapped to any column.
+func (c *BoardConfiguration) ColumnForStatus(statusID string) (*BoardConfigurationColumn, bool) {
+	for i, column := range c.ColumnConfig.Columns {
+		for _, status := range column.Status {
+			if status.ID == statusID {
+				return &c.ColumnConfig.Columns[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// GetAllBoardsWithContext will returns all boards. This only includes boards that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getAllBoards
-func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Response, error) {
+func (s *BoardService) GetAllBoardsWithContext(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest("GET", url, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,13 +240,18 @@ func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Respon
 	return boards, resp, err
 }
 
-// GetBoard will returns the board for the given boardID.
+// GetAllBoards wraps GetAllBoardsWithContext using the background context.
+func (s *BoardService) GetAllBoards(opt *BoardListOptions) (*BoardsList, *Response, error) {
+	return s.GetAllBoardsWithContext(context.Background(), opt)
+}
+
+// GetBoardWithContext will returns the board for the given boardID.
 // This board will only be returned if the user has permission to view it.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getBoard
-func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
+func (s *BoardService) GetBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -119,7 +266,12 @@ func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
 	return board, resp, nil
 }
 
-// CreateBoard creates a new board. Board name, type and filter Id is required.
+// GetBoard wraps GetBoardWithContext using the background context.
+func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
+	return s.GetBoardWithContext(context.Background(), boardID)
+}
+
+// CreateBoardWithContext creates a new board. Board name, type and filter Id is required.
 // name - Must be less than 255 characters.
 // type - Valid values: scrum, kanban
 // filterId - Id of a filter that the user has permissions to view.
@@ -127,9 +279,9 @@ func (s *BoardService) GetBoard(boardID int) (*Board, *Response, error) {
 // board will be created instead (remember that board sharing depends on the filter sharing).
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
-func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
+func (s *BoardService) CreateBoardWithContext(ctx context.Context, board *Board) (*Board, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
-	req, err := s.client.NewRequest("POST", apiEndpoint, board)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, board)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,12 +296,17 @@ func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
 	return responseBoard, resp, nil
 }
 
-// DeleteBoard will delete an agile board.
+// CreateBoard wraps CreateBoardWithContext using the background context.
+func (s *BoardService) CreateBoard(board *Board) (*Board, *Response, error) {
+	return s.CreateBoardWithContext(context.Background(), board)
+}
+
+// DeleteBoardWithContext will delete an agile board.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-deleteBoard
-func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
+func (s *BoardService) DeleteBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,17 +318,78 @@ func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
 	return nil, resp, err
 }
 
-// GetAllSprints will return all sprints from a board, for a given board Id.
+// DeleteBoard wraps DeleteBoardWithContext using the background context.
+func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
+	return s.DeleteBoardWithContext(context.Background(), boardID)
+}
+
+// GetBoardConfigurationWithContext will return the configuration of the board for the given boardID,
+// e.g. its filter, columns and estimation field.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getConfiguration
+func (s *BoardService) GetBoardConfigurationWithContext(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(BoardConfiguration)
+	resp, err := s.client.Do(req, configuration)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return configuration, resp, nil
+}
+
+// GetBoardConfiguration wraps GetBoardConfigurationWithContext using the background context.
+func (s *BoardService) GetBoardConfiguration(boardID int) (*BoardConfiguration, *Response, error) {
+	return s.GetBoardConfigurationWithContext(context.Background(), boardID)
+}
+
+// GetIssuesForBoardWithContext will return the issues of the board for the given boardID.
+// The issues are ordered by rank. The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getIssuesForBoard
+func (s *BoardService) GetIssuesForBoardWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/issue", boardID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(searchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Issues, resp, nil
+}
+
+// GetIssuesForBoard wraps GetIssuesForBoardWithContext using the background context.
+func (s *BoardService) GetIssuesForBoard(boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesForBoardWithContext(context.Background(), boardID, options)
+}
+
+// GetAllSprintsWithContext will return all sprints from a board, for a given board Id.
 // This only includes sprints that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
-func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error) {
+func (s *BoardService) GetAllSprintsWithContext(ctx context.Context, boardID string) ([]Sprint, *Response, error) {
 	id, err := strconv.Atoi(boardID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result, response, err := s.GetAllSprintsWithOptions(id, &GetAllSprintsOptions{})
+	result, response, err := s.GetAllSprintsWithOptionsWithContext(ctx, id, &GetAllSprintsOptions{})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,17 +397,22 @@ func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error
 	return result.Values, response, nil
 }
 
-// GetAllSprintsWithOptions will return sprints from a board, for a given board Id and filtering options
+// GetAllSprints wraps GetAllSprintsWithContext using the background context.
+func (s *BoardService) GetAllSprints(boardID string) ([]Sprint, *Response, error) {
+	return s.GetAllSprintsWithContext(context.Background(), boardID)
+}
+
+// GetAllSprintsWithOptionsWithContext will return sprints from a board, for a given board Id and filtering options
 // This only includes sprints that the user has permission to view.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
-func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
+func (s *BoardService) GetAllSprintsWithOptionsWithContext(ctx context.Context, boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/sprint", boardID)
 	url, err := addOptions(apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
 	}
-	req, err := s.client.NewRequest("GET", url, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,3 +425,36 @@ func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSpri
 
 	return result, resp, err
 }
+
+// GetAllSprintsWithOptions wraps GetAllSprintsWithOptionsWithContext using the background context.
+func (s *BoardService) GetAllSprintsWithOptions(boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
+	return s.GetAllSprintsWithOptionsWithContext(context.Background(), boardID, options)
+}
+
+// GetSprintsByStateWithContext will return the sprints of all pages from a board, for a given board Id.
+// Only sprints in one of the given states, e.g. SprintStateActive and SprintStateClosed, are returned.
+// Without states, sprints in all states are returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board/{boardId}/sprint
+func (s *BoardService) GetSprintsByStateWithContext(ctx context.Context, boardID int, states ...string) ([]Sprint, *Response, error) {
+	options := &GetAllSprintsOptions{State: strings.Join(states, ",")}
+	var sprints []Sprint
+	for {
+		result, resp, err := s.GetAllSprintsWithOptionsWithContext(ctx, boardID, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		sprints = append(sprints, result.Values...)
+
+		// The sprint endpoint reports no total, but whether the page is the last one
+		if result.IsLast || len(result.Values) == 0 {
+			return sprints, resp, nil
+		}
+		options.StartAt += len(result.Values)
+	}
+}
+
+// GetSprintsByState wraps GetSprintsByStateWithContext using the background context.
+func (s *BoardService) GetSprintsByState(boardID int, states ...string) ([]Sprint, *Response, error) {
+	return s.GetSprintsByStateWithContext(context.Background(), boardID, states...)
+}
@@ -216,3 +216,127 @@ func TestBoardService_GetAllSprintsWithOptions(t *testing.T) {
 		t.Errorf("Expected 1 transition. Got %d", len(sprints.Values))
 	}
 }
+
+func TestBoardService_GetBoardConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/agile/1.0/board/35/configuration"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"id":35,"name":"SCRUM board","type":"scrum","self":"https://test.jira.org/rest/agile/1.0/board/35/configuration",
+			"location":{"type":"project","key":"SCRUM","id":"10010","name":"Scrum"},
+			"filter":{"id":"10101","self":"https://test.jira.org/rest/api/2/filter/10101"},
+			"columnConfig":{"columns":[{"name":"To Do","statuses":[{"id":"10000","self":"https://test.jira.org/rest/api/2/status/10000"}]},
+				{"name":"Done","statuses":[{"id":"10002","self":"https://test.jira.org/rest/api/2/status/10002"}],"max":5}],"constraintType":"issueCount"},
+			"estimation":{"type":"field","field":{"fieldId":"customfield_10002","displayName":"Story Points"}},
+			"ranking":{"rankCustomFieldId":10011}}`)
+	})
+
+	config, _, err := testClient.Board.GetBoardConfiguration(35)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if config == nil {
+		t.Fatal("Expected board configuration. Board configuration is nil")
+	}
+	if len(config.ColumnConfig.Columns) != 2 || config.ColumnConfig.Columns[1].Max != 5 {
+		t.Errorf("Expected 2 columns with a limit on Done. Got %+v", config.ColumnConfig)
+	}
+	if config.Estimation.Field.FieldID != "customfield_10002" {
+		t.Errorf("Expected estimation field customfield_10002. Got %s", config.Estimation.Field.FieldID)
+	}
+}
+
+func TestBoardService_GetIssuesForBoard(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/agile/1.0/board/35/issue"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?fields=summary&jql=assignee+%3D+currentUser%28%29&maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"id":"10001","key":"SCRUM-1"},{"id":"10002","key":"SCRUM-2"}]}`)
+	})
+
+	issues, resp, err := testClient.Board.GetIssuesForBoard(35, &BoardIssuesOptions{JQL: "assignee = currentUser()", Fields: "summary", MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues. Got %d", len(issues))
+	}
+	if resp.Total != 3 {
+		t.Errorf("Expected total of 3. Got %d", resp.Total)
+	}
+}
+
+func TestBoardService_GetSprintsByState(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/123/sprint"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if state := r.URL.Query().Get("state"); state != "active,closed" {
+			t.Errorf("Expected state filter active,closed. Got %s", state)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults":50,"startAt":0,"isLast":false,"values":[{"id":1,"state":"closed"},{"id":2,"state":"active"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults":50,"startAt":2,"isLast":true,"values":[{"id":3,"state":"active"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	sprints, _, err := testClient.Board.GetSprintsByState(123, SprintStateActive, SprintStateClosed)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(sprints) != 3 || sprints[2].ID != 3 {
+		t.Errorf("Expected the sprints of both pages. Got %+v", sprints)
+	}
+}
+
+func TestBoardConfiguration_Estimate(t *testing.T) {
+	config := &BoardConfiguration{}
+	config.Estimation.Type = BoardEstimationTypeField
+	config.Estimation.Field.FieldID = "customfield_10002"
+	config.ColumnConfig.Columns = []BoardConfigurationColumn{
+		{Name: "To Do", Status: []BoardConfigurationColumnStatus{{ID: "10000"}}},
+		{Name: "Done", Status: []BoardConfigurationColumnStatus{{ID: "10001"}, {ID: "10002"}}},
+	}
+
+	issues := []Issue{
+		{Key: "SCRUM-1", Fields: &IssueFields{}},
+		{Key: "SCRUM-2", Fields: &IssueFields{}},
+		{Key: "SCRUM-3", Fields: &IssueFields{}},
+	}
+	issues[0].Fields.SetCustomFieldNumber("customfield_10002", 3)
+	issues[1].Fields.SetCustomFieldNumber("customfield_10002", 5)
+
+	if estimate, ok := config.Estimate(&issues[0]); !ok || estimate != 3 {
+		t.Errorf("Expected estimate 3. Got %v", estimate)
+	}
+	if _, ok := config.Estimate(&issues[2]); ok {
+		t.Error("Expected no estimate for an issue without story points")
+	}
+	if total := config.TotalEstimate(issues); total != 8 {
+		t.Errorf("Expected total estimate 8. Got %v", total)
+	}
+
+	config.Estimation.Type = BoardEstimationTypeIssueCount
+	if total := config.TotalEstimate(issues); total != 3 {
+		t.Errorf("Expected the number of issues as total estimate. Got %v", total)
+	}
+
+	if column, ok := config.ColumnForStatus("10002"); !ok || column.Name != "Done" {
+		t.Errorf("Expected column Done. Got %+v", column)
+	}
+	if _, ok := config.ColumnForStatus("10003"); ok {
+		t.Error("Expected no column for an unmapped status")
+	}
+}
@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// ResponseCache stores the responses of GET requests carrying an ETag or Last-Modified header.
+// The client revalidates a cached response with a conditional request
+// and uses the cached body if JIRA answers with 304 Not Modified.
+// Implementations must be safe for concurrent use.
+//
+// Responses are cached by URL, so a cache must not be shared by clients authenticating as different users.
+type ResponseCache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+}
+
+// CachedResponse is a response stored in a ResponseCache.
+type CachedResponse struct {
+	StatusCode   int
+	Header       http.Header
+	Body         []byte
+	ETag         string
+	LastModified string
+}
+
+// MemoryCache is a ResponseCache keeping the responses in memory.
+type MemoryCache struct {
+	mu        sync.RWMutex
+	responses map[string]*CachedResponse
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{responses: map[string]*CachedResponse{}}
+}
+
+// Get returns the response cached for key.
+func (c *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resp, ok := c.responses[key]
+	return resp, ok
+}
+
+// Set caches resp for key.
+func (c *MemoryCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = resp
+}
+
+// Clear removes all cached responses.
+func (c *MemoryCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = map[string]*CachedResponse{}
+}
+
+// sendCached sends the GET request req, revalidating the response cached for its URL.
+func (c *Client) sendCached(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	key := req.URL.String()
+	cached, ok := c.Cache.Get(key)
+	if ok {
+		req = cloneRequest(req)
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := send(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		// The response is replaced by the cached one, so drain it to allow the connection to be reused
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		return cached.response(req), nil
+	}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	c.Cache.Set(key, &CachedResponse{
+		StatusCode:   resp.StatusCode,
+		Header:       cloneHeader(resp.Header),
+		Body:         body,
+		ETag:         etag,
+		LastModified: lastModified,
+	})
+	return resp, nil
+}
+
+// response returns a new HTTP response for req with the cached status, headers and body
+func (r *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(r.StatusCode),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cloneHeader(r.Header),
+		Body:          ioutil.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// cloneHeader returns a deep copy of header
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for k, s := range header {
+		clone[k] = append([]string(nil), s...)
+	}
+	return clone
+}
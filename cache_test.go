@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Do_Cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 && r.Header.Get("If-None-Match") != `"v1"` {
+			t.Errorf("Expected a conditional request. Got If-None-Match %q", r.Header.Get("If-None-Match"))
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+	})
+
+	testClient.Cache = NewMemoryCache()
+	for i := 0; i < 2; i++ {
+		fields, resp, err := testClient.Field.GetList()
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected the cached status 200. Got %d", resp.StatusCode)
+		}
+		if len(fields) != 1 || fields[0].Name != "Summary" {
+			t.Errorf("Expected the field Summary. Got %+v", fields)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests. Got %d", requests)
+	}
+}
+
+func TestClient_Do_CacheSkipsUncacheable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			t.Error("Expected no conditional request for a response without ETag and Last-Modified")
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	cache := NewMemoryCache()
+	testClient.Cache = cache
+	for i := 0; i < 2; i++ {
+		if _, _, err := testClient.Priority.GetList(); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+	}
+	if len(cache.responses) != 0 {
+		t.Errorf("Expected no cached responses. Got %d", len(cache.responses))
+	}
+}
@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Call sends a request to an endpoint of the JIRA API this package has no method for, e.g. a new or experimental one,
+// the same way the services do: authenticated, with the middlewares of the client, and JIRA errors as Error.
+//
+// path is resolved relative to the base URL, e.g. "rest/api/3/project/search".
+// query adds query parameters to path. It is nil, url.Values or a struct with url tags like the options of the services.
+// body is sent as is if it is an io.Reader and JSON encoded otherwise. It is not sent if nil.
+// The response is JSON decoded into out unless it is nil.
+//
+//	var projects []jira.Project
+//	_, err := client.Call(ctx, "GET", "rest/api/2/project", url.Values{"expand": {"lead"}}, nil, &projects)
+func (c *Client) Call(ctx context.Context, method, path string, query interface{}, body interface{}, out interface{}) (*Response, error) {
+	apiEndpoint, err := withQuery(path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	if reader, ok := body.(io.Reader); ok {
+		req, err = c.NewRawRequestWithContext(ctx, method, apiEndpoint, reader)
+	} else {
+		req, err = c.NewRequestWithContext(ctx, method, apiEndpoint, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, out)
+	if err == io.EOF {
+		// The response has no body to decode, e.g. 204 No Content
+		return resp, nil
+	}
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	if out == nil {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	return resp, nil
+}
+
+// CallPages calls a GET endpoint paginated with the startAt and maxResults query parameters page by page,
+// until all items were returned, and calls f with each item.
+// The items are found in the field itemsField of the pages, e.g. "values" or "issues",
+// or are the page itself if itemsField is empty. The last page is told by the total or isLast field of the pages,
+// or, if there are none, by a page with less items than its maxResults field. Pages without any of these fields,
+// e.g. plain lists, are requested until an empty page is returned.
+// query sets the first startAt and the maxResults, which will default to 0 and 50.
+// Iteration stops with the first error returned by f.
+func (c *Client) CallPages(ctx context.Context, path string, query interface{}, itemsField string, f func(item json.RawMessage) error) error {
+	apiEndpoint, err := withQuery(path, query)
+	if err != nil {
+		return err
+	}
+	u, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return err
+	}
+	params := u.Query()
+	u.RawQuery = ""
+	startAt, _ := strconv.Atoi(params.Get("startAt"))
+	maxResults, _ := strconv.Atoi(params.Get("maxResults"))
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+
+	return fetchPages(startAt, maxResults, func(startAt, maxResults int) (*Response, int, error) {
+		params.Set("startAt", strconv.Itoa(startAt))
+		params.Set("maxResults", strconv.Itoa(maxResults))
+		var page json.RawMessage
+		resp, err := c.Call(ctx, "GET", u.String(), params, nil, &page)
+		if err != nil {
+			return resp, 0, err
+		}
+
+		items, total, pageSize, err := pageItems(page, itemsField)
+		if err != nil {
+			return resp, 0, err
+		}
+		resp.StartAt = startAt
+		resp.MaxResults = pageSize
+		resp.Total = total
+		if total < 0 {
+			// The last page: end the iteration after it
+			resp.Total = startAt + len(items)
+		}
+
+		for _, item := range items {
+			if err := f(item); err != nil {
+				return resp, 0, err
+			}
+		}
+		return resp, len(items), nil
+	})
+}
+
+// pageItems returns the items of a page in itemsField, or the page itself if itemsField is empty,
+// the total of the pages, which is -1 if the page is the last page and 0 if it is not known,
+// and the page size JIRA used, which is 0 if it is not known
+func pageItems(page json.RawMessage, itemsField string) ([]json.RawMessage, int, int, error) {
+	var items []json.RawMessage
+	if itemsField == "" {
+		if err := json.Unmarshal(page, &items); err != nil {
+			return nil, 0, 0, err
+		}
+		return items, 0, 0, nil
+	}
+
+	var fields struct {
+		Total      int   `json:"total"`
+		MaxResults int   `json:"maxResults"`
+		IsLast     *bool `json:"isLast"`
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(page, &object); err != nil {
+		return nil, 0, 0, err
+	}
+	if err := json.Unmarshal(page, &fields); err != nil {
+		return nil, 0, 0, err
+	}
+	raw, ok := object[itemsField]
+	if !ok {
+		return nil, 0, 0, fmt.Errorf("The page has no field %q", itemsField)
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, 0, 0, err
+	}
+
+	if fields.IsLast != nil && *fields.IsLast {
+		return items, -1, fields.MaxResults, nil
+	}
+	return items, fields.Total, fields.MaxResults, nil
+}
+
+// withQuery adds the query parameters to path.
+// The query is nil, url.Values or a struct with url tags.
+func withQuery(path string, opt interface{}) (string, error) {
+	var params url.Values
+	switch opt := opt.(type) {
+	case nil:
+		return path, nil
+	case url.Values:
+		params = opt
+	default:
+		if v := reflect.ValueOf(opt); v.Kind() == reflect.Ptr && v.IsNil() {
+			return path, nil
+		}
+		var err error
+		params, err = query.Values(opt)
+		if err != nil {
+			return path, err
+		}
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+	merged := u.Query()
+	for key, values := range params {
+		merged[key] = values
+	}
+	u.RawQuery = merged.Encode()
+	return u.String(), nil
+}
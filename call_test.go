@@ -0,0 +1,177 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestClient_Call(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/project/search?expand=lead&query=ex")
+		fmt.Fprint(w, `{"values":[{"key":"EX","name":"Example"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/3/project/EX/properties/tool", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if strings.TrimSpace(string(body)) != `{"enabled":true}` {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/3/project/NOPE", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["No project could be found with key 'NOPE'."],"errors":{}}`)
+	})
+
+	var page struct {
+		Values []Project `json:"values"`
+	}
+	_, err := testClient.Call(context.Background(), "GET", "rest/api/3/project/search?query=ex", url.Values{"expand": {"lead"}}, nil, &page)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(page.Values) != 1 || page.Values[0].Key != "EX" {
+		t.Errorf("Unexpected projects %+v", page.Values)
+	}
+
+	resp, err := testClient.Call(context.Background(), "PUT", "/rest/api/3/project/EX/properties/tool", nil, map[string]bool{"enabled": true}, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", resp.StatusCode)
+	}
+
+	_, err = testClient.Call(context.Background(), "GET", "rest/api/3/project/NOPE", nil, nil, new(Project))
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "No project could be found") {
+		t.Errorf("Expected a JIRA error for 404, got %v", err)
+	}
+}
+
+func TestClient_Call_StructQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&maxResults=10")
+		fmt.Fprint(w, `{}`)
+	})
+
+	options := &struct {
+		JQL        string `url:"jql"`
+		MaxResults int    `url:"maxResults,omitempty"`
+		StartAt    int    `url:"startAt,omitempty"`
+	}{JQL: "project = EX", MaxResults: 10}
+	if _, err := testClient.Call(context.Background(), "GET", "rest/api/2/search", options, nil, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestClient_CallPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/workflow/search", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"isLast":false,"values":[{"id":"1"},{"id":"2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"isLast":true,"values":[{"id":"3"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `[{"name":"fred"},{"name":"wilma"}]`)
+		case "2":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var ids []string
+	err := testClient.CallPages(context.Background(), "rest/api/3/workflow/search", url.Values{"maxResults": {"2"}}, "values", func(item json.RawMessage) error {
+		var workflow struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &workflow); err != nil {
+			return err
+		}
+		ids = append(ids, workflow.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Join(ids, ",") != "1,2,3" {
+		t.Errorf("Expected the items of both pages, got %v", ids)
+	}
+
+	var names []string
+	err = testClient.CallPages(context.Background(), "rest/api/2/user/search?username=.", url.Values{"maxResults": {"2"}}, "", func(item json.RawMessage) error {
+		var user User
+		if err := json.Unmarshal(item, &user); err != nil {
+			return err
+		}
+		names = append(names, user.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Join(names, ",") != "fred,wilma" {
+		t.Errorf("Expected fred and wilma, got %v", names)
+	}
+}
+
+func TestClient_CallPages_CappedPageSize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/dashboard/search", func(w http.ResponseWriter, r *http.Request) {
+		if maxResults := r.URL.Query().Get("maxResults"); maxResults != "50" {
+			t.Errorf("Expected the default page size of 50. Got %s", maxResults)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"values":[{"id":"1"},{"id":"2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"values":[{"id":"3"},{"id":"4"}]}`)
+		case "4":
+			fmt.Fprint(w, `{"startAt":4,"maxResults":2,"values":[{"id":"5"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var ids []string
+	err := testClient.CallPages(context.Background(), "rest/api/2/dashboard/search", nil, "values", func(item json.RawMessage) error {
+		var dashboard struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &dashboard); err != nil {
+			return err
+		}
+		ids = append(ids, dashboard.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Join(ids, ",") != "1,2,3,4,5" {
+		t.Errorf("Expected the items of all pages, got %v", ids)
+	}
+}
@@ -0,0 +1,230 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// These constants are the URLs of the Atlassian platform API used by CloudSites
+const (
+	// DefaultAccessibleResourcesURL lists the sites an OAuth 2.0 (3LO) access token grants access to
+	DefaultAccessibleResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	// DefaultCloudAPIURL is the base URL of the REST API of the JIRA Cloud sites, followed by their cloud id
+	DefaultCloudAPIURL = "https://api.atlassian.com/ex/jira/"
+)
+
+// CloudResource represents a site an OAuth 2.0 access token grants access to.
+//
+// Atlassian docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+type CloudResource struct {
+	ID        string   `json:"id" structs:"id"`
+	URL       string   `json:"url" structs:"url"`
+	Name      string   `json:"name" structs:"name"`
+	Scopes    []string `json:"scopes,omitempty" structs:"scopes,omitempty"`
+	AvatarURL string   `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+}
+
+// CloudSites manages a Client for each JIRA Cloud site an OAuth 2.0 (3LO) access token grants access to,
+// so multi-tenant apps can fan out operations across the sites of all their customers:
+//
+//	tp := jira.BearerAuthTransport{Token: accessToken}
+//	sites := jira.NewCloudSites(tp.Client())
+//	err := sites.ForEach(func(ctx context.Context, site jira.CloudResource, client *jira.Client) error {
+//		_, _, err := client.Issue.Search("assignee = currentUser()", nil)
+//		return err
+//	})
+//
+// The clients are keyed by the cloud id of their site and created once. It is safe for concurrent use.
+type CloudSites struct {
+	// AccessibleResourcesURL will default to DefaultAccessibleResourcesURL if empty
+	AccessibleResourcesURL string
+	// APIURL will default to DefaultCloudAPIURL if empty
+	APIURL string
+
+	httpClient *http.Client
+	options    []ClientOption
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewCloudSites returns a CloudSites making the requests with httpClient,
+// which has to authenticate them with an OAuth 2.0 access token, e.g. using BearerAuthTransport.
+// The options are applied to every Client.
+func NewCloudSites(httpClient *http.Client, opts ...ClientOption) *CloudSites {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &CloudSites{
+		httpClient: httpClient,
+		options:    opts,
+		clients:    map[string]*Client{},
+	}
+}
+
+// ResourcesWithContext returns the JIRA sites the access token grants access to.
+// Sites of other products, e.g. Confluence, are left out.
+//
+// Atlassian docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+func (s *CloudSites) ResourcesWithContext(ctx context.Context) ([]CloudResource, *Response, error) {
+	apiEndpoint := s.AccessibleResourcesURL
+	if apiEndpoint == "" {
+		apiEndpoint = DefaultAccessibleResourcesURL
+	}
+	req, err := newRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpResp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := newResponse(httpResp, nil)
+	if err := CheckResponse(httpResp); err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	defer httpResp.Body.Close()
+
+	var resources []CloudResource
+	if err := json.NewDecoder(httpResp.Body).Decode(&resources); err != nil {
+		return nil, resp, err
+	}
+
+	sites := make([]CloudResource, 0, len(resources))
+	for _, resource := range resources {
+		if resource.isJira() {
+			sites = append(sites, resource)
+		}
+	}
+	return sites, resp, nil
+}
+
+// Resources wraps ResourcesWithContext using the background context.
+func (s *CloudSites) Resources() ([]CloudResource, *Response, error) {
+	return s.ResourcesWithContext(context.Background())
+}
+
+// Client returns the Client of the site with cloudID, creating it on first use.
+func (s *CloudSites) Client(cloudID string) (*Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if client, ok := s.clients[cloudID]; ok {
+		return client, nil
+	}
+
+	apiURL := s.APIURL
+	if apiURL == "" {
+		apiURL = DefaultCloudAPIURL
+	}
+	opts := append([]ClientOption{WithHTTPClient(s.httpClient), WithDialect(DialectCloud)}, s.options...)
+	client, err := NewClient(strings.TrimSuffix(apiURL, "/")+"/"+cloudID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.clients[cloudID] = client
+	return client, nil
+}
+
+// ClientsWithContext returns the Clients of all JIRA sites the access token grants access to, keyed by cloud id.
+func (s *CloudSites) ClientsWithContext(ctx context.Context) (map[string]*Client, error) {
+	resources, _, err := s.ResourcesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]*Client, len(resources))
+	for _, resource := range resources {
+		client, err := s.Client(resource.ID)
+		if err != nil {
+			return nil, err
+		}
+		clients[resource.ID] = client
+	}
+	return clients, nil
+}
+
+// Clients wraps ClientsWithContext using the background context.
+func (s *CloudSites) Clients() (map[string]*Client, error) {
+	return s.ClientsWithContext(context.Background())
+}
+
+// CloudSitesError holds the errors of the sites an operation of CloudSites.ForEach failed for, keyed by cloud id
+type CloudSitesError map[string]error
+
+// Error lists the sites and their errors
+func (e CloudSitesError) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("%s: %s", id, e[id])
+	}
+	return fmt.Sprintf("%d sites failed: %s", len(e), strings.Join(messages, "; "))
+}
+
+// ForEachWithContext calls f concurrently with every JIRA site the access token grants access to and its Client.
+// It waits for all calls and returns a CloudSitesError with the errors of the sites f failed for.
+func (s *CloudSites) ForEachWithContext(ctx context.Context, f func(ctx context.Context, site CloudResource, client *Client) error) error {
+	resources, _, err := s.ResourcesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	failed := CloudSitesError{}
+	var wg sync.WaitGroup
+	for _, resource := range resources {
+		client, err := s.Client(resource.ID)
+		if err != nil {
+			mu.Lock()
+			failed[resource.ID] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(resource CloudResource, client *Client) {
+			defer wg.Done()
+			if err := f(ctx, resource, client); err != nil {
+				mu.Lock()
+				failed[resource.ID] = err
+				mu.Unlock()
+			}
+		}(resource, client)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+// ForEach wraps ForEachWithContext using the background context.
+func (s *CloudSites) ForEach(f func(ctx context.Context, site CloudResource, client *Client) error) error {
+	return s.ForEachWithContext(context.Background(), f)
+}
+
+// isJira reports whether the resource is a JIRA site, from its scopes
+func (r CloudResource) isJira() bool {
+	if len(r.Scopes) == 0 {
+		return true
+	}
+	for _, scope := range r.Scopes {
+		if strings.Contains(scope, "jira") || strings.HasPrefix(scope, "manage:servicedesk") {
+			return true
+		}
+	}
+	return false
+}
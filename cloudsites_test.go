@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestCloudSites_ForEach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+			t.Errorf("Expected the access token, got %q", auth)
+		}
+		fmt.Fprint(w, `[
+			{"id":"1324a887-45db-1bf4-1e99-ef0ff456d421","url":"https://first.atlassian.net","name":"first","scopes":["read:jira-work"]},
+			{"id":"2ab5c9f0-1111-2222-3333-444455556666","url":"https://second.atlassian.net","name":"second","scopes":["read:jira-work","write:jira-work"]},
+			{"id":"99999999-0000-0000-0000-000000000000","url":"https://wiki.atlassian.net","name":"wiki","scopes":["read:confluence-content.all"]}
+		]`)
+	})
+	testMux.HandleFunc("/ex/jira/1324a887-45db-1bf4-1e99-ef0ff456d421/rest/api/3/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"EX-1","fields":{"summary":"first site"}}`)
+	})
+	testMux.HandleFunc("/ex/jira/2ab5c9f0-1111-2222-3333-444455556666/rest/api/3/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Issue does not exist"],"errors":{}}`)
+	})
+
+	tp := &BearerAuthTransport{Token: "token"}
+	sites := NewCloudSites(tp.Client())
+	sites.AccessibleResourcesURL = testServer.URL + "/oauth/token/accessible-resources"
+	sites.APIURL = testServer.URL + "/ex/jira/"
+
+	var mu sync.Mutex
+	summaries := map[string]string{}
+	err := sites.ForEach(func(ctx context.Context, site CloudResource, client *Client) error {
+		issue, _, err := client.Issue.GetWithContext(ctx, "EX-1", nil)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		summaries[site.Name] = issue.Fields.Summary
+		mu.Unlock()
+		return nil
+	})
+
+	var failed CloudSitesError
+	if !errors.As(err, &failed) || len(failed) != 1 || !IsNotFound(failed["2ab5c9f0-1111-2222-3333-444455556666"]) {
+		t.Fatalf("Expected the second site to fail, got %v", err)
+	}
+	if len(summaries) != 1 || summaries["first"] != "first site" {
+		t.Errorf("Expected the issue of the first site, got %v", summaries)
+	}
+
+	client, _ := sites.Client("1324a887-45db-1bf4-1e99-ef0ff456d421")
+	clients, err := sites.Clients()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(clients) != 2 || clients["1324a887-45db-1bf4-1e99-ef0ff456d421"] != client {
+		t.Errorf("Expected the clients of the 2 JIRA sites to be reused, got %v", clients)
+	}
+	if client.Dialect != DialectCloud {
+		t.Errorf("Expected the cloud dialect, got %s", client.Dialect)
+	}
+}
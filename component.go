@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // ComponentService handles components for the JIRA instance / API.
 //
 // JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component
@@ -7,22 +12,33 @@ type ComponentService struct {
 	client *Client
 }
 
-// CreateComponentOptions are passed to the ComponentService.Create function to create a new JIRA component
+// These constants are the ways issues of a component can be assigned by default
+const (
+	ComponentAssigneeTypeProjectDefault = "PROJECT_DEFAULT"
+	ComponentAssigneeTypeComponentLead  = "COMPONENT_LEAD"
+	ComponentAssigneeTypeProjectLead    = "PROJECT_LEAD"
+	ComponentAssigneeTypeUnassigned     = "UNASSIGNED"
+)
+
+// CreateComponentOptions are passed to the ComponentService.Create function to create a new JIRA component.
+// They are passed to ComponentService.Update as well, where only the fields which are set are changed.
+// AssigneeType is one of the ComponentAssigneeType constants.
 type CreateComponentOptions struct {
-	Name         string `json:"name,omitempty" structs:"name,omitempty"`
-	Description  string `json:"description,omitempty" structs:"description,omitempty"`
-	Lead         *User  `json:"lead,omitempty" structs:"lead,omitempty"`
-	LeadUserName string `json:"leadUserName,omitempty" structs:"leadUserName,omitempty"`
-	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
-	Assignee     *User  `json:"assignee,omitempty" structs:"assignee,omitempty"`
-	Project      string `json:"project,omitempty" structs:"project,omitempty"`
-	ProjectID    int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	Name          string `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead          *User  `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadUserName  string `json:"leadUserName,omitempty" structs:"leadUserName,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	Assignee      *User  `json:"assignee,omitempty" structs:"assignee,omitempty"`
+	Project       string `json:"project,omitempty" structs:"project,omitempty"`
+	ProjectID     int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
 }
 
-// Create creates a new JIRA component based on the given options.
-func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+// CreateWithContext creates a new JIRA component based on the given options.
+func (s *ComponentService) CreateWithContext(ctx context.Context, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
 	apiEndpoint := "rest/api/2/component"
-	req, err := s.client.NewRequest("POST", apiEndpoint, options)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -36,3 +52,133 @@ func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComp
 
 	return component, resp, nil
 }
+
+// Create wraps CreateWithContext using the background context.
+func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the component for a given component id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-getComponent
+func (s *ComponentService) GetWithContext(ctx context.Context, componentID string) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ComponentService) Get(componentID string) (*ProjectComponent, *Response, error) {
+	return s.GetWithContext(context.Background(), componentID)
+}
+
+// GetListWithContext returns all components of a project, for a given project id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project-getProjectComponents
+func (s *ComponentService) GetListWithContext(ctx context.Context, projectIDOrKey string) ([]ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/components", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	components := []ProjectComponent{}
+	resp, err := s.client.Do(req, &components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ComponentService) GetList(projectIDOrKey string) ([]ProjectComponent, *Response, error) {
+	return s.GetListWithContext(context.Background(), projectIDOrKey)
+}
+
+// UpdateWithContext updates the component for a given component id.
+// Only the fields set in options are changed, e.g. the lead or the assignee type.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-updateComponent
+func (s *ComponentService) UpdateWithContext(ctx context.Context, componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ComponentService) Update(componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	return s.UpdateWithContext(context.Background(), componentID, options)
+}
+
+// SetLeadWithContext makes the user with the given name the lead of the component for a given component id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-updateComponent
+func (s *ComponentService) SetLeadWithContext(ctx context.Context, componentID, leadUserName string) (*ProjectComponent, *Response, error) {
+	return s.UpdateWithContext(ctx, componentID, &CreateComponentOptions{LeadUserName: leadUserName})
+}
+
+// SetLead wraps SetLeadWithContext using the background context.
+func (s *ComponentService) SetLead(componentID, leadUserName string) (*ProjectComponent, *Response, error) {
+	return s.SetLeadWithContext(context.Background(), componentID, leadUserName)
+}
+
+// SetAssigneeTypeWithContext changes who issues of the component for a given component id are assigned to by default.
+// assigneeType is one of the ComponentAssigneeType constants.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-updateComponent
+func (s *ComponentService) SetAssigneeTypeWithContext(ctx context.Context, componentID, assigneeType string) (*ProjectComponent, *Response, error) {
+	return s.UpdateWithContext(ctx, componentID, &CreateComponentOptions{AssigneeType: assigneeType})
+}
+
+// SetAssigneeType wraps SetAssigneeTypeWithContext using the background context.
+func (s *ComponentService) SetAssigneeType(componentID, assigneeType string) (*ProjectComponent, *Response, error) {
+	return s.SetAssigneeTypeWithContext(context.Background(), componentID, assigneeType)
+}
+
+// DeleteWithContext deletes the component for a given component id.
+// If moveIssuesTo is not empty, the issues of the deleted component are moved to the component with that id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-delete
+func (s *ComponentService) DeleteWithContext(ctx context.Context, componentID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	if moveIssuesTo != "" {
+		apiEndpoint += "?moveIssuesTo=" + moveIssuesTo
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *ComponentService) Delete(componentID, moveIssuesTo string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), componentID, moveIssuesTo)
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -27,3 +28,83 @@ func TestComponentService_Create_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestComponentService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/component/10000","id":"10000","name":"Component 1","lead":{"name":"fred"},"assigneeType":"COMPONENT_LEAD","project":"HSP","projectId":10000}`)
+	})
+
+	component, _, err := testClient.Component.Get("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.AssigneeType != ComponentAssigneeTypeComponentLead || component.Lead.Name != "fred" {
+		t.Errorf("Expected component led and assigned to fred. Got %+v", component)
+	}
+}
+
+func TestComponentService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/HSP/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/HSP/components")
+
+		fmt.Fprint(w, `[{"id":"10000","name":"Component 1"},{"id":"10050","name":"PXA"}]`)
+	})
+
+	components, _, err := testClient.Component.GetList("HSP")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(components) != 2 {
+		t.Errorf("Expected 2 components. Got %d", len(components))
+	}
+}
+
+func TestComponentService_SetAssigneeType(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload) != 1 || payload["assigneeType"] != ComponentAssigneeTypeProjectLead {
+			t.Errorf("Expected only the assignee type in payload, got %v instead", payload)
+		}
+
+		fmt.Fprint(w, `{"id":"10000","name":"Component 1","assigneeType":"PROJECT_LEAD"}`)
+	})
+
+	component, _, err := testClient.Component.SetAssigneeType("10000", ComponentAssigneeTypeProjectLead)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.AssigneeType != ComponentAssigneeTypeProjectLead {
+		t.Errorf("Expected component assigned to the project lead. Got %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/component/10000?moveIssuesTo=10050")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete("10000", "10050"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
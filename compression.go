@@ -0,0 +1,88 @@
+package jira
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Compression returns a Middleware asking JIRA for gzip compressed responses and decompressing them transparently.
+// Request bodies of at least minBodySize bytes, e.g. bulk create payloads, are gzip compressed.
+// Request bodies are not compressed if minBodySize is zero or negative, or if they cannot be read again
+// because the request has no GetBody, e.g. a request created by NewRawRequest with a streaming reader.
+// The bodies of requests created by NewRequest can be read again and are compressed.
+func Compression(minBodySize int) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req = cloneRequest(req)
+			if minBodySize > 0 && req.GetBody != nil && req.ContentLength >= int64(minBodySize) && req.Header.Get("Content-Encoding") == "" {
+				if err := compressBody(req); err != nil {
+					return nil, err
+				}
+			}
+
+			acceptGzip := req.Header.Get("Accept-Encoding") == ""
+			if acceptGzip {
+				req.Header.Set("Accept-Encoding", "gzip")
+			}
+
+			resp, err := next(req)
+			if err != nil || !acceptGzip || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+				return resp, err
+			}
+
+			body, err := gzip.NewReader(resp.Body)
+			if err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			resp.Body = &gzipBody{Reader: body, body: resp.Body}
+			resp.Header.Del("Content-Encoding")
+			resp.Header.Del("Content-Length")
+			resp.ContentLength = -1
+			resp.Uncompressed = true
+			return resp, nil
+		}
+	}
+}
+
+// compressBody replaces the body of req by its gzip compressed copy
+func compressBody(req *http.Request) error {
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := io.Copy(writer, body); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// gzipBody decompresses a response body, closing both the decompressor and the body
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
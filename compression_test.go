@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCompression(t *testing.T) {
+	setup()
+	defer teardown()
+	summary := strings.Repeat("a", 200)
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Expected Accept-Encoding gzip. Got %q", r.Header.Get("Accept-Encoding"))
+		}
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			t.Fatalf("Expected a gzip compressed request body. Got Content-Encoding %q", r.Header.Get("Content-Encoding"))
+		}
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		body, _ := ioutil.ReadAll(reader)
+		if !strings.Contains(string(body), summary) {
+			t.Errorf("Expected the decompressed body to contain the summary. Got %s", body)
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		fmt.Fprint(writer, `{"id":"10000","key":"EX-1"}`)
+		writer.Close()
+	})
+
+	testClient.Middlewares = []Middleware{Compression(100)}
+	issue, _, err := testClient.Issue.Create(&Issue{Fields: &IssueFields{Summary: summary}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Expected the decompressed issue EX-1. Got %+v", issue)
+	}
+}
+
+func TestCompression_SmallBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Expected an uncompressed request body. Got Content-Encoding %q", r.Header.Get("Content-Encoding"))
+		}
+		fmt.Fprint(w, `{"id":"10000","key":"EX-1"}`)
+	})
+
+	testClient.Middlewares = []Middleware{Compression(1024)}
+	issue, _, err := testClient.Issue.Create(&Issue{Fields: &IssueFields{Summary: "short"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Expected the issue EX-1. Got %+v", issue)
+	}
+}
@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultConnectTokenURL is the authorization server issuing access tokens to Atlassian Connect apps acting as users
+const DefaultConnectTokenURL = "https://oauth-2-authorization-server.services.atlassian.com/oauth2/token"
+
+// ConnectImpersonationTransport is an http.RoundTripper that authenticates all requests
+// as an Atlassian Connect app acting as a user, so JIRA attributes the actions to the user.
+// It exchanges a JWT signed with the shared secret of the installation of the app for an access token
+// with the OAuth 2.0 JWT bearer grant, and renews the token before it expires.
+// The app needs the ACT_AS_USER scope.
+//
+// Atlassian Connect docs: https://developer.atlassian.com/cloud/jira/platform/user-impersonation-for-connect-apps/
+type ConnectImpersonationTransport struct {
+	// OAuthClientID is the OAuth client id of the app, from the installed lifecycle event
+	OAuthClientID string
+
+	// SharedSecret is the secret JIRA sent to the app in the installed lifecycle event
+	SharedSecret string
+
+	// BaseURL is the base URL of the JIRA instance, from the installed lifecycle event
+	BaseURL string
+
+	// AccountID is the account id of the user the app acts as
+	AccountID string
+
+	// Scopes are the scopes of the access tokens, e.g. "READ" and "WRITE". They will default to "READ" if empty.
+	Scopes []string
+
+	// TokenURL is the URL of the authorization server. It will default to DefaultConnectTokenURL if empty.
+	TokenURL string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// ActAsUser returns a transport authenticating requests as the app acting as the user with accountID,
+// using the shared secret, base URL and transport of t.
+func (t *ConnectJWTTransport) ActAsUser(oauthClientID, accountID string, scopes ...string) *ConnectImpersonationTransport {
+	return &ConnectImpersonationTransport{
+		OAuthClientID: oauthClientID,
+		SharedSecret:  t.SharedSecret,
+		BaseURL:       t.BaseURL,
+		AccountID:     accountID,
+		Scopes:        scopes,
+		Transport:     t.Transport,
+	}
+}
+
+// RoundTrip implements the RoundTripper interface. It adds the access token in the Authorization header.
+func (t *ConnectImpersonationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.AccessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated as the app acting as the user
+func (t *ConnectImpersonationTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// AccessToken returns the access token for the user,
+// requesting a new one if there is none or if it expires within the next minute.
+func (t *ConnectImpersonationTransport) AccessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && time.Now().Add(time.Minute).Before(t.expires) {
+		return t.token, nil
+	}
+
+	tokenURL := t.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultConnectTokenURL
+	}
+	scopes := t.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"READ"}
+	}
+
+	now := time.Now()
+	assertion, err := signJWT(map[string]interface{}{
+		"iss": "urn:atlassian:connect:clientid:" + t.OAuthClientID,
+		"sub": "urn:atlassian:connect:useraccountid:" + t.AccountID,
+		"tnt": t.BaseURL,
+		"aud": strings.TrimSuffix(tokenURL, "/oauth2/token"),
+		"iat": now.Unix(),
+		"exp": now.Add(time.Minute).Unix(),
+	}, t.SharedSecret)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	form.Set("scope", strings.ToUpper(strings.Join(scopes, " ")))
+	req, err := newRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", NewJiraError(newResponse(resp, nil), fmt.Errorf("connect: requesting access token failed with status %d", resp.StatusCode))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("connect: no access token returned")
+	}
+	t.token = result.AccessToken
+	t.expires = now.Add(time.Duration(result.ExpiresIn) * time.Second)
+	return t.token, nil
+}
+
+func (t *ConnectImpersonationTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
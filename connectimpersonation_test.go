@@ -0,0 +1,86 @@
+package jira
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestConnectImpersonationTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tokens := 0
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		r.ParseForm()
+		if r.Form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.Form.Get("scope") != "READ WRITE" {
+			t.Errorf("Unexpected token request %v", r.Form)
+		}
+		parts := strings.Split(r.Form.Get("assertion"), ".")
+		if len(parts) != 3 {
+			t.Errorf("Expected a JWT assertion, got %q", r.Form.Get("assertion"))
+			return
+		}
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		var claims map[string]interface{}
+		json.Unmarshal(payload, &claims)
+		if claims["iss"] != "urn:atlassian:connect:clientid:client-id" ||
+			claims["sub"] != "urn:atlassian:connect:useraccountid:000-fred" ||
+			claims["tnt"] != testServer.URL ||
+			claims["aud"] != testServer.URL {
+			t.Errorf("Unexpected claims %v", claims)
+		}
+		tokens++
+		fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":900,"token_type":"Bearer"}`, tokens)
+	})
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer token-1" {
+			t.Errorf("Expected the access token, got %q", auth)
+		}
+		fmt.Fprint(w, `{"accountId":"000-fred"}`)
+	})
+
+	app := &ConnectJWTTransport{AppKey: "com.example.app", SharedSecret: "secret", BaseURL: testServer.URL}
+	tp := app.ActAsUser("client-id", "000-fred", "read", "write")
+	tp.TokenURL = testServer.URL + "/oauth2/token"
+	client, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()), WithDialect(DialectCloud))
+
+	for i := 0; i < 2; i++ {
+		user, _, err := client.User.GetSelf()
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if user.AccountID != "000-fred" {
+			t.Errorf("Expected 000-fred, got %s", user.AccountID)
+		}
+	}
+	if tokens != 1 {
+		t.Errorf("Expected the access token to be reused, got %d tokens", tokens)
+	}
+}
+
+func TestConnectImpersonationTransport_Denied(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":"invalid_grant"}`)
+	})
+
+	tp := &ConnectImpersonationTransport{
+		OAuthClientID: "client-id",
+		SharedSecret:  "wrong",
+		BaseURL:       testServer.URL,
+		AccountID:     "000-fred",
+		TokenURL:      testServer.URL + "/oauth2/token",
+	}
+	if _, err := tp.AccessToken(context.Background()); !IsUnauthorized(err) {
+		t.Errorf("Expected an unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultConnectJWTExpiry is the time a JWT signed by ConnectJWTTransport is valid
+const DefaultConnectJWTExpiry = 3 * time.Minute
+
+// ConnectJWTTransport is an http.RoundTripper that authenticates all requests
+// as an Atlassian Connect app, with a JWT signed with the shared secret of the installation of the app.
+// The query string hash (qsh) claim of every token is computed from the method, path and query of its request.
+//
+// Atlassian Connect docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/
+type ConnectJWTTransport struct {
+	// AppKey is the key of the app, from its descriptor. It is the issuer of the tokens.
+	AppKey string
+
+	// SharedSecret is the secret JIRA sent to the app in the installed lifecycle event
+	SharedSecret string
+
+	// BaseURL is the base URL of the JIRA instance, from the installed lifecycle event.
+	// Its path is the context path, which is not part of the path the query string hash is computed from.
+	BaseURL string
+
+	// Expiry is the time a token is valid. It will default to DefaultConnectJWTExpiry if zero.
+	Expiry time.Duration
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface. It adds a JWT for the request in the Authorization header.
+func (t *ConnectJWTTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Method, req.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "JWT "+token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated as the Connect app
+func (t *ConnectJWTTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Token returns a JWT for a request with method to u.
+func (t *ConnectJWTTransport) Token(method string, u *url.URL) (string, error) {
+	contextPath := ""
+	if t.BaseURL != "" {
+		base, err := url.Parse(t.BaseURL)
+		if err != nil {
+			return "", err
+		}
+		contextPath = base.Path
+	}
+
+	expiry := t.Expiry
+	if expiry == 0 {
+		expiry = DefaultConnectJWTExpiry
+	}
+	now := time.Now()
+	return signJWT(map[string]interface{}{
+		"iss": t.AppKey,
+		"iat": now.Unix(),
+		"exp": now.Add(expiry).Unix(),
+		"qsh": ConnectQueryStringHash(method, u, contextPath),
+	}, t.SharedSecret)
+}
+
+func (t *ConnectJWTTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// ConnectQueryStringHash returns the query string hash (qsh) claim of a JWT for a request with method to u,
+// the hex encoded SHA-256 hash of its canonical request.
+// contextPath is the path of the base URL of the JIRA instance, which is removed from the path of u.
+//
+// Atlassian Connect docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/#qsh
+func ConnectQueryStringHash(method string, u *url.URL, contextPath string) string {
+	hash := sha256.Sum256([]byte(connectCanonicalRequest(method, u, contextPath)))
+	return hex.EncodeToString(hash[:])
+}
+
+// connectCanonicalRequest returns the canonical request of the query string hash:
+// the method, the path without context path and the sorted query without jwt parameter, separated by &
+func connectCanonicalRequest(method string, u *url.URL, contextPath string) string {
+	path := u.Path
+	contextPath = strings.TrimSuffix(contextPath, "/")
+	if contextPath != "" && strings.HasPrefix(path, contextPath) {
+		path = path[len(contextPath):]
+	}
+	path = strings.TrimSuffix(path, "/")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	path = strings.Replace(path, "&", "%26", -1)
+
+	// The parameters are sorted by their encoded names, not as name=value strings, or "a-b" would sort before "a"
+	query := u.Query()
+	values := make(map[string][]string, len(query))
+	names := make([]string, 0, len(query))
+	for name, value := range query {
+		if name == "jwt" {
+			continue
+		}
+		values[connectEscape(name)] = value
+		names = append(names, connectEscape(name))
+	}
+	sort.Strings(names)
+
+	params := make([]string, len(names))
+	for i, name := range names {
+		encoded := make([]string, len(values[name]))
+		for j, value := range values[name] {
+			encoded[j] = connectEscape(value)
+		}
+		sort.Strings(encoded)
+		params[i] = name + "=" + strings.Join(encoded, ",")
+	}
+
+	return strings.ToUpper(method) + "&" + path + "&" + strings.Join(params, "&")
+}
+
+// connectEscape percent-encodes s as required for canonical requests: spaces as %20, and ~ not at all
+func connectEscape(s string) string {
+	escaped := url.QueryEscape(s)
+	escaped = strings.Replace(escaped, "+", "%20", -1)
+	escaped = strings.Replace(escaped, "*", "%2A", -1)
+	return strings.Replace(escaped, "%7E", "~", -1)
+}
+
+// signJWT returns a JWT with claims, signed with secret using HMAC SHA-256
+func signJWT(claims map[string]interface{}, secret string) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestConnectCanonicalRequest(t *testing.T) {
+	tests := []struct {
+		method, url, contextPath, want string
+	}{
+		{"get", "https://example.atlassian.net/rest/api/2/issue/EX-1", "", "GET&/rest/api/2/issue/EX-1&"},
+		{"GET", "https://example.atlassian.net/", "", "GET&/&"},
+		{"POST", "https://example.com/jira/rest/api/2/search/", "/jira", "POST&/rest/api/2/search&"},
+		{"GET", "https://example.com/jira/rest/a&b", "/jira/", "GET&/rest/a%26b&"},
+		{
+			"GET",
+			"https://example.atlassian.net/rest/api/2/search?jql=project+%3D+EX&maxResults=10&expand=names&expand=changelog&jwt=token&tilde=~*",
+			"",
+			"GET&/rest/api/2/search&expand=changelog,names&jql=project%20%3D%20EX&maxResults=10&tilde=~%2A",
+		},
+		{"GET", "https://example.atlassian.net/rest/api/2/search?a-b=2&a=1", "", "GET&/rest/api/2/search&a=1&a-b=2"},
+	}
+	for _, test := range tests {
+		u, _ := url.Parse(test.url)
+		if got := connectCanonicalRequest(test.method, u, test.contextPath); got != test.want {
+			t.Errorf("%s %s: Expected %q, got %q", test.method, test.url, test.want, got)
+		}
+	}
+}
+
+func TestConnectJWTTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tp := &ConnectJWTTransport{
+		AppKey:       "com.example.app",
+		SharedSecret: "secret",
+		BaseURL:      testServer.URL,
+	}
+	testMux.HandleFunc("/rest/api/3/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "JWT ") {
+			t.Errorf("Expected a JWT, got %q", auth)
+			return
+		}
+		parts := strings.Split(strings.TrimPrefix(auth, "JWT "), ".")
+		if len(parts) != 3 {
+			t.Errorf("Expected 3 parts of the JWT, got %d", len(parts))
+			return
+		}
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+			t.Error("Expected a valid signature")
+		}
+
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		var claims struct {
+			Iss string `json:"iss"`
+			Iat int64  `json:"iat"`
+			Exp int64  `json:"exp"`
+			Qsh string `json:"qsh"`
+		}
+		if err := json.Unmarshal(payload, &claims); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		hash := sha256.Sum256([]byte("GET&/rest/api/3/issue/EX-1&fields=summary"))
+		if claims.Qsh != hex.EncodeToString(hash[:]) {
+			t.Errorf("Unexpected qsh %s", claims.Qsh)
+		}
+		if claims.Iss != "com.example.app" || claims.Exp-claims.Iat != int64(DefaultConnectJWTExpiry.Seconds()) {
+			t.Errorf("Unexpected claims %+v", claims)
+		}
+		w.Write([]byte(`{"key":"EX-1"}`))
+	})
+
+	client, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()), WithDialect(DialectCloud))
+	issue, _, err := client.Issue.Get("EX-1", &GetQueryOptions{Fields: "summary"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected EX-1, got %s", issue.Key)
+	}
+}
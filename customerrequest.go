@@ -0,0 +1,413 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CustomerRequest represents a request raised on a service desk.
+// Unlike the issue it is based on, it carries the service desk context, e.g. the request type and the status shown in the portal.
+type CustomerRequest struct {
+	IssueID            string                      `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	IssueKey           string                      `json:"issueKey,omitempty" structs:"issueKey,omitempty"`
+	RequestTypeID      string                      `json:"requestTypeId,omitempty" structs:"requestTypeId,omitempty"`
+	ServiceDeskID      string                      `json:"serviceDeskId,omitempty" structs:"serviceDeskId,omitempty"`
+	CreatedDate        *CustomerRequestDate        `json:"createdDate,omitempty" structs:"createdDate,omitempty"`
+	Reporter           *User                       `json:"reporter,omitempty" structs:"reporter,omitempty"`
+	RequestFieldValues []CustomerRequestFieldValue `json:"requestFieldValues,omitempty" structs:"requestFieldValues,omitempty"`
+	CurrentStatus      *CustomerRequestStatus      `json:"currentStatus,omitempty" structs:"currentStatus,omitempty"`
+	Links              *CustomerRequestLinks       `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// CustomerRequestDate is a date as returned by the JIRA Service Management API, in several formats.
+type CustomerRequestDate struct {
+	ISO8601     string `json:"iso8601,omitempty" structs:"iso8601,omitempty"`
+	Jira        string `json:"jira,omitempty" structs:"jira,omitempty"`
+	Friendly    string `json:"friendly,omitempty" structs:"friendly,omitempty"`
+	EpochMillis int64  `json:"epochMillis,omitempty" structs:"epochMillis,omitempty"`
+}
+
+// CustomerRequestFieldValue is the value of a field of a customer request, labeled as on the request type form.
+type CustomerRequestFieldValue struct {
+	FieldID string      `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	Label   string      `json:"label,omitempty" structs:"label,omitempty"`
+	Value   interface{} `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// CustomerRequestStatus is the status of a customer request as shown to the customer.
+type CustomerRequestStatus struct {
+	Status         string               `json:"status,omitempty" structs:"status,omitempty"`
+	StatusCategory string               `json:"statusCategory,omitempty" structs:"statusCategory,omitempty"`
+	StatusDate     *CustomerRequestDate `json:"statusDate,omitempty" structs:"statusDate,omitempty"`
+}
+
+// CustomerRequestLinks are the links to a customer request, e.g. Web is the request in the customer portal.
+type CustomerRequestLinks struct {
+	Web      string `json:"web,omitempty" structs:"web,omitempty"`
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	JiraRest string `json:"jiraRest,omitempty" structs:"jiraRest,omitempty"`
+	Agent    string `json:"agent,omitempty" structs:"agent,omitempty"`
+}
+
+// CreateCustomerRequestOptions are passed to the ServiceDeskService.CreateCustomerRequest function to raise a request.
+// RequestFieldValues maps the field ids of the request type fields to their values, e.g. "summary" to the summary.
+// RaiseOnBehalfOf and RequestParticipants identify customers by their account id on JIRA Cloud and their username on JIRA Server.
+type CreateCustomerRequestOptions struct {
+	ServiceDeskID       string                 `json:"serviceDeskId" structs:"serviceDeskId"`
+	RequestTypeID       string                 `json:"requestTypeId" structs:"requestTypeId"`
+	RequestFieldValues  map[string]interface{} `json:"requestFieldValues" structs:"requestFieldValues"`
+	RaiseOnBehalfOf     string                 `json:"raiseOnBehalfOf,omitempty" structs:"raiseOnBehalfOf,omitempty"`
+	RequestParticipants []string               `json:"requestParticipants,omitempty" structs:"requestParticipants,omitempty"`
+}
+
+// CustomerRequestGetOptions specifies the optional parameters to the ServiceDeskService.GetCustomerRequest
+type CustomerRequestGetOptions struct {
+	// Expand is a comma-separated list of the entities to expand, e.g. "participant,status,sla".
+	Expand string `url:"expand,omitempty"`
+}
+
+// RequestParticipantsList reflects a page of the participants of a customer request
+type RequestParticipantsList struct {
+	Size       int    `json:"size" structs:"size"`
+	Start      int    `json:"start" structs:"start"`
+	Limit      int    `json:"limit" structs:"limit"`
+	IsLastPage bool   `json:"isLastPage" structs:"isLastPage"`
+	Values     []User `json:"values" structs:"values"`
+}
+
+// RequestSLA represents a service level agreement of a customer request, e.g. "Time to first response",
+// with its ongoing and completed cycles.
+type RequestSLA struct {
+	ID              string                `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string                `json:"name,omitempty" structs:"name,omitempty"`
+	OngoingCycle    *RequestSLACycle      `json:"ongoingCycle,omitempty" structs:"ongoingCycle,omitempty"`
+	CompletedCycles []RequestSLACycle     `json:"completedCycles,omitempty" structs:"completedCycles,omitempty"`
+	Links           *CustomerRequestLinks `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestSLACycle is a cycle of an SLA, which runs from the start to the stop of the SLA timer.
+// StopTime is only set for completed cycles.
+type RequestSLACycle struct {
+	StartTime           *CustomerRequestDate `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	StopTime            *CustomerRequestDate `json:"stopTime,omitempty" structs:"stopTime,omitempty"`
+	BreachTime          *CustomerRequestDate `json:"breachTime,omitempty" structs:"breachTime,omitempty"`
+	Breached            bool                 `json:"breached,omitempty" structs:"breached,omitempty"`
+	Paused              bool                 `json:"paused,omitempty" structs:"paused,omitempty"`
+	WithinCalendarHours bool                 `json:"withinCalendarHours,omitempty" structs:"withinCalendarHours,omitempty"`
+	GoalDuration        *RequestSLADuration  `json:"goalDuration,omitempty" structs:"goalDuration,omitempty"`
+	ElapsedTime         *RequestSLADuration  `json:"elapsedTime,omitempty" structs:"elapsedTime,omitempty"`
+	RemainingTime       *RequestSLADuration  `json:"remainingTime,omitempty" structs:"remainingTime,omitempty"`
+}
+
+// RequestSLADuration is a duration of an SLA cycle. Millis is negative for the remaining time of a breached SLA.
+type RequestSLADuration struct {
+	Millis   int64  `json:"millis" structs:"millis"`
+	Friendly string `json:"friendly,omitempty" structs:"friendly,omitempty"`
+}
+
+// Duration returns the duration as time.Duration
+func (d *RequestSLADuration) Duration() time.Duration {
+	return time.Duration(d.Millis) * time.Millisecond
+}
+
+// TimeToBreach returns the time left until the SLA is breached, which is negative if it is already breached.
+// It returns false if the cycle reports no remaining time, e.g. as the SLA has no goal.
+func (c *RequestSLACycle) TimeToBreach() (time.Duration, bool) {
+	if c.RemainingTime == nil {
+		return 0, false
+	}
+	return c.RemainingTime.Duration(), true
+}
+
+// RequestSLAList reflects a page of the SLAs of a customer request
+type RequestSLAList struct {
+	Size       int          `json:"size" structs:"size"`
+	Start      int          `json:"start" structs:"start"`
+	Limit      int          `json:"limit" structs:"limit"`
+	IsLastPage bool         `json:"isLastPage" structs:"isLastPage"`
+	Values     []RequestSLA `json:"values" structs:"values"`
+}
+
+// These constants are the decisions of an approval of a customer request
+const (
+	// ApprovalDecisionApprove and ApprovalDecisionDecline answer an approval
+	ApprovalDecisionApprove = "approve"
+	ApprovalDecisionDecline = "decline"
+
+	// ApprovalDecisionApproved, ApprovalDecisionDeclined and ApprovalDecisionPending are the decisions of approvals and approvers
+	ApprovalDecisionApproved = "approved"
+	ApprovalDecisionDeclined = "declined"
+	ApprovalDecisionPending  = "pending"
+)
+
+// Approval represents an approval of a customer request, which has to be answered by its approvers.
+// CompletedDate is only set once the approval has a FinalDecision other than ApprovalDecisionPending.
+type Approval struct {
+	ID                string                `json:"id,omitempty" structs:"id,omitempty"`
+	Name              string                `json:"name,omitempty" structs:"name,omitempty"`
+	FinalDecision     string                `json:"finalDecision,omitempty" structs:"finalDecision,omitempty"`
+	CanAnswerApproval bool                  `json:"canAnswerApproval,omitempty" structs:"canAnswerApproval,omitempty"`
+	Approvers         []Approver            `json:"approvers,omitempty" structs:"approvers,omitempty"`
+	CreatedDate       *CustomerRequestDate  `json:"createdDate,omitempty" structs:"createdDate,omitempty"`
+	CompletedDate     *CustomerRequestDate  `json:"completedDate,omitempty" structs:"completedDate,omitempty"`
+	Links             *CustomerRequestLinks `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// Approver is a user who has to answer an approval, with the decision of the user.
+type Approver struct {
+	Approver         *User  `json:"approver,omitempty" structs:"approver,omitempty"`
+	ApproverDecision string `json:"approverDecision,omitempty" structs:"approverDecision,omitempty"`
+}
+
+// ApprovalsList reflects a page of the approvals of a customer request
+type ApprovalsList struct {
+	Size       int        `json:"size" structs:"size"`
+	Start      int        `json:"start" structs:"start"`
+	Limit      int        `json:"limit" structs:"limit"`
+	IsLastPage bool       `json:"isLastPage" structs:"isLastPage"`
+	Values     []Approval `json:"values" structs:"values"`
+}
+
+// approvalAnswer is the request of the ServiceDeskService.AnswerApproval method
+type approvalAnswer struct {
+	Decision string `json:"decision"`
+}
+
+// CreateCustomerRequestWithContext raises a customer request on a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request-createCustomerRequest
+func (s *ServiceDeskService) CreateCustomerRequestWithContext(ctx context.Context, options *CreateCustomerRequestOptions) (*CustomerRequest, *Response, error) {
+	apiEndpoint := "rest/servicedeskapi/request"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := new(CustomerRequest)
+	resp, err := s.client.Do(req, request)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return request, resp, nil
+}
+
+// CreateCustomerRequest wraps CreateCustomerRequestWithContext using the background context.
+func (s *ServiceDeskService) CreateCustomerRequest(options *CreateCustomerRequestOptions) (*CustomerRequest, *Response, error) {
+	return s.CreateCustomerRequestWithContext(context.Background(), options)
+}
+
+// GetCustomerRequestWithContext returns the customer request for a given issue id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request-getCustomerRequestByIdOrKey
+func (s *ServiceDeskService) GetCustomerRequestWithContext(ctx context.Context, issueIDOrKey string, options *CustomerRequestGetOptions) (*CustomerRequest, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := new(CustomerRequest)
+	resp, err := s.client.Do(req, request)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return request, resp, nil
+}
+
+// GetCustomerRequest wraps GetCustomerRequestWithContext using the background context.
+func (s *ServiceDeskService) GetCustomerRequest(issueIDOrKey string, options *CustomerRequestGetOptions) (*CustomerRequest, *Response, error) {
+	return s.GetCustomerRequestWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// GetRequestParticipantsWithContext returns a page of the participants of a customer request.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/participant-getRequestParticipants
+func (s *ServiceDeskService) GetRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestParticipantsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/participant", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestParticipantsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetRequestParticipants wraps GetRequestParticipantsWithContext using the background context.
+func (s *ServiceDeskService) GetRequestParticipants(issueIDOrKey string, options *ServiceDeskListOptions) (*RequestParticipantsList, *Response, error) {
+	return s.GetRequestParticipantsWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// AddRequestParticipantsWithContext adds participants to a customer request and returns its participants.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/participant-addRequestParticipants
+func (s *ServiceDeskService) AddRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error) {
+	return s.updateRequestParticipants(ctx, "POST", issueIDOrKey, users)
+}
+
+// AddRequestParticipants wraps AddRequestParticipantsWithContext using the background context.
+func (s *ServiceDeskService) AddRequestParticipants(issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error) {
+	return s.AddRequestParticipantsWithContext(context.Background(), issueIDOrKey, users...)
+}
+
+// RemoveRequestParticipantsWithContext removes participants from a customer request and returns its remaining participants.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/participant-removeRequestParticipants
+func (s *ServiceDeskService) RemoveRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error) {
+	return s.updateRequestParticipants(ctx, "DELETE", issueIDOrKey, users)
+}
+
+// RemoveRequestParticipants wraps RemoveRequestParticipantsWithContext using the background context.
+func (s *ServiceDeskService) RemoveRequestParticipants(issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error) {
+	return s.RemoveRequestParticipantsWithContext(context.Background(), issueIDOrKey, users...)
+}
+
+// updateRequestParticipants adds (POST) or removes (DELETE) participants of a customer request.
+func (s *ServiceDeskService) updateRequestParticipants(ctx context.Context, method, issueIDOrKey string, users []string) (*RequestParticipantsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/participant", issueIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, method, apiEndpoint, s.users(ctx, users))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestParticipantsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetRequestSLAWithContext returns a page of the SLAs of a customer request with their ongoing and completed cycles.
+// The current user has to be an agent of the service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/sla-getSlaInformation
+func (s *ServiceDeskService) GetRequestSLAWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/sla", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestSLAList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetRequestSLA wraps GetRequestSLAWithContext using the background context.
+func (s *ServiceDeskService) GetRequestSLA(issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error) {
+	return s.GetRequestSLAWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// GetApprovalsWithContext returns a page of the approvals of a customer request.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/approval-getApprovals
+func (s *ServiceDeskService) GetApprovalsWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*ApprovalsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%s/approval", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ApprovalsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetApprovals wraps GetApprovalsWithContext using the background context.
+func (s *ServiceDeskService) GetApprovals(issueIDOrKey string, options *ServiceDeskListOptions) (*ApprovalsList, *Response, error) {
+	return s.GetApprovalsWithContext(context.Background(), issueIDOrKey, options)
+}
+
+// GetApprovalWithContext returns an approval of a customer request.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/approval-getApprovalById
+func (s *ServiceDeskService) GetApprovalWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/approval/%s", issueIDOrKey, approvalID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(Approval)
+	resp, err := s.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return approval, resp, nil
+}
+
+// GetApproval wraps GetApprovalWithContext using the background context.
+func (s *ServiceDeskService) GetApproval(issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	return s.GetApprovalWithContext(context.Background(), issueIDOrKey, approvalID)
+}
+
+// AnswerApprovalWithContext approves or declines an approval of a customer request as the current user,
+// who has to be one of its approvers. decision is ApprovalDecisionApprove or ApprovalDecisionDecline.
+// The approval is returned with its updated decisions.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/request/{issueIdOrKey}/approval-answerApproval
+func (s *ServiceDeskService) AnswerApprovalWithContext(ctx context.Context, issueIDOrKey, approvalID, decision string) (*Approval, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%s/approval/%s", issueIDOrKey, approvalID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &approvalAnswer{Decision: decision})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(Approval)
+	resp, err := s.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return approval, resp, nil
+}
+
+// AnswerApproval wraps AnswerApprovalWithContext using the background context.
+func (s *ServiceDeskService) AnswerApproval(issueIDOrKey, approvalID, decision string) (*Approval, *Response, error) {
+	return s.AnswerApprovalWithContext(context.Background(), issueIDOrKey, approvalID, decision)
+}
+
+// ApproveWithContext approves an approval of a customer request as the current user.
+func (s *ServiceDeskService) ApproveWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	return s.AnswerApprovalWithContext(ctx, issueIDOrKey, approvalID, ApprovalDecisionApprove)
+}
+
+// Approve wraps ApproveWithContext using the background context.
+func (s *ServiceDeskService) Approve(issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	return s.ApproveWithContext(context.Background(), issueIDOrKey, approvalID)
+}
+
+// DeclineWithContext declines an approval of a customer request as the current user.
+func (s *ServiceDeskService) DeclineWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	return s.AnswerApprovalWithContext(ctx, issueIDOrKey, approvalID, ApprovalDecisionDecline)
+}
+
+// Decline wraps DeclineWithContext using the background context.
+func (s *ServiceDeskService) Decline(issueIDOrKey, approvalID string) (*Approval, *Response, error) {
+	return s.DeclineWithContext(context.Background(), issueIDOrKey, approvalID)
+}
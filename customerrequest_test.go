@@ -0,0 +1,267 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServiceDeskService_CreateCustomerRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"serviceDeskId":"10","requestTypeId":"25","requestFieldValues":{"summary":"Request JSD help via REST"},"raiseOnBehalfOf":"qm:a713c8ea-1075-4e30-9d96-891a7d181739"}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issueId":"107001","issueKey":"HELPDESK-1","requestTypeId":"25","serviceDeskId":"10",
+			"createdDate":{"iso8601":"2015-10-08T14:05:00+0700","jira":"2015-10-08T14:05:00.000+0700","friendly":"Today 14:05 PM","epochMillis":1444287900000},
+			"reporter":{"accountId":"qm:a713c8ea-1075-4e30-9d96-891a7d181739","displayName":"Fred F. User"},
+			"requestFieldValues":[{"fieldId":"summary","label":"What do you need?","value":"Request JSD help via REST"}],
+			"currentStatus":{"status":"Waiting for Support","statusCategory":"NEW","statusDate":{"epochMillis":1444287900000}},
+			"_links":{"web":"https://your-domain.atlassian.net/servicedesk/customer/portal/10/HELPDESK-1"}}`)
+	})
+
+	request, _, err := testClient.ServiceDesk.CreateCustomerRequest(&CreateCustomerRequestOptions{
+		ServiceDeskID:      "10",
+		RequestTypeID:      "25",
+		RequestFieldValues: map[string]interface{}{"summary": "Request JSD help via REST"},
+		RaiseOnBehalfOf:    "qm:a713c8ea-1075-4e30-9d96-891a7d181739",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if request == nil || request.IssueKey != "HELPDESK-1" || request.CreatedDate.EpochMillis != 1444287900000 {
+		t.Fatalf("Expected request HELPDESK-1. Got %+v", request)
+	}
+	if request.CurrentStatus.Status != "Waiting for Support" || request.Links.Web == "" {
+		t.Errorf("Expected the status and portal link of the request. Got %+v and %+v", request.CurrentStatus, request.Links)
+	}
+	if request.RequestFieldValues[0].Value != "Request JSD help via REST" {
+		t.Errorf("Expected the summary of the request. Got %+v", request.RequestFieldValues)
+	}
+}
+
+func TestServiceDeskService_GetCustomerRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=participant%2Cstatus")
+		fmt.Fprint(w, `{"issueId":"107001","issueKey":"HELPDESK-1","requestTypeId":"25","serviceDeskId":"10"}`)
+	})
+
+	request, _, err := testClient.ServiceDesk.GetCustomerRequest("HELPDESK-1", &CustomerRequestGetOptions{Expand: "participant,status"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if request == nil || request.IssueID != "107001" {
+		t.Errorf("Expected request 107001. Got %+v", request)
+	}
+}
+
+func TestServiceDeskService_GetRequestParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?limit=10")
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":10,"isLastPage":true,"values":[{"accountId":"qm:a713c8ea","displayName":"Fred F. User"}]}`)
+	})
+
+	participants, _, err := testClient.ServiceDesk.GetRequestParticipants("HELPDESK-1", &ServiceDeskListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 1 || participants.Values[0].AccountID != "qm:a713c8ea" {
+		t.Errorf("Expected participant Fred. Got %+v", participants)
+	}
+}
+
+func TestServiceDeskService_AddRequestParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string][]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if len(payload["accountIds"]) != 2 {
+			t.Errorf("Expected 2 account ids. Got %+v", payload)
+		}
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[{"accountId":"qm:1"},{"accountId":"qm:2"}]}`)
+	})
+
+	participants, _, err := testClient.ServiceDesk.AddRequestParticipants("HELPDESK-1", "qm:1", "qm:2")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 2 {
+		t.Errorf("Expected 2 participants. Got %+v", participants)
+	}
+}
+
+func TestServiceDeskService_RemoveRequestParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"usernames":["fred"]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"size":0,"start":0,"limit":50,"isLastPage":true,"values":[]}`)
+	})
+
+	participants, _, err := testClient.ServiceDesk.RemoveRequestParticipants("HELPDESK-1", "fred")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 0 {
+		t.Errorf("Expected no participants. Got %+v", participants)
+	}
+}
+
+func TestServiceDeskService_GetRequestSLA(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/sla"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[
+			{"id":"1","name":"Time to first response",
+				"ongoingCycle":{"startTime":{"epochMillis":1444287900000},"breachTime":{"epochMillis":1444316700000},"breached":false,"paused":false,"withinCalendarHours":true,
+					"goalDuration":{"millis":28800000,"friendly":"8h"},"elapsedTime":{"millis":14400000,"friendly":"4h"},"remainingTime":{"millis":14400000,"friendly":"4h"}}},
+			{"id":"2","name":"Time to resolution",
+				"completedCycles":[{"startTime":{"epochMillis":1444287900000},"stopTime":{"epochMillis":1444374300000},"breached":true,
+					"goalDuration":{"millis":57600000},"elapsedTime":{"millis":86400000},"remainingTime":{"millis":-28800000,"friendly":"-8h"}}]}]}`)
+	})
+
+	slas, _, err := testClient.ServiceDesk.GetRequestSLA("HELPDESK-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if slas == nil || len(slas.Values) != 2 {
+		t.Fatalf("Expected 2 SLAs. Got %+v", slas)
+	}
+
+	ongoing := slas.Values[0].OngoingCycle
+	if timeToBreach, ok := ongoing.TimeToBreach(); !ok || timeToBreach != 4*time.Hour {
+		t.Errorf("Expected 4h to breach. Got %v", timeToBreach)
+	}
+	completed := slas.Values[1].CompletedCycles[0]
+	if timeToBreach, _ := completed.TimeToBreach(); !completed.Breached || timeToBreach != -8*time.Hour {
+		t.Errorf("Expected the SLA to be breached by 8h. Got %v", timeToBreach)
+	}
+	if _, ok := (&RequestSLACycle{}).TimeToBreach(); ok {
+		t.Error("Expected no time to breach without remaining time")
+	}
+}
+
+func TestServiceDeskService_GetApprovals(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[
+			{"id":"1","name":"Please approve my request","finalDecision":"pending","canAnswerApproval":true,
+				"approvers":[{"approver":{"accountId":"qm:a713c8ea","displayName":"Fred F. User"},"approverDecision":"pending"}],
+				"createdDate":{"epochMillis":1444287900000}}]}`)
+	})
+
+	approvals, _, err := testClient.ServiceDesk.GetApprovals("HELPDESK-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if approvals == nil || len(approvals.Values) != 1 {
+		t.Fatalf("Expected 1 approval. Got %+v", approvals)
+	}
+	approval := approvals.Values[0]
+	if approval.FinalDecision != ApprovalDecisionPending || !approval.CanAnswerApproval || approval.Approvers[0].Approver.AccountID != "qm:a713c8ea" {
+		t.Errorf("Expected a pending approval of Fred. Got %+v", approval)
+	}
+}
+
+func TestServiceDeskService_GetApproval(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"1","name":"Please approve my request","finalDecision":"pending"}`)
+	})
+
+	approval, _, err := testClient.ServiceDesk.GetApproval("HELPDESK-1", "1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if approval == nil || approval.ID != "1" {
+		t.Errorf("Expected approval 1. Got %+v", approval)
+	}
+}
+
+func TestServiceDeskService_AnswerApproval(t *testing.T) {
+	for _, test := range []struct {
+		answer   func(s *ServiceDeskService, issueIDOrKey, approvalID string) (*Approval, *Response, error)
+		decision string
+		result   string
+	}{
+		{(*ServiceDeskService).Approve, ApprovalDecisionApprove, ApprovalDecisionApproved},
+		{(*ServiceDeskService).Decline, ApprovalDecisionDecline, ApprovalDecisionDeclined},
+	} {
+		setup()
+		testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval/1"
+
+		testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testRequestURL(t, r, testAPIEndpoint)
+
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"decision":"`+test.decision+`"}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			fmt.Fprintf(w, `{"id":"1","finalDecision":"%s","completedDate":{"epochMillis":1444287900000}}`, test.result)
+		})
+
+		approval, _, err := test.answer(testClient.ServiceDesk, "HELPDESK-1", "1")
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if approval == nil || approval.FinalDecision != test.result {
+			t.Errorf("Expected final decision %s. Got %+v", test.result, approval)
+		}
+		teardown()
+	}
+}
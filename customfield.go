@@ -0,0 +1,145 @@
+package jira
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// CascadingOption represents the value of a cascading select custom field:
+// an option of the first level and the chosen option of the second level.
+type CascadingOption struct {
+	ID    string  `json:"id,omitempty" structs:"id,omitempty"`
+	Value string  `json:"value,omitempty" structs:"value,omitempty"`
+	Child *Option `json:"child,omitempty" structs:"child,omitempty"`
+}
+
+// The custom field helpers below read and write custom fields like "customfield_10123" in the Unknowns of the IssueFields.
+// Those are sent to JIRA next to the known fields, so no map[string]interface{} payloads have to be built by hand.
+// The getters return false if the field is not set or its value has a different type.
+
+// SetCustomField sets the custom field with the given id to value, which is sent to JIRA as is.
+func (i *IssueFields) SetCustomField(id string, value interface{}) {
+	if i.Unknowns == nil {
+		i.Unknowns = tcontainer.NewMarshalMap()
+	}
+	i.Unknowns[id] = value
+}
+
+// customField decodes the value of the custom field with the given id into v.
+func (i *IssueFields) customField(id string, v interface{}) bool {
+	value, ok := i.Unknowns[id]
+	if !ok || value == nil {
+		return false
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// SetCustomFieldString sets a text custom field.
+func (i *IssueFields) SetCustomFieldString(id string, value string) {
+	i.SetCustomField(id, value)
+}
+
+// CustomFieldString returns the value of a text custom field.
+func (i *IssueFields) CustomFieldString(id string) (string, bool) {
+	var value string
+	ok := i.customField(id, &value)
+	return value, ok
+}
+
+// SetCustomFieldNumber sets a number custom field.
+func (i *IssueFields) SetCustomFieldNumber(id string, value float64) {
+	i.SetCustomField(id, value)
+}
+
+// CustomFieldNumber returns the value of a number custom field.
+func (i *IssueFields) CustomFieldNumber(id string) (float64, bool) {
+	var value float64
+	ok := i.customField(id, &value)
+	return value, ok
+}
+
+// SetCustomFieldOption sets a select list custom field to the option with the given value.
+func (i *IssueFields) SetCustomFieldOption(id string, value string) {
+	i.SetCustomField(id, &Option{Value: value})
+}
+
+// CustomFieldOption returns the chosen option of a select list custom field.
+func (i *IssueFields) CustomFieldOption(id string) (*Option, bool) {
+	value := new(Option)
+	if !i.customField(id, value) {
+		return nil, false
+	}
+	return value, true
+}
+
+// SetCustomFieldOptions sets a multi select custom field to the options with the given values.
+func (i *IssueFields) SetCustomFieldOptions(id string, values ...string) {
+	options := make([]Option, 0, len(values))
+	for _, value := range values {
+		options = append(options, Option{Value: value})
+	}
+	i.SetCustomField(id, options)
+}
+
+// CustomFieldOptions returns the chosen options of a multi select custom field.
+func (i *IssueFields) CustomFieldOptions(id string) ([]Option, bool) {
+	var values []Option
+	ok := i.customField(id, &values)
+	return values, ok
+}
+
+// SetCustomFieldUser sets a user picker custom field.
+// The user is identified by its AccountID, or its Name if there is no account id.
+func (i *IssueFields) SetCustomFieldUser(id string, user *User) {
+	if user.AccountID != "" {
+		i.SetCustomField(id, map[string]string{"accountId": user.AccountID})
+		return
+	}
+	i.SetCustomField(id, map[string]string{"name": user.Name})
+}
+
+// CustomFieldUser returns the user of a user picker custom field.
+func (i *IssueFields) CustomFieldUser(id string) (*User, bool) {
+	value := new(User)
+	if !i.customField(id, value) {
+		return nil, false
+	}
+	return value, true
+}
+
+// SetCustomFieldDate sets a date picker custom field.
+func (i *IssueFields) SetCustomFieldDate(id string, value time.Time) {
+	i.SetCustomField(id, Date(value))
+}
+
+// CustomFieldDate returns the value of a date picker custom field.
+func (i *IssueFields) CustomFieldDate(id string) (time.Time, bool) {
+	var value Date
+	ok := i.customField(id, &value)
+	return time.Time(value), ok
+}
+
+// SetCustomFieldCascadingOption sets a cascading select custom field to the option parent and its child option child.
+// An empty child only sets the option of the first level.
+func (i *IssueFields) SetCustomFieldCascadingOption(id string, parent, child string) {
+	value := &CascadingOption{Value: parent}
+	if child != "" {
+		value.Child = &Option{Value: child}
+	}
+	i.SetCustomField(id, value)
+}
+
+// CustomFieldCascadingOption returns the chosen options of a cascading select custom field.
+func (i *IssueFields) CustomFieldCascadingOption(id string) (*CascadingOption, bool) {
+	value := new(CascadingOption)
+	if !i.customField(id, value) {
+		return nil, false
+	}
+	return value, true
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestIssueFields_CustomFields_Marshal(t *testing.T) {
+	fields := &IssueFields{Summary: "Outage"}
+	fields.SetCustomFieldString("customfield_10001", "text")
+	fields.SetCustomFieldNumber("customfield_10002", 3.5)
+	fields.SetCustomFieldOption("customfield_10003", "Billing")
+	fields.SetCustomFieldOptions("customfield_10004", "Red", "Blue")
+	fields.SetCustomFieldUser("customfield_10005", &User{AccountID: "5b10a2844c20165700ede21g"})
+	fields.SetCustomFieldDate("customfield_10006", time.Date(2020, time.March, 4, 0, 0, 0, 0, time.UTC))
+	fields.SetCustomFieldCascadingOption("customfield_10007", "Europe", "Germany")
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	expected := map[string]string{
+		"customfield_10001": `"text"`,
+		"customfield_10002": `3.5`,
+		"customfield_10003": `{"value":"Billing"}`,
+		"customfield_10004": `[{"value":"Red"},{"value":"Blue"}]`,
+		"customfield_10005": `{"accountId":"5b10a2844c20165700ede21g"}`,
+		"customfield_10006": `"2020-03-04"`,
+		"customfield_10007": `{"child":{"value":"Germany"},"value":"Europe"}`,
+	}
+	for id, want := range expected {
+		got, _ := json.Marshal(m[id])
+		if string(got) != want {
+			t.Errorf("Expected %s to be %s. Got %s", id, want, got)
+		}
+	}
+	if m["summary"] != "Outage" {
+		t.Errorf("Expected summary Outage. Got %v", m["summary"])
+	}
+}
+
+func TestIssueFields_CustomFields_Unmarshal(t *testing.T) {
+	data := []byte(`{
+		"summary": "Outage",
+		"customfield_10001": "text",
+		"customfield_10002": 3.5,
+		"customfield_10003": {"self":"https://my.jira.com/rest/api/2/customFieldOption/10200","value":"Billing","id":"10200"},
+		"customfield_10004": [{"value":"Red","id":"1"},{"value":"Blue","id":"2"}],
+		"customfield_10005": {"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User"},
+		"customfield_10006": "2020-03-04",
+		"customfield_10007": {"value":"Europe","id":"10300","child":{"value":"Germany","id":"10301"}},
+		"customfield_10008": null
+	}`)
+	fields := new(IssueFields)
+	if err := json.Unmarshal(data, fields); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if value, ok := fields.CustomFieldString("customfield_10001"); !ok || value != "text" {
+		t.Errorf("Expected text. Got %v", value)
+	}
+	if value, ok := fields.CustomFieldNumber("customfield_10002"); !ok || value != 3.5 {
+		t.Errorf("Expected 3.5. Got %v", value)
+	}
+	if value, ok := fields.CustomFieldOption("customfield_10003"); !ok || value.Value != "Billing" {
+		t.Errorf("Expected option Billing. Got %+v", value)
+	}
+	if values, ok := fields.CustomFieldOptions("customfield_10004"); !ok || len(values) != 2 || values[1].Value != "Blue" {
+		t.Errorf("Expected options Red and Blue. Got %+v", values)
+	}
+	if user, ok := fields.CustomFieldUser("customfield_10005"); !ok || user.DisplayName != "Fred F. User" {
+		t.Errorf("Expected user Fred. Got %+v", user)
+	}
+	if date, ok := fields.CustomFieldDate("customfield_10006"); !ok || !date.Equal(time.Date(2020, time.March, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2020-03-04. Got %v", date)
+	}
+	if value, ok := fields.CustomFieldCascadingOption("customfield_10007"); !ok || value.Value != "Europe" || value.Child.Value != "Germany" {
+		t.Errorf("Expected Europe / Germany. Got %+v", value)
+	}
+
+	if _, ok := fields.CustomFieldString("customfield_10008"); ok {
+		t.Error("Expected unset field to be reported as missing")
+	}
+	if _, ok := fields.CustomFieldNumber("customfield_10001"); ok {
+		t.Error("Expected text field not to be read as number")
+	}
+}
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// DashboardService handles dashboards for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard
+type DashboardService struct {
+	client *Client
+}
+
+// Dashboard represents a JIRA dashboard
+type Dashboard struct {
+	ID               string            `json:"id" structs:"id"`
+	Name             string            `json:"name" structs:"name"`
+	Self             string            `json:"self" structs:"self"`
+	View             string            `json:"view" structs:"view"`
+	IsFavourite      bool              `json:"isFavourite,omitempty" structs:"isFavourite,omitempty"`
+	Owner            *User             `json:"owner,omitempty" structs:"owner,omitempty"`
+	SharePermissions []SharePermission `json:"sharePermissions,omitempty" structs:"sharePermissions,omitempty"`
+}
+
+// DashboardListOptions specifies the optional parameters to the DashboardService.GetList
+type DashboardListOptions struct {
+	// Filter restricts the dashboards to the favourite ("favourite") or owned ("my") dashboards of the current user.
+	Filter string `url:"filter,omitempty"`
+	// StartAt is the index of the first dashboard to return. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of dashboards to return per page. Default: 20.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// dashboardListResult is a single page of dashboards
+type dashboardListResult struct {
+	StartAt    int         `json:"startAt" structs:"startAt"`
+	MaxResults int         `json:"maxResults" structs:"maxResults"`
+	Total      int         `json:"total" structs:"total"`
+	Dashboards []Dashboard `json:"dashboards" structs:"dashboards"`
+}
+
+// GetListWithContext returns a page of the dashboards visible to the current user.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard-list
+func (s *DashboardService) GetListWithContext(ctx context.Context, options *DashboardListOptions) ([]Dashboard, *Response, error) {
+	apiEndpoint := "rest/api/2/dashboard"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(dashboardListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Dashboards, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *DashboardService) GetList(options *DashboardListOptions) ([]Dashboard, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the dashboard for a given dashboard id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard-getDashboard
+func (s *DashboardService) GetWithContext(ctx context.Context, dashboardID string) (*Dashboard, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s", dashboardID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.Do(req, dashboard)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return dashboard, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *DashboardService) Get(dashboardID string) (*Dashboard, *Response, error) {
+	return s.GetWithContext(context.Background(), dashboardID)
+}
+
+// GetItemPropertyKeysWithContext returns the keys of the properties of a dashboard item.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard/{dashboardId}/items/{itemId}/properties-getPropertiesKeys
+func (s *DashboardService) GetItemPropertyKeysWithContext(ctx context.Context, dashboardID, itemID string) ([]EntityPropertyKey, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties", dashboardID, itemID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(entityPropertyKeys)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Keys, resp, nil
+}
+
+// GetItemPropertyKeys wraps GetItemPropertyKeysWithContext using the background context.
+func (s *DashboardService) GetItemPropertyKeys(dashboardID, itemID string) ([]EntityPropertyKey, *Response, error) {
+	return s.GetItemPropertyKeysWithContext(context.Background(), dashboardID, itemID)
+}
+
+// GetItemPropertyWithContext returns a property of a dashboard item.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard/{dashboardId}/items/{itemId}/properties-getProperty
+func (s *DashboardService) GetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return property, resp, nil
+}
+
+// GetItemProperty wraps GetItemPropertyWithContext using the background context.
+func (s *DashboardService) GetItemProperty(dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error) {
+	return s.GetItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey)
+}
+
+// SetItemPropertyWithContext creates or replaces a property of a dashboard item.
+// value is encoded as JSON.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard/{dashboardId}/items/{itemId}/properties-setProperty
+func (s *DashboardService) SetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// SetItemProperty wraps SetItemPropertyWithContext using the background context.
+func (s *DashboardService) SetItemProperty(dashboardID, itemID, propertyKey string, value interface{}) (*Response, error) {
+	return s.SetItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey, value)
+}
+
+// DeleteItemPropertyWithContext removes a property from a dashboard item.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/dashboard/{dashboardId}/items/{itemId}/properties-deleteProperty
+func (s *DashboardService) DeleteItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/dashboard/%s/items/%s/properties/%s", dashboardID, itemID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// DeleteItemProperty wraps DeleteItemPropertyWithContext using the background context.
+func (s *DashboardService) DeleteItemProperty(dashboardID, itemID, propertyKey string) (*Response, error) {
+	return s.DeleteItemPropertyWithContext(context.Background(), dashboardID, itemID, propertyKey)
+}
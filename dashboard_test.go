@@ -0,0 +1,132 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDashboardService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?filter=my&maxResults=10")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":10,"total":2,"dashboards":[{"id":"10000","name":"System Dashboard","self":"http://www.example.com/jira/rest/api/2/dashboard/10000","view":"http://www.example.com/jira/secure/Dashboard.jspa?selectPageId=10000"},{"id":"20000","name":"Build Dashboard","self":"http://www.example.com/jira/rest/api/2/dashboard/20000","view":"http://www.example.com/jira/secure/Dashboard.jspa?selectPageId=20000"}]}`)
+	})
+
+	dashboards, resp, err := testClient.Dashboard.GetList(&DashboardListOptions{Filter: "my", MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(dashboards) != 2 || dashboards[1].Name != "Build Dashboard" {
+		t.Errorf("Expected 2 dashboards. Got %+v", dashboards)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total of 2. Got %d", resp.Total)
+	}
+}
+
+func TestDashboardService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10000","name":"System Dashboard","self":"http://www.example.com/jira/rest/api/2/dashboard/10000","view":"http://www.example.com/jira/secure/Dashboard.jspa?selectPageId=10000","sharePermissions":[{"type":"global"}]}`)
+	})
+
+	dashboard, _, err := testClient.Dashboard.Get("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if dashboard == nil || len(dashboard.SharePermissions) != 1 || dashboard.SharePermissions[0].Type != SharePermissionTypeGlobal {
+		t.Errorf("Expected globally shared dashboard. Got %+v", dashboard)
+	}
+}
+
+func TestDashboardService_GetItemPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/20/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"http://www.example.com/jira/rest/api/2/dashboard/10000/items/20/properties/config","key":"config"}]}`)
+	})
+
+	keys, _, err := testClient.Dashboard.GetItemPropertyKeys("10000", "20")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Key != "config" {
+		t.Errorf("Expected property key config. Got %+v", keys)
+	}
+}
+
+func TestDashboardService_GetItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/20/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"config","value":{"refresh":15}}`)
+	})
+
+	property, _, err := testClient.Dashboard.GetItemProperty("10000", "20", "config")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if !ok || value["refresh"] != float64(15) {
+		t.Errorf("Expected refresh of 15. Got %+v", property.Value)
+	}
+}
+
+func TestDashboardService_SetItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/20/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["refresh"] != 30 {
+			t.Errorf("Expected refresh of 30 in payload, got %v instead", payload)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Dashboard.SetItemProperty("10000", "20", "config", map[string]int{"refresh": 30}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDashboardService_DeleteItemProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/dashboard/10000/items/20/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Dashboard.DeleteItemProperty("10000", "20", "config"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
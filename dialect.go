@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Dialect is the variant of the JIRA REST API a Client talks.
+// JIRA Cloud and JIRA Server / Data Center differ in the version of the REST API and in how users are identified.
+// Rich text like descriptions and comments is not affected by the dialect:
+// the V3 methods of IssueService send and return it in the Atlassian Document Format, all others in wiki markup.
+type Dialect int
+
+const (
+	// DialectAuto detects the dialect from the server info of the JIRA instance on first use.
+	DialectAuto Dialect = iota
+	// DialectCloud is the API of JIRA Cloud: version 3 of the REST API and users identified by account id.
+	DialectCloud
+	// DialectServer is the API of JIRA Server and JIRA Data Center: version 2 of the REST API and users identified by username.
+	DialectServer
+)
+
+// String returns the name of the dialect
+func (d Dialect) String() string {
+	switch d {
+	case DialectCloud:
+		return "Cloud"
+	case DialectServer:
+		return "Server"
+	default:
+		return "Auto"
+	}
+}
+
+// APIBase returns the path of the REST API in the dialect, e.g. /rest/api/3 for JIRA Cloud
+func (d Dialect) APIBase() string {
+	if d == DialectServer {
+		return "/rest/api/2"
+	}
+	return restAPIBase
+}
+
+// UsesAccountID reports whether users are identified by their account id instead of their username
+func (d Dialect) UsesAccountID() bool {
+	return d != DialectServer
+}
+
+// UserQuery returns the query parameters identifying the user in the dialect:
+// the account id on JIRA Cloud and the username on JIRA Server.
+func (d Dialect) UserQuery(user *User) url.Values {
+	if d.UsesAccountID() {
+		return url.Values{d.userParam(): []string{user.AccountID}}
+	}
+	return url.Values{d.userParam(): []string{user.Name}}
+}
+
+// userParam returns the name of the parameter identifying users in the dialect
+func (d Dialect) userParam() string {
+	if d.UsesAccountID() {
+		return "accountId"
+	}
+	return "username"
+}
+
+// DetectDialectWithContext returns the dialect of the REST API used by the client.
+// If the Dialect of the client is DialectAuto, it is detected from the server info of the JIRA instance.
+// The detected dialect is remembered, so the server info is only requested once.
+// So is the error if JIRA refused the server info, e.g. because it requires authentication;
+// set the Dialect of the client to avoid the request.
+func (c *Client) DetectDialectWithContext(ctx context.Context) (Dialect, error) {
+	if c.Dialect != DialectAuto {
+		return c.Dialect, nil
+	}
+
+	c.dialectMu.Lock()
+	dialect, err := c.detectedDialect, c.dialectErr
+	c.dialectMu.Unlock()
+	if dialect != DialectAuto || err != nil {
+		return dialect, err
+	}
+
+	// The mutex is not held during the request, concurrent first calls may both request the server info
+	info, resp, err := c.ServerInfo.GetWithContext(ctx, nil)
+	if err != nil {
+		if resp != nil {
+			c.dialectMu.Lock()
+			c.dialectErr = err
+			c.dialectMu.Unlock()
+		}
+		return DialectAuto, err
+	}
+	dialect = DialectServer
+	if info.IsCloud() {
+		dialect = DialectCloud
+	}
+	c.dialectMu.Lock()
+	c.detectedDialect = dialect
+	c.dialectMu.Unlock()
+	return dialect, nil
+}
+
+// DetectDialect wraps DetectDialectWithContext using the background context.
+func (c *Client) DetectDialect() (Dialect, error) {
+	return c.DetectDialectWithContext(context.Background())
+}
+
+// dialect returns the dialect of the REST API used by the client.
+// JIRA Cloud is assumed if the dialect cannot be detected, the API the client was written against.
+func (c *Client) dialect(ctx context.Context) Dialect {
+	d, err := c.DetectDialectWithContext(ctx)
+	if err != nil {
+		return DialectCloud
+	}
+	return d
+}
+
+// apiBase returns the path of the REST API in the dialect used by the client,
+// or in the version the client was created with.
+func (c *Client) apiBase(ctx context.Context) string {
+	if c.apiVersion != 0 {
+		return fmt.Sprintf("/rest/api/%d", c.apiVersion)
+	}
+	return c.dialect(ctx).APIBase()
+}
@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_DetectDialect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testClient.Dialect = DialectAuto
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		fmt.Fprint(w, `{"version":"8.5.4","deploymentType":"Server"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		dialect, err := testClient.DetectDialect()
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if dialect != DialectServer {
+			t.Errorf("Expected dialect Server. Got %s", dialect)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the server info to be requested once. Got %d requests", requests)
+	}
+}
+
+func TestClient_DetectDialect_Explicit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the server info not to be requested")
+	})
+
+	testClient.Dialect = DialectCloud
+	dialect, err := testClient.DetectDialect()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if dialect != DialectCloud {
+		t.Errorf("Expected dialect Cloud. Got %s", dialect)
+	}
+}
+
+func TestClient_DetectDialect_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testClient.Dialect = DialectAuto
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if _, err := testClient.DetectDialect(); err == nil {
+		t.Error("Expected an error")
+	}
+	for i := 0; i < 3; i++ {
+		if dialect := testClient.dialect(context.Background()); dialect != DialectCloud {
+			t.Errorf("Expected fallback to dialect Cloud. Got %s", dialect)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the failed server info to be requested once. Got %d requests", requests)
+	}
+}
+
+func TestDialect_Server(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testClient.Dialect = DialectAuto
+
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version":"8.5.4","deploymentType":"Server"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user?username=fred")
+		fmt.Fprint(w, `{"name":"fred","displayName":"Fred F. User"}`)
+	})
+
+	user, _, err := testClient.User.GetUser(&User{Name: "fred", AccountID: "5b10a2844c20165700ede21g"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if user == nil || user.DisplayName != "Fred F. User" {
+		t.Errorf("Expected user Fred. Got %+v", user)
+	}
+}
+
+func TestDialect_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/user?accountId=5b10a2844c20165700ede21g")
+		fmt.Fprint(w, `{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User"}`)
+	})
+
+	user, _, err := testClient.User.GetUser(&User{Name: "fred", AccountID: "5b10a2844c20165700ede21g"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if user == nil || user.DisplayName != "Fred F. User" {
+		t.Errorf("Expected user Fred. Got %+v", user)
+	}
+}
+
+func TestDialect_Features(t *testing.T) {
+	if DialectServer.APIBase() != "/rest/api/2" || DialectCloud.APIBase() != "/rest/api/3" {
+		t.Error("Unexpected API base")
+	}
+	if DialectServer.UsesAccountID() || !DialectCloud.UsesAccountID() {
+		t.Error("Expected only Cloud to identify users by account id")
+	}
+}
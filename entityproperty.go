@@ -0,0 +1,20 @@
+package jira
+
+// EntityProperty is a key-value pair stored by apps and integrations on a JIRA entity,
+// e.g. a dashboard item, a project or a workflow transition.
+// Value holds the decoded JSON value.
+type EntityProperty struct {
+	Key   string      `json:"key" structs:"key"`
+	Value interface{} `json:"value" structs:"value"`
+}
+
+// EntityPropertyKey references a property of a JIRA entity
+type EntityPropertyKey struct {
+	Self string `json:"self" structs:"self"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// entityPropertyKeys is the list of the property keys of a JIRA entity
+type entityPropertyKeys struct {
+	Keys []EntityPropertyKey `json:"keys" structs:"keys"`
+}
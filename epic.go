@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// EpicService handles epics in JIRA Agile API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic
+type EpicService struct {
+	client *Client
+}
+
+// EpicRankOptions specifies where to rank an epic.
+// Either RankBeforeEpic or RankAfterEpic must be set.
+type EpicRankOptions struct {
+	RankBeforeEpic    string `json:"rankBeforeEpic,omitempty" structs:"rankBeforeEpic,omitempty"`
+	RankAfterEpic     string `json:"rankAfterEpic,omitempty" structs:"rankAfterEpic,omitempty"`
+	RankCustomFieldID int    `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// GetWithContext returns the epic for a given epic Id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-getEpic
+func (s *EpicService) GetWithContext(ctx context.Context, epicIDOrKey string) (*Epic, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epic := new(Epic)
+	resp, err := s.client.Do(req, epic)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return epic, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *EpicService) Get(epicIDOrKey string) (*Epic, *Response, error) {
+	return s.GetWithContext(context.Background(), epicIDOrKey)
+}
+
+// GetIssuesWithContext returns the issues that belong to the epic for a given epic Id or key.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-getIssuesForEpic
+func (s *EpicService) GetIssuesWithContext(ctx context.Context, epicIDOrKey string, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue", epicIDOrKey)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(searchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Issues, resp, nil
+}
+
+// GetIssues wraps GetIssuesWithContext using the background context.
+func (s *EpicService) GetIssues(epicIDOrKey string, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesWithContext(context.Background(), epicIDOrKey, options)
+}
+
+// MoveIssuesToEpicWithContext moves issues to an epic, for a given epic Id or key.
+// Issues that are already in an epic are moved to the new one.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-moveIssuesToEpic
+func (s *EpicService) MoveIssuesToEpicWithContext(ctx context.Context, epicIDOrKey string, issueIDs []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue", epicIDOrKey)
+
+	payload := IssuesWrapper{Issues: issueIDs}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// MoveIssuesToEpic wraps MoveIssuesToEpicWithContext using the background context.
+func (s *EpicService) MoveIssuesToEpic(epicIDOrKey string, issueIDs []string) (*Response, error) {
+	return s.MoveIssuesToEpicWithContext(context.Background(), epicIDOrKey, issueIDs)
+}
+
+// RemoveIssuesFromEpicWithContext removes issues from the epics they belong to.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-removeIssuesFromEpic
+func (s *EpicService) RemoveIssuesFromEpicWithContext(ctx context.Context, issueIDs []string) (*Response, error) {
+	apiEndpoint := "rest/agile/1.0/epic/none/issue"
+
+	payload := IssuesWrapper{Issues: issueIDs}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// RemoveIssuesFromEpic wraps RemoveIssuesFromEpicWithContext using the background context.
+func (s *EpicService) RemoveIssuesFromEpic(issueIDs []string) (*Response, error) {
+	return s.RemoveIssuesFromEpicWithContext(context.Background(), issueIDs)
+}
+
+// RankWithContext moves the epic given by epicIDOrKey before or after another epic.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/epic-rankEpics
+func (s *EpicService) RankWithContext(ctx context.Context, epicIDOrKey string, options *EpicRankOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/rank", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// Rank wraps RankWithContext using the background context.
+func (s *EpicService) Rank(epicIDOrKey string, options *EpicRankOptions) (*Response, error) {
+	return s.RankWithContext(context.Background(), epicIDOrKey, options)
+}
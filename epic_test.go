@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestEpicService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EPIC-77"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":19415,"key":"EPIC-77","self":"https://example.atlassian.net/rest/agile/1.0/epic/19415","name":"Epic Name","summary":"Do it","color":{"key":"color_11"},"done":false}`)
+	})
+
+	epic, _, err := testClient.Epic.Get("EPIC-77")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epic == nil || epic.ID != 19415 || epic.Name != "Epic Name" {
+		t.Errorf("Expected epic 19415. Got %+v", epic)
+	}
+}
+
+func TestEpicService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EPIC-77/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?startAt=1")
+		fmt.Fprint(w, `{"startAt":1,"maxResults":50,"total":2,"issues":[{"id":"10002","key":"EX-2"}]}`)
+	})
+
+	issues, resp, err := testClient.Epic.GetIssues("EPIC-77", &BoardIssuesOptions{StartAt: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue. Got %d", len(issues))
+	}
+	if resp.StartAt != 1 || resp.Total != 2 {
+		t.Errorf("Expected page starting at 1 of 2. Got %d of %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestEpicService_MoveIssuesToEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EPIC-77/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload.Issues) != 2 || payload.Issues[1] != "EX-2" {
+			t.Errorf("Expected EX-1 and EX-2 in payload, got %v instead", payload.Issues)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.MoveIssuesToEpic("EPIC-77", []string{"EX-1", "EX-2"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_RemoveIssuesFromEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/none/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.RemoveIssuesFromEpic([]string{"EX-1"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_Rank(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EPIC-77/rank"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload EpicRankOptions
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.RankBeforeEpic != "EPIC-78" {
+			t.Errorf("Expected rankBeforeEpic EPIC-78, got %s instead", payload.RankBeforeEpic)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.Rank("EPIC-77", &EpicRankOptions{RankBeforeEpic: "EPIC-78"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -12,9 +14,86 @@ import (
 // Error message from JIRA
 // See https://docs.atlassian.com/jira/REST/cloud/#error-responses
 type Error struct {
-	HTTPError     error
-	ErrorMessages []string          `json:"errorMessages"`
-	Errors        map[string]string `json:"errors"`
+	HTTPError error
+	// HTTPStatusCode is the status code of the response JIRA answered with.
+	// It is 0 if no response was received.
+	HTTPStatusCode int               `json:"-"`
+	ErrorMessages  []string          `json:"errorMessages"`
+	Errors         map[string]string `json:"errors"`
+	// LoginError is set if JIRA refused to authenticate the request, e.g. because it requires a CAPTCHA.
+	LoginError *LoginError `json:"-"`
+}
+
+// These constants are the reasons JIRA Server gives in the X-Seraph-LoginReason header, as found in LoginError.Reason
+const (
+	LoginReasonOK                   = "OK"
+	LoginReasonOut                  = "OUT"
+	LoginReasonAuthenticationFailed = "AUTHENTICATED_FAILED"
+	LoginReasonAuthenticationDenied = "AUTHENTICATION_DENIED"
+	LoginReasonAuthorizationFailed  = "AUTHORIZATION_FAILED"
+)
+
+// DeniedReasonCaptchaChallenge is the reason in the X-Authentication-Denied-Reason header
+// when JIRA requires the user to solve a CAPTCHA after too many failed logins
+const DeniedReasonCaptchaChallenge = "CAPTCHA_CHALLENGE"
+
+// LoginError describes why JIRA refused to authenticate a request,
+// from the X-Seraph-LoginReason and X-Authentication-Denied-Reason headers of its response.
+type LoginError struct {
+	// Reason is the login reason, e.g. LoginReasonAuthenticationDenied
+	Reason string
+	// DeniedReason is the reason the authentication was denied, e.g. DeniedReasonCaptchaChallenge
+	DeniedReason string
+	// LoginURL is the page to log in at in a browser, e.g. to solve the CAPTCHA
+	LoginURL string
+}
+
+// CaptchaRequired reports whether JIRA denies the authentication until the user solved a CAPTCHA in a browser.
+func (e *LoginError) CaptchaRequired() bool {
+	return e.DeniedReason == DeniedReasonCaptchaChallenge
+}
+
+// Error describes the login error and, if a CAPTCHA is required, tells the user where to solve it
+func (e *LoginError) Error() string {
+	if e.CaptchaRequired() {
+		if e.LoginURL != "" {
+			return fmt.Sprintf("JIRA requires a CAPTCHA: log in at %s in a browser to solve it", e.LoginURL)
+		}
+		return "JIRA requires a CAPTCHA: log in in a browser to solve it"
+	}
+	if e.Reason == LoginReasonAuthorizationFailed {
+		return "JIRA authenticated the user, but did not authorize the request"
+	}
+	if e.DeniedReason != "" {
+		return fmt.Sprintf("JIRA denied the authentication: %s", e.DeniedReason)
+	}
+	return fmt.Sprintf("JIRA refused to authenticate the user: %s", e.Reason)
+}
+
+// newLoginError returns the LoginError of a response, or nil if JIRA did not refuse to authenticate its request
+func newLoginError(header http.Header) *LoginError {
+	loginError := &LoginError{Reason: header.Get("X-Seraph-LoginReason")}
+	if denied := header.Get("X-Authentication-Denied-Reason"); denied != "" {
+		parts := strings.Split(denied, ";")
+		loginError.DeniedReason = strings.TrimSpace(parts[0])
+		for _, part := range parts[1:] {
+			if kv := strings.SplitN(strings.TrimSpace(part), "=", 2); len(kv) == 2 && kv[0] == "login-url" {
+				loginError.LoginURL = kv[1]
+			}
+		}
+	}
+
+	for _, reason := range strings.Split(loginError.Reason, ",") {
+		switch strings.TrimSpace(reason) {
+		case LoginReasonAuthenticationFailed, LoginReasonAuthenticationDenied, LoginReasonAuthorizationFailed:
+			loginError.Reason = strings.TrimSpace(reason)
+			return loginError
+		}
+	}
+	if loginError.DeniedReason != "" {
+		return loginError
+	}
+	return nil
 }
 
 // NewJiraError creates a new jira Error
@@ -23,24 +102,71 @@ func NewJiraError(resp *Response, httpError error) error {
 		return errors.Wrap(httpError, "No response returned")
 	}
 
+	jerr := Error{HTTPError: httpError, HTTPStatusCode: resp.StatusCode}
+	if resp.Response != nil {
+		jerr.LoginError = newLoginError(resp.Header)
+	}
+
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, httpError.Error())
+		jerr.HTTPError = errors.Wrap(err, httpError.Error())
+		return &jerr
 	}
 
-	jerr := Error{HTTPError: httpError}
 	err = json.Unmarshal(body, &jerr)
 	if err != nil {
 		httpError = errors.Wrap(errors.New("Could not parse JSON"), httpError.Error())
-		return errors.Wrap(err, httpError.Error())
+		jerr.HTTPError = errors.Wrap(err, httpError.Error())
+		return &jerr
 	}
 
 	return &jerr
 }
 
+// IsNotFound reports whether err is a JIRA Error for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return errorStatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is a JIRA Error for a 401 Unauthorized response,
+// e.g. because of missing or wrong credentials.
+func IsUnauthorized(err error) bool {
+	return errorStatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is a JIRA Error for a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return errorStatusCode(err) == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is a JIRA Error for a 429 Too Many Requests response.
+func IsRateLimited(err error) bool {
+	return errorStatusCode(err) == http.StatusTooManyRequests
+}
+
+// IsCaptchaRequired reports whether err is a JIRA Error because JIRA requires the user to solve a CAPTCHA
+// in a browser before it accepts the credentials again.
+func IsCaptchaRequired(err error) bool {
+	if jerr, ok := errors.Cause(err).(*Error); ok && jerr.LoginError != nil {
+		return jerr.LoginError.CaptchaRequired()
+	}
+	return false
+}
+
+// errorStatusCode returns the HTTP status code of the JIRA Error behind err, or 0 if there is none.
+func errorStatusCode(err error) int {
+	if jerr, ok := errors.Cause(err).(*Error); ok {
+		return jerr.HTTPStatusCode
+	}
+	return 0
+}
+
 // Error is a short string representing the error
 func (e *Error) Error() string {
+	if e.LoginError != nil {
+		return e.LoginError.Error()
+	}
 	if len(e.ErrorMessages) > 0 {
 		// return fmt.Sprintf("%v", e.HTTPError)
 		return fmt.Sprintf("%s: %v", e.ErrorMessages[0], e.HTTPError)
@@ -61,6 +187,11 @@ func (e *Error) LongError() string {
 		msg.WriteString(e.HTTPError.Error())
 		msg.WriteString("\n")
 	}
+	if e.LoginError != nil {
+		msg.WriteString("Login:\n")
+		msg.WriteString(e.LoginError.Error())
+		msg.WriteString("\n")
+	}
 	if len(e.ErrorMessages) > 0 {
 		msg.WriteString("Messages:\n")
 		for _, v := range e.ErrorMessages {
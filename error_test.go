@@ -29,6 +29,40 @@ func TestError_NewJiraError(t *testing.T) {
 	}
 }
 
+func TestError_StatusCodeHelpers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"issue":"Issue does not exist"}}`)
+	})
+
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	resp, err := testClient.Do(req, nil)
+
+	err = NewJiraError(resp, err)
+	jerr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected jira Error. Got %s", err.Error())
+	}
+	if jerr.HTTPStatusCode != http.StatusNotFound {
+		t.Errorf("Expected status code 404. Got %d", jerr.HTTPStatusCode)
+	}
+	if jerr.Errors["issue"] != "Issue does not exist" {
+		t.Errorf("Expected issue error. Got %v", jerr.Errors)
+	}
+	if !IsNotFound(err) {
+		t.Error("Expected IsNotFound to be true")
+	}
+	if IsUnauthorized(err) || IsForbidden(err) || IsRateLimited(err) {
+		t.Error("Expected only IsNotFound to be true")
+	}
+	if IsNotFound(errors.New("Original http error")) {
+		t.Error("Expected IsNotFound to be false for a plain error")
+	}
+}
+
 func TestError_NoResponse(t *testing.T) {
 	err := NewJiraError(nil, errors.New("Original http error"))
 
@@ -59,6 +93,9 @@ func TestError_NoJSON(t *testing.T) {
 	if !strings.Contains(msg, "Could not parse JSON") {
 		t.Errorf("Expected the 'Could not parse JSON' error message: Got\n%s\n", msg)
 	}
+	if jerr, ok := err.(*Error); !ok || jerr.HTTPStatusCode != http.StatusOK {
+		t.Errorf("Expected jira Error with status code 200. Got %#v", err)
+	}
 }
 
 func TestError_NilOriginalMessage(t *testing.T) {
@@ -163,3 +200,55 @@ func TestError_LongMessage(t *testing.T) {
 		t.Errorf("Expected the error map: Got\n%s\n", msg)
 	}
 }
+
+func TestError_CaptchaRequired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATION_DENIED")
+		w.Header().Set("X-Authentication-Denied-Reason", "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<html>Forbidden</html>`)
+	})
+
+	_, _, err := testClient.User.GetSelf()
+	if !IsCaptchaRequired(err) || !IsForbidden(err) {
+		t.Fatalf("Expected a forbidden error requiring a CAPTCHA. Got %v", err)
+	}
+	loginError := err.(*Error).LoginError
+	if loginError.Reason != LoginReasonAuthenticationDenied || loginError.LoginURL != "https://jira.example.com/login.jsp" {
+		t.Errorf("Unexpected login error %+v", loginError)
+	}
+	if !strings.Contains(err.Error(), "log in at https://jira.example.com/login.jsp") {
+		t.Errorf("Expected the error to point to the login page. Got %s", err.Error())
+	}
+}
+
+func TestError_AuthenticationFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATED_FAILED")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Seraph-LoginReason", "OK")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Issue does not exist"],"errors":{}}`)
+	})
+
+	_, _, err := testClient.User.GetSelf()
+	if jerr, ok := err.(*Error); !ok || jerr.LoginError == nil || jerr.LoginError.Reason != LoginReasonAuthenticationFailed {
+		t.Errorf("Expected a login error. Got %v", err)
+	}
+	if IsCaptchaRequired(err) {
+		t.Error("Expected no CAPTCHA to be required")
+	}
+
+	_, _, err = testClient.Issue.Get("EX-1", nil)
+	if jerr, ok := err.(*Error); !ok || jerr.LoginError != nil {
+		t.Errorf("Expected no login error for a successful login. Got %v", err)
+	}
+}
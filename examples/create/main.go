@@ -29,7 +29,7 @@ func main() {
 		Password: strings.TrimSpace(password),
 	}
 
-	client, err := jira.NewClient(tp.Client(), strings.TrimSpace(jiraURL))
+	client, err := jira.NewClient(strings.TrimSpace(jiraURL), jira.WithHTTPClient(tp.Client()))
 	if err != nil {
 		fmt.Printf("\nerror: %v\n", err)
 		return
@@ -14,7 +14,7 @@ func main() {
 	}
 	client := &http.Client{Transport: tr}
 
-	jiraClient, _ := jira.NewClient(client, "https://issues.apache.org/jira/")
+	jiraClient, _ := jira.NewClient("https://issues.apache.org/jira/", jira.WithHTTPClient(client))
 	issue, _, _ := jiraClient.Issue.Get("MESOS-3325", nil)
 
 	fmt.Printf("%s: %+v\n", issue.Key, issue.Fields.Summary)
@@ -42,7 +42,7 @@ func main() {
 		tp = ba.Client()
 	}
 
-	client, err := jira.NewClient(tp, strings.TrimSpace(jiraURL))
+	client, err := jira.NewClient(strings.TrimSpace(jiraURL), jira.WithHTTPClient(tp))
 	if err != nil {
 		fmt.Printf("\nerror: %v\n", err)
 		return
@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // FieldService handles fields for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-Field
@@ -20,16 +25,113 @@ type Field struct {
 }
 
 type FieldSchema struct {
-	Type   string `json:"type,omitempty" structs:"type,omitempty"`
-	System string `json:"system,omitempty" structs:"system,omitempty"`
+	Type     string `json:"type,omitempty" structs:"type,omitempty"`
+	Items    string `json:"items,omitempty" structs:"items,omitempty"`
+	System   string `json:"system,omitempty" structs:"system,omitempty"`
+	Custom   string `json:"custom,omitempty" structs:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty" structs:"customId,omitempty"`
+}
+
+// CreateCustomFieldOptions are passed to the FieldService.CreateCustomField function to create a new custom field.
+// Type is the key of the custom field type, e.g. "com.atlassian.jira.plugin.system.customfieldtypes:select",
+// SearcherKey the key of the searcher used to search the field in JQL.
+type CreateCustomFieldOptions struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Type        string `json:"type,omitempty" structs:"type,omitempty"`
+	SearcherKey string `json:"searcherKey,omitempty" structs:"searcherKey,omitempty"`
+}
+
+// FieldContext represents a context of a custom field.
+// A context defines the projects and issue types the field is used in, along with its options and default value.
+type FieldContext struct {
+	ID              string   `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string   `json:"name,omitempty" structs:"name,omitempty"`
+	Description     string   `json:"description,omitempty" structs:"description,omitempty"`
+	IsGlobalContext bool     `json:"isGlobalContext,omitempty" structs:"isGlobalContext,omitempty"`
+	IsAnyIssueType  bool     `json:"isAnyIssueType,omitempty" structs:"isAnyIssueType,omitempty"`
+	ProjectIDs      []string `json:"projectIds,omitempty" structs:"projectIds,omitempty"`
+	IssueTypeIDs    []string `json:"issueTypeIds,omitempty" structs:"issueTypeIds,omitempty"`
 }
 
-// GetList gets all fields from JIRA
+// FieldContextListOptions specifies the optional parameters to the FieldService.GetContexts method
+type FieldContextListOptions struct {
+	// ContextIDs restricts the result to the contexts with these ids
+	ContextIDs []string `url:"contextId,omitempty"`
+	// IsAnyIssueType restricts the result to contexts which apply to all issue types or to specific ones
+	IsAnyIssueType *bool `url:"isAnyIssueType,omitempty"`
+	// IsGlobalContext restricts the result to contexts which apply to all projects or to specific ones
+	IsGlobalContext *bool `url:"isGlobalContext,omitempty"`
+	// StartAt is the index of the first context to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of contexts to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// fieldContextListResult is a page of the contexts of a custom field
+type fieldContextListResult struct {
+	StartAt    int            `json:"startAt"`
+	MaxResults int            `json:"maxResults"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Contexts   []FieldContext `json:"values"`
+}
+
+// These constants are the positions options of a custom field can be moved to
+const (
+	FieldContextOptionPositionFirst = "First"
+	FieldContextOptionPositionLast  = "Last"
+)
+
+// FieldContextOption represents an option of a select list custom field in a context.
+// OptionID is the id of the parent option for the second level options of cascading select lists.
+type FieldContextOption struct {
+	ID       string `json:"id,omitempty" structs:"id,omitempty"`
+	Value    string `json:"value,omitempty" structs:"value,omitempty"`
+	OptionID string `json:"optionId,omitempty" structs:"optionId,omitempty"`
+	Disabled bool   `json:"disabled" structs:"disabled"`
+}
+
+// fieldContextOptionList is the list of options sent to and returned by JIRA when creating or updating options
+type fieldContextOptionList struct {
+	Options []FieldContextOption `json:"options" structs:"options"`
+}
+
+// FieldContextOptionListOptions specifies the optional parameters to the FieldService.GetContextOptions method
+type FieldContextOptionListOptions struct {
+	// OptionID returns the option with this id only, or its child options together with OnlyOptions
+	OptionID string `url:"optionId,omitempty"`
+	// OnlyOptions leaves out the child options of cascading select lists
+	OnlyOptions bool `url:"onlyOptions,omitempty"`
+	// StartAt is the index of the first option to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of options to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// fieldContextOptionListResult is a page of the options of a custom field context
+type fieldContextOptionListResult struct {
+	StartAt    int                  `json:"startAt"`
+	MaxResults int                  `json:"maxResults"`
+	Total      int                  `json:"total"`
+	IsLast     bool                 `json:"isLast"`
+	Options    []FieldContextOption `json:"values"`
+}
+
+// FieldContextOptionMove describes where the options with the ids CustomFieldOptionIDs are moved to.
+// Either Position is one of the FieldContextOptionPosition constants, or After is the id of the option they are moved after.
+type FieldContextOptionMove struct {
+	CustomFieldOptionIDs []string `json:"customFieldOptionIds" structs:"customFieldOptionIds"`
+	Position             string   `json:"position,omitempty" structs:"position,omitempty"`
+	After                string   `json:"after,omitempty" structs:"after,omitempty"`
+}
+
+// GetListWithContext gets all fields from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-field-get
-func (s *FieldService) GetList() ([]Field, *Response, error) {
+func (s *FieldService) GetListWithContext(ctx context.Context) ([]Field, *Response, error) {
 	apiEndpoint := "rest/api/2/field"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,3 +143,263 @@ func (s *FieldService) GetList() ([]Field, *Response, error) {
 	}
 	return fieldList, resp, nil
 }
+
+// GetList wraps GetListWithContext using the background context.
+func (s *FieldService) GetList() ([]Field, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// CreateCustomFieldWithContext creates a custom field.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/field-createCustomField
+func (s *FieldService) CreateCustomFieldWithContext(ctx context.Context, options *CreateCustomFieldOptions) (*Field, *Response, error) {
+	apiEndpoint := "rest/api/2/field"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	field := new(Field)
+	resp, err := s.client.Do(req, field)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return field, resp, nil
+}
+
+// CreateCustomField wraps CreateCustomFieldWithContext using the background context.
+func (s *FieldService) CreateCustomField(options *CreateCustomFieldOptions) (*Field, *Response, error) {
+	return s.CreateCustomFieldWithContext(context.Background(), options)
+}
+
+// GetContextsWithContext returns a page of the contexts of the custom field with the given id, e.g. "customfield_10123".
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// Contexts are only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-get
+func (s *FieldService) GetContextsWithContext(ctx context.Context, fieldID string, options *FieldContextListOptions) ([]FieldContext, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("%s/field/%s/context", restAPIBase, fieldID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(fieldContextListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Contexts, resp, nil
+}
+
+// GetContexts wraps GetContextsWithContext using the background context.
+func (s *FieldService) GetContexts(fieldID string, options *FieldContextListOptions) ([]FieldContext, *Response, error) {
+	return s.GetContextsWithContext(context.Background(), fieldID, options)
+}
+
+// CreateContextWithContext creates a context of the custom field with the given id.
+// A context without ProjectIDs applies to all projects, one without IssueTypeIDs to all issue types.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-post
+func (s *FieldService) CreateContextWithContext(ctx context.Context, fieldID string, fieldContext *FieldContext) (*FieldContext, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context", restAPIBase, fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, fieldContext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdContext := new(FieldContext)
+	resp, err := s.client.Do(req, createdContext)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return createdContext, resp, nil
+}
+
+// CreateContext wraps CreateContextWithContext using the background context.
+func (s *FieldService) CreateContext(fieldID string, fieldContext *FieldContext) (*FieldContext, *Response, error) {
+	return s.CreateContextWithContext(context.Background(), fieldID, fieldContext)
+}
+
+// UpdateContextWithContext updates the name and description of a context of the custom field with the given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-put
+func (s *FieldService) UpdateContextWithContext(ctx context.Context, fieldID, contextID, name, description string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context/%s", restAPIBase, fieldID, contextID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &FieldContext{Name: name, Description: description})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// UpdateContext wraps UpdateContextWithContext using the background context.
+func (s *FieldService) UpdateContext(fieldID, contextID, name, description string) (*Response, error) {
+	return s.UpdateContextWithContext(context.Background(), fieldID, contextID, name, description)
+}
+
+// DeleteContextWithContext deletes a context of the custom field with the given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-delete
+func (s *FieldService) DeleteContextWithContext(ctx context.Context, fieldID, contextID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context/%s", restAPIBase, fieldID, contextID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteContext wraps DeleteContextWithContext using the background context.
+func (s *FieldService) DeleteContext(fieldID, contextID string) (*Response, error) {
+	return s.DeleteContextWithContext(context.Background(), fieldID, contextID)
+}
+
+// GetContextOptionsWithContext returns a page of the options of a select list custom field in the given context.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-get
+func (s *FieldService) GetContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options *FieldContextOptionListOptions) ([]FieldContextOption, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("%s/field/%s/context/%s/option", restAPIBase, fieldID, contextID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(fieldContextOptionListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Options, resp, nil
+}
+
+// GetContextOptions wraps GetContextOptionsWithContext using the background context.
+func (s *FieldService) GetContextOptions(fieldID, contextID string, options *FieldContextOptionListOptions) ([]FieldContextOption, *Response, error) {
+	return s.GetContextOptionsWithContext(context.Background(), fieldID, contextID, options)
+}
+
+// CreateContextOptionsWithContext adds options to a select list custom field in the given context.
+// The new options are added after the existing ones.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-post
+func (s *FieldService) CreateContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error) {
+	return s.sendContextOptions(ctx, "POST", fieldID, contextID, options)
+}
+
+// CreateContextOptions wraps CreateContextOptionsWithContext using the background context.
+func (s *FieldService) CreateContextOptions(fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error) {
+	return s.CreateContextOptionsWithContext(context.Background(), fieldID, contextID, options)
+}
+
+// UpdateContextOptionsWithContext changes the value or the disabled state of options, identified by their ID,
+// of a select list custom field in the given context.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-put
+func (s *FieldService) UpdateContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error) {
+	return s.sendContextOptions(ctx, "PUT", fieldID, contextID, options)
+}
+
+// UpdateContextOptions wraps UpdateContextOptionsWithContext using the background context.
+func (s *FieldService) UpdateContextOptions(fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error) {
+	return s.UpdateContextOptionsWithContext(context.Background(), fieldID, contextID, options)
+}
+
+// SetContextOptionDisabledWithContext disables or enables an option of a select list custom field in the given context.
+// Disabled options are kept on existing issues but can no longer be chosen.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-put
+func (s *FieldService) SetContextOptionDisabledWithContext(ctx context.Context, fieldID, contextID string, option FieldContextOption, disabled bool) (*FieldContextOption, *Response, error) {
+	option.Disabled = disabled
+	options, resp, err := s.UpdateContextOptionsWithContext(ctx, fieldID, contextID, []FieldContextOption{option})
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(options) == 0 {
+		return &option, resp, nil
+	}
+	return &options[0], resp, nil
+}
+
+// SetContextOptionDisabled wraps SetContextOptionDisabledWithContext using the background context.
+func (s *FieldService) SetContextOptionDisabled(fieldID, contextID string, option FieldContextOption, disabled bool) (*FieldContextOption, *Response, error) {
+	return s.SetContextOptionDisabledWithContext(context.Background(), fieldID, contextID, option, disabled)
+}
+
+// sendContextOptions creates or updates options of a select list custom field in the given context.
+func (s *FieldService) sendContextOptions(ctx context.Context, method, fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context/%s/option", restAPIBase, fieldID, contextID)
+	req, err := s.client.NewRequestWithContext(ctx, method, apiEndpoint, &fieldContextOptionList{Options: options})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(fieldContextOptionList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Options, resp, nil
+}
+
+// ReorderContextOptionsWithContext moves options of a select list custom field in the given context.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-move-put
+func (s *FieldService) ReorderContextOptionsWithContext(ctx context.Context, fieldID, contextID string, move *FieldContextOptionMove) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context/%s/option/move", restAPIBase, fieldID, contextID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, move)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// ReorderContextOptions wraps ReorderContextOptionsWithContext using the background context.
+func (s *FieldService) ReorderContextOptions(fieldID, contextID string, move *FieldContextOptionMove) (*Response, error) {
+	return s.ReorderContextOptionsWithContext(context.Background(), fieldID, contextID, move)
+}
+
+// DeleteContextOptionWithContext deletes an option of a select list custom field in the given context.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-field-fieldId-context-contextId-option-optionId-delete
+func (s *FieldService) DeleteContextOptionWithContext(ctx context.Context, fieldID, contextID, optionID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/field/%s/context/%s/option/%s", restAPIBase, fieldID, contextID, optionID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteContextOption wraps DeleteContextOptionWithContext using the background context.
+func (s *FieldService) DeleteContextOption(fieldID, contextID, optionID string) (*Response, error) {
+	return s.DeleteContextOptionWithContext(context.Background(), fieldID, contextID, optionID)
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -30,3 +31,240 @@ func TestFieldService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestFieldService_CreateCustomField(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/field"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		options := new(CreateCustomFieldOptions)
+		json.NewDecoder(r.Body).Decode(options)
+		if options.Name != "Service" || options.Type != "com.atlassian.jira.plugin.system.customfieldtypes:select" {
+			t.Errorf("Unexpected options: %+v", options)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"customfield_10123","name":"Service","custom":true,"schema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select","customId":10123}}`)
+	})
+
+	field, _, err := testClient.Field.CreateCustomField(&CreateCustomFieldOptions{
+		Name:        "Service",
+		Type:        "com.atlassian.jira.plugin.system.customfieldtypes:select",
+		SearcherKey: "com.atlassian.jira.plugin.system.customfieldtypes:multiselectsearcher",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if field == nil || field.ID != "customfield_10123" || field.Schema.CustomID != 10123 {
+		t.Errorf("Expected custom field customfield_10123. Got %+v", field)
+	}
+}
+
+func TestFieldService_GetContexts(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?isGlobalContext=true")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"id":"10025","name":"Default context","isGlobalContext":true,"isAnyIssueType":true}]}`)
+	})
+
+	global := true
+	contexts, resp, err := testClient.Field.GetContexts("customfield_10123", &FieldContextListOptions{IsGlobalContext: &global})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(contexts) != 1 || !contexts[0].IsGlobalContext {
+		t.Errorf("Expected the global context. Got %+v", contexts)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Expected total of 1. Got %d", resp.Total)
+	}
+}
+
+func TestFieldService_CreateContext(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		fieldContext := new(FieldContext)
+		json.NewDecoder(r.Body).Decode(fieldContext)
+		if fieldContext.Name != "Bugs" || len(fieldContext.ProjectIDs) != 1 || fieldContext.IssueTypeIDs[0] != "10001" {
+			t.Errorf("Unexpected context: %+v", fieldContext)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10026","name":"Bugs","projectIds":["10000"],"issueTypeIds":["10001"]}`)
+	})
+
+	fieldContext, _, err := testClient.Field.CreateContext("customfield_10123", &FieldContext{
+		Name:         "Bugs",
+		ProjectIDs:   []string{"10000"},
+		IssueTypeIDs: []string{"10001"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if fieldContext == nil || fieldContext.ID != "10026" {
+		t.Errorf("Expected context 10026. Got %+v", fieldContext)
+	}
+}
+
+func TestFieldService_UpdateContext(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10026"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"Defects","description":"Used for defects"}`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Field.UpdateContext("customfield_10123", "10026", "Defects", "Used for defects"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldService_DeleteContext(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10026"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Field.DeleteContext("customfield_10123", "10026"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldService_GetContextOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10025/option"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint+"?onlyOptions=true")
+		fmt.Fprint(w, `{"maxResults":100,"startAt":0,"total":2,"isLast":true,"values":[{"id":"10200","value":"Billing","disabled":false},{"id":"10201","value":"Search","disabled":true}]}`)
+	})
+
+	options, resp, err := testClient.Field.GetContextOptions("customfield_10123", "10025", &FieldContextOptionListOptions{OnlyOptions: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(options) != 2 || options[0].Value != "Billing" || !options[1].Disabled {
+		t.Errorf("Expected options Billing and disabled Search. Got %+v", options)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total of 2. Got %d", resp.Total)
+	}
+}
+
+func TestFieldService_CreateContextOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10025/option"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"options":[{"value":"Payments","disabled":false}]}`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		fmt.Fprint(w, `{"options":[{"id":"10202","value":"Payments","disabled":false}]}`)
+	})
+
+	options, _, err := testClient.Field.CreateContextOptions("customfield_10123", "10025", []FieldContextOption{{Value: "Payments"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(options) != 1 || options[0].ID != "10202" {
+		t.Errorf("Expected option 10202. Got %+v", options)
+	}
+}
+
+func TestFieldService_SetContextOptionDisabled(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10025/option"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"options":[{"id":"10200","value":"Billing","disabled":true}]}`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		fmt.Fprint(w, `{"options":[{"id":"10200","value":"Billing","disabled":true}]}`)
+	})
+
+	option, _, err := testClient.Field.SetContextOptionDisabled("customfield_10123", "10025", FieldContextOption{ID: "10200", Value: "Billing"}, true)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if option == nil || !option.Disabled {
+		t.Errorf("Expected disabled option. Got %+v", option)
+	}
+}
+
+func TestFieldService_ReorderContextOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10025/option/move"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"customFieldOptionIds":["10202"],"position":"First"}`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Field.ReorderContextOptions("customfield_10123", "10025", &FieldContextOptionMove{
+		CustomFieldOptionIDs: []string{"10202"},
+		Position:             FieldContextOptionPositionFirst,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFieldService_DeleteContextOption(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/3/field/customfield_10123/context/10025/option/10201"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Field.DeleteContextOption("customfield_10123", "10025", "10201"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,255 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// FilterService handles saved filters for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter
+type FilterService struct {
+	client *Client
+}
+
+// Filter represents a saved JQL filter in JIRA
+type Filter struct {
+	Self             string            `json:"self,omitempty" structs:"self,omitempty"`
+	ID               string            `json:"id,omitempty" structs:"id,omitempty"`
+	Name             string            `json:"name,omitempty" structs:"name,omitempty"`
+	Description      string            `json:"description,omitempty" structs:"description,omitempty"`
+	Owner            *User             `json:"owner,omitempty" structs:"owner,omitempty"`
+	JQL              string            `json:"jql,omitempty" structs:"jql,omitempty"`
+	ViewURL          string            `json:"viewUrl,omitempty" structs:"viewUrl,omitempty"`
+	SearchURL        string            `json:"searchUrl,omitempty" structs:"searchUrl,omitempty"`
+	Favourite        bool              `json:"favourite,omitempty" structs:"favourite,omitempty"`
+	FavouritedCount  int               `json:"favouritedCount,omitempty" structs:"favouritedCount,omitempty"`
+	SharePermissions []SharePermission `json:"sharePermissions,omitempty" structs:"sharePermissions,omitempty"`
+}
+
+// These constants are the types a filter can be shared by
+const (
+	SharePermissionTypeGlobal        = "global"
+	SharePermissionTypeAuthenticated = "authenticated"
+	SharePermissionTypeProject       = "project"
+	SharePermissionTypeProjectRole   = "projectRole"
+	SharePermissionTypeGroup         = "group"
+)
+
+// SharePermission represents who a filter is shared with.
+// Depending on the Type, Project, Role or Group tell the audience.
+type SharePermission struct {
+	ID      int        `json:"id,omitempty" structs:"id,omitempty"`
+	Type    string     `json:"type" structs:"type"`
+	Project *Project   `json:"project,omitempty" structs:"project,omitempty"`
+	Role    *Role      `json:"role,omitempty" structs:"role,omitempty"`
+	Group   *UserGroup `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// SharePermissionOptions describes a share permission to add to a filter.
+// ProjectID is required for the project and projectRole types, ProjectRoleID for the latter,
+// and Groupname for the group type.
+type SharePermissionOptions struct {
+	Type          string `json:"type" structs:"type"`
+	ProjectID     string `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	ProjectRoleID string `json:"projectRoleId,omitempty" structs:"projectRoleId,omitempty"`
+	Groupname     string `json:"groupname,omitempty" structs:"groupname,omitempty"`
+}
+
+// CreateWithContext creates a filter owned by the current user.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-createFilter
+func (s *FilterService) CreateWithContext(ctx context.Context, filter *Filter) (*Filter, *Response, error) {
+	apiEndpoint := "rest/api/2/filter"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Filter)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return created, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *FilterService) Create(filter *Filter) (*Filter, *Response, error) {
+	return s.CreateWithContext(context.Background(), filter)
+}
+
+// GetWithContext returns the filter for a given filter id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-getFilter
+func (s *FilterService) GetWithContext(ctx context.Context, filterID int) (*Filter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(Filter)
+	resp, err := s.client.Do(req, filter)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return filter, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *FilterService) Get(filterID int) (*Filter, *Response, error) {
+	return s.GetWithContext(context.Background(), filterID)
+}
+
+// UpdateWithContext updates the name, description or JQL of the filter for a given filter id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-editFilter
+func (s *FilterService) UpdateWithContext(ctx context.Context, filterID int, filter *Filter) (*Filter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Filter)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return updated, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *FilterService) Update(filterID int, filter *Filter) (*Filter, *Response, error) {
+	return s.UpdateWithContext(context.Background(), filterID, filter)
+}
+
+// DeleteWithContext deletes the filter for a given filter id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-deleteFilter
+func (s *FilterService) DeleteWithContext(ctx context.Context, filterID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *FilterService) Delete(filterID int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), filterID)
+}
+
+// GetFavouriteListWithContext returns the favourite filters of the current user.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-getFavouriteFilters
+func (s *FilterService) GetFavouriteListWithContext(ctx context.Context) ([]*Filter, *Response, error) {
+	apiEndpoint := "rest/api/2/filter/favourite"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filters := []*Filter{}
+	resp, err := s.client.Do(req, &filters)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return filters, resp, nil
+}
+
+// GetFavouriteList wraps GetFavouriteListWithContext using the background context.
+func (s *FilterService) GetFavouriteList() ([]*Filter, *Response, error) {
+	return s.GetFavouriteListWithContext(context.Background())
+}
+
+// GetSharePermissionsWithContext returns the share permissions of the filter for a given filter id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-getSharePermissions
+func (s *FilterService) GetSharePermissionsWithContext(ctx context.Context, filterID int) ([]SharePermission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission", filterID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := []SharePermission{}
+	resp, err := s.client.Do(req, &permissions)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return permissions, resp, nil
+}
+
+// GetSharePermissions wraps GetSharePermissionsWithContext using the background context.
+func (s *FilterService) GetSharePermissions(filterID int) ([]SharePermission, *Response, error) {
+	return s.GetSharePermissionsWithContext(context.Background(), filterID)
+}
+
+// AddSharePermissionWithContext shares the filter for a given filter id and returns all of its share permissions.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-addSharePermission
+func (s *FilterService) AddSharePermissionWithContext(ctx context.Context, filterID int, permission *SharePermissionOptions) ([]SharePermission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission", filterID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, permission)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := []SharePermission{}
+	resp, err := s.client.Do(req, &permissions)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return permissions, resp, nil
+}
+
+// AddSharePermission wraps AddSharePermissionWithContext using the background context.
+func (s *FilterService) AddSharePermission(filterID int, permission *SharePermissionOptions) ([]SharePermission, *Response, error) {
+	return s.AddSharePermissionWithContext(context.Background(), filterID, permission)
+}
+
+// DeleteSharePermissionWithContext removes a share permission from the filter for a given filter id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/filter-deleteSharePermission
+func (s *FilterService) DeleteSharePermissionWithContext(ctx context.Context, filterID, permissionID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission/%d", filterID, permissionID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// DeleteSharePermission wraps DeleteSharePermissionWithContext using the background context.
+func (s *FilterService) DeleteSharePermission(filterID, permissionID int) (*Response, error) {
+	return s.DeleteSharePermissionWithContext(context.Background(), filterID, permissionID)
+}
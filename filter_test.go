@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFilterService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload Filter
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.JQL != "project = EX AND assignee = currentUser()" {
+			t.Errorf("Expected JQL in payload, got %s instead", payload.JQL)
+		}
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/filter/10000","id":"10000","name":"My issues","jql":"project = EX AND assignee = currentUser()","favourite":true}`)
+	})
+
+	filter, _, err := testClient.Filter.Create(&Filter{Name: "My issues", JQL: "project = EX AND assignee = currentUser()", Favourite: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.ID != "10000" {
+		t.Errorf("Expected filter 10000. Got %+v", filter)
+	}
+}
+
+func TestFilterService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/filter/10000","id":"10000","name":"My issues","owner":{"name":"fred"},"jql":"assignee = currentUser()","sharePermissions":[{"id":10000,"type":"group","group":{"name":"jira-developers"}}]}`)
+	})
+
+	filter, _, err := testClient.Filter.Get(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.Owner.Name != "fred" {
+		t.Fatalf("Expected filter owned by fred. Got %+v", filter)
+	}
+	if len(filter.SharePermissions) != 1 || filter.SharePermissions[0].Group.Name != "jira-developers" {
+		t.Errorf("Expected filter shared with jira-developers. Got %+v", filter.SharePermissions)
+	}
+}
+
+func TestFilterService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10000","name":"Team issues","jql":"project = EX"}`)
+	})
+
+	filter, _, err := testClient.Filter.Update(10000, &Filter{Name: "Team issues", JQL: "project = EX"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.Name != "Team issues" {
+		t.Errorf("Expected renamed filter. Got %+v", filter)
+	}
+}
+
+func TestFilterService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.Delete(10000); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_GetFavouriteList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/favourite"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"10000","name":"My issues","favourite":true},{"id":"10010","name":"Team issues","favourite":true}]`)
+	})
+
+	filters, _, err := testClient.Filter.GetFavouriteList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(filters) != 2 {
+		t.Errorf("Expected 2 filters. Got %d", len(filters))
+	}
+}
+
+func TestFilterService_AddSharePermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000/permission"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload SharePermissionOptions
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Type != SharePermissionTypeProjectRole || payload.ProjectRoleID != "10360" {
+			t.Errorf("Expected project role in payload, got %+v instead", payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `[{"id":10010,"type":"projectRole","project":{"id":"10000","key":"EX"},"role":{"id":10360,"name":"Developers"}}]`)
+	})
+
+	permissions, _, err := testClient.Filter.AddSharePermission(10000, &SharePermissionOptions{
+		Type:          SharePermissionTypeProjectRole,
+		ProjectID:     "10000",
+		ProjectRoleID: "10360",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(permissions) != 1 || permissions[0].Role.Name != "Developers" {
+		t.Errorf("Expected filter shared with Developers. Got %+v", permissions)
+	}
+}
+
+func TestFilterService_DeleteSharePermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/filter/10000/permission/10010"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.DeleteSharePermission(10000, 10010); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -50,6 +51,33 @@ type GroupMember struct {
 	TimeZone     string `json:"timeZone,omitempty"`
 }
 
+// GroupIdentity identifies a group by its name and its id
+type GroupIdentity struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId"`
+}
+
+// groupBulkResult is a single page of the groups found by the bulk group lookup
+type groupBulkResult struct {
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Total      int             `json:"total"`
+	IsLast     bool            `json:"isLast"`
+	Groups     []GroupIdentity `json:"values"`
+}
+
+// GroupBulkOptions specifies the optional parameters for the GroupService.GetBulk method
+type GroupBulkOptions struct {
+	// GroupIDs restricts the result to the groups with these ids.
+	GroupIDs []string `url:"groupId,omitempty"`
+	// GroupNames restricts the result to the groups with these names.
+	GroupNames []string `url:"groupName,omitempty"`
+	// StartAt is the index of the first group to return. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of groups to return per page.
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
 // GroupSearchOptions specifies the optional parameters for the Get Group methods
 type GroupSearchOptions struct {
 	StartAt              int64
@@ -73,37 +101,42 @@ type GroupList struct {
 	Groups []GroupDetails `json:"groups"`
 }
 
-// Get returns a paginated list of users who are members of the specified group and its subgroups.
+// GetWithContext returns a paginated list of users who are members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-member-get
 //
-// WARNING: This API only returns the first page of group members
+// WARNING: This API only returns the first page of group members, use GetAllMembersWithContext to get all of them
+func (s *GroupService) GetWithContext(ctx context.Context, name string) ([]GroupMember, *Response, error) {
+	return s.GetWithOptionsWithContext(ctx, name, nil)
+}
+
+// Get wraps GetWithContext using the background context.
 func (s *GroupService) Get(name string) ([]GroupMember, *Response, error) {
-	return s.GetWithOptions(name, nil)
+	return s.GetWithContext(context.Background(), name)
 }
 
-// GetWithOptions returns a paginated list of members of the specified group and its subgroups.
+// GetWithOptionsWithContext returns a paginated list of members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-member-get
-func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+func (s *GroupService) GetWithOptionsWithContext(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
 	var apiEndpoint string
 	if options == nil {
-		apiEndpoint = fmt.Sprintf("%s/group/member?groupname=%s", restAPIBase, url.QueryEscape(name))
+		apiEndpoint = fmt.Sprintf("%s/group/member?groupname=%s", s.client.apiBase(ctx), url.QueryEscape(name))
 	} else {
 		apiEndpoint = fmt.Sprintf(
 			"%s/group/member?groupname=%s&startAt=%d&maxResults=%d&includeInactiveUsers=%t",
-			restAPIBase,
+			s.client.apiBase(ctx),
 			url.QueryEscape(name),
 			options.StartAt,
 			options.MaxResults,
 			options.IncludeInactiveUsers,
 		)
 	}
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,27 +149,91 @@ func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions)
 	return group.Members, resp, nil
 }
 
-// Add adds user to group
+// GetWithOptions wraps GetWithOptionsWithContext using the background context.
+func (s *GroupService) GetWithOptions(name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+	return s.GetWithOptionsWithContext(context.Background(), name, options)
+}
+
+// GetPagesWithContext calls f for every member of the specified group and its subgroups, following all result pages.
+// options.StartAt sets the first member to return and options.MaxResults the page size.
+// User of this resource is required to have sysadmin or admin permissions.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-member-get
+func (s *GroupService) GetPagesWithContext(ctx context.Context, name string, options *GroupSearchOptions, f func(GroupMember) error) error {
+	opt := GroupSearchOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	return fetchPages(int(opt.StartAt), int(opt.MaxResults), func(startAt, maxResults int) (*Response, int, error) {
+		opt.StartAt = int64(startAt)
+		opt.MaxResults = int32(maxResults)
+		members, resp, err := s.GetWithOptionsWithContext(ctx, name, &opt)
+		if err != nil {
+			return resp, 0, err
+		}
+		for _, member := range members {
+			if err := f(member); err != nil {
+				return resp, 0, err
+			}
+		}
+		return resp, len(members), nil
+	})
+}
+
+// GetPages wraps GetPagesWithContext using the background context.
+func (s *GroupService) GetPages(name string, options *GroupSearchOptions, f func(GroupMember) error) error {
+	return s.GetPagesWithContext(context.Background(), name, options, f)
+}
+
+// GetAllMembersWithContext returns all members of the specified group and its subgroups, following all result pages.
+// Inactive users are left out; use GetPagesWithContext to include them or to avoid holding all members in memory.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-member-get
+func (s *GroupService) GetAllMembersWithContext(ctx context.Context, name string) ([]GroupMember, error) {
+	members := []GroupMember{}
+	err := s.GetPagesWithContext(ctx, name, nil, func(member GroupMember) error {
+		members = append(members, member)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// GetAllMembers wraps GetAllMembersWithContext using the background context.
+func (s *GroupService) GetAllMembers(name string) ([]GroupMember, error) {
+	return s.GetAllMembersWithContext(context.Background(), name)
+}
+
+// Add adds user to group.
+// The user is given by its username and account id, or by either, depending on the Dialect of the client:
+// the account id on JIRA Cloud and the username on JIRA Server.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-user-post
-func (s *GroupService) AddUser(groupname string, userParams ...string) (*Group, *Response, error) {
+func (s *GroupService) AddUserWithContext(ctx context.Context, groupname string, userParams ...string) (*Group, *Response, error) {
 	if len(userParams) != 1 && len(userParams) != 2 {
 		// First string is username and second string is accountId
 		return nil, nil, errors.New("Invalid User add parameters")
 	}
 
-	apiEndpoint := fmt.Sprintf("%s/group/user?groupname=%s", restAPIBase, url.QueryEscape(groupname))
+	apiEndpoint := fmt.Sprintf("%s/group/user?groupname=%s", s.client.apiBase(ctx), url.QueryEscape(groupname))
 	var user struct {
-		Name      string `json:"name"`
+		Name      string `json:"name,omitempty"`
 		AccountId string `json:"accountId,omitempty"`
 	}
 
-	user.Name = userParams[0]
 	if len(userParams) == 2 {
+		user.Name = userParams[0]
 		user.AccountId = userParams[1]
+	} else if s.client.dialect(ctx).UsesAccountID() {
+		user.AccountId = userParams[0]
+	} else {
+		user.Name = userParams[0]
 	}
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, &user)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &user)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,13 +252,20 @@ func (s *GroupService) AddUser(groupname string, userParams ...string) (*Group,
 	return responseGroup, resp, nil
 }
 
-// Remove removes user from group
+// AddUser wraps AddUserWithContext using the background context.
+func (s *GroupService) AddUser(groupname string, userParams ...string) (*Group, *Response, error) {
+	return s.AddUserWithContext(context.Background(), groupname, userParams...)
+}
+
+// Remove removes user from group.
+// The user is given by its account id on JIRA Cloud and by its username on JIRA Server, depending on the Dialect of the client.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group-removeUserFromGroup
-func (s *GroupService) RemoveUser(groupname string, username string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("%s/group/user?groupname=%s&username=%s", restAPIBase,
-		url.QueryEscape(groupname), url.QueryEscape(username))
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-group-user-delete
+func (s *GroupService) RemoveUserWithContext(ctx context.Context, groupname string, username string) (*Response, error) {
+	dialect := s.client.dialect(ctx)
+	apiEndpoint := fmt.Sprintf("%s/group/user?groupname=%s&%s=%s", s.client.apiBase(ctx),
+		url.QueryEscape(groupname), dialect.userParam(), url.QueryEscape(username))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -175,22 +279,32 @@ func (s *GroupService) RemoveUser(groupname string, username string) (*Response,
 	return resp, nil
 }
 
+// RemoveUser wraps RemoveUserWithContext using the background context.
+func (s *GroupService) RemoveUser(groupname string, username string) (*Response, error) {
+	return s.RemoveUserWithContext(context.Background(), groupname, username)
+}
+
 // Get the first page of groups list
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-groups-picker-get
+func (s *GroupService) GetListWithContext(ctx context.Context) (*GroupList, *Response, error) {
+	return s.GetListWithOptionsWithContext(ctx, nil)
+}
+
+// GetList wraps GetListWithContext using the background context.
 func (s *GroupService) GetList() (*GroupList, *Response, error) {
-	return s.GetListWithOptions(nil)
+	return s.GetListWithContext(context.Background())
 }
 
 // Get the groups list with query parameters
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-groups-picker-get
-func (s *GroupService) GetListWithOptions(v url.Values) (*GroupList, *Response, error) {
-	apiEndPoint := fmt.Sprintf("%s/groups/picker", restAPIBase)
+func (s *GroupService) GetListWithOptionsWithContext(ctx context.Context, v url.Values) (*GroupList, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/groups/picker", s.client.apiBase(ctx))
 	if len(v) > 0 {
 		apiEndPoint = fmt.Sprintf("%s?%s", apiEndPoint, v.Encode())
 	}
-	req, err := s.client.NewRequest("GET", apiEndPoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -205,13 +319,27 @@ func (s *GroupService) GetListWithOptions(v url.Values) (*GroupList, *Response,
 	return gl, resp, nil
 }
 
-// Creates a group.
+// GetListWithOptions wraps GetListWithOptionsWithContext using the background context.
+func (s *GroupService) GetListWithOptions(v url.Values) (*GroupList, *Response, error) {
+	return s.GetListWithOptionsWithContext(context.Background(), v)
+}
+
+// CreateWithContext creates a group with the given name.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-post
-func (s *GroupService) Create(g *GroupDetails) (*GroupDetails, *Response, error) {
-	apiEndPoint := restAPIBase + "/group"
+func (s *GroupService) CreateWithContext(ctx context.Context, name string) (*GroupDetails, *Response, error) {
+	if name == "" {
+		return nil, nil, errors.New("Group Name should be non empty string")
+	}
+
+	apiEndPoint := s.client.apiBase(ctx) + "/group"
+	payload := struct {
+		Name string `json:"name"`
+	}{
+		Name: name,
+	}
 
-	req, err := s.client.NewRequest("POST", apiEndPoint, g)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, payload)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -226,18 +354,26 @@ func (s *GroupService) Create(g *GroupDetails) (*GroupDetails, *Response, error)
 	return gn, resp, nil
 }
 
-// Deletes a group.
+// Create wraps CreateWithContext using the background context.
+func (s *GroupService) Create(name string) (*GroupDetails, *Response, error) {
+	return s.CreateWithContext(context.Background(), name)
+}
+
+// DeleteWithContext deletes the group with the given name.
+// If swapGroup is not empty, the restrictions of comments and worklogs visible to the deleted group are moved to that group.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-group-delete
-func (s *GroupService) Remove(g string) (*Response, error) {
-
-	if g == "" {
+func (s *GroupService) DeleteWithContext(ctx context.Context, name string, swapGroup string) (*Response, error) {
+	if name == "" {
 		return nil, errors.New("Group Name should be non empty string")
 	}
 
-	apiEndPoint := restAPIBase + "/group"
+	apiEndPoint := fmt.Sprintf("%s/group?groupname=%s", s.client.apiBase(ctx), url.QueryEscape(name))
+	if swapGroup != "" {
+		apiEndPoint += "&swapGroup=" + url.QueryEscape(swapGroup)
+	}
 
-	req, err := s.client.NewRequest("DELETE ", apiEndPoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -250,3 +386,53 @@ func (s *GroupService) Remove(g string) (*Response, error) {
 
 	return resp, nil
 }
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *GroupService) Delete(name string, swapGroup string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), name, swapGroup)
+}
+
+// RemoveWithContext deletes a group.
+//
+// Deprecated: Use DeleteWithContext, which allows to pass a swap group.
+func (s *GroupService) RemoveWithContext(ctx context.Context, g string) (*Response, error) {
+	return s.DeleteWithContext(ctx, g, "")
+}
+
+// Remove wraps RemoveWithContext using the background context.
+//
+// Deprecated: Use Delete, which allows to pass a swap group.
+func (s *GroupService) Remove(g string) (*Response, error) {
+	return s.RemoveWithContext(context.Background(), g)
+}
+
+// GetBulkWithContext returns a page of groups, e.g. to resolve group names to group ids.
+// Without options all groups are returned.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-group-bulk-get
+func (s *GroupService) GetBulkWithContext(ctx context.Context, options *GroupBulkOptions) ([]GroupIdentity, *Response, error) {
+	apiEndPoint, err := addOptions(restAPIBase+"/group/bulk", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(groupBulkResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Groups, resp, nil
+}
+
+// GetBulk wraps GetBulkWithContext using the background context.
+func (s *GroupService) GetBulk(options *GroupBulkOptions) ([]GroupIdentity, *Response, error) {
+	return s.GetBulkWithContext(context.Background(), options)
+}
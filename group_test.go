@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -9,9 +10,10 @@ import (
 func TestGroupService_Get(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/member", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/group/member?groupname=default")
+		testRequestURL(t, r, "/rest/api/3/group/member?groupname=default")
 		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/group/member?includeInactiveUsers=false&maxResults=50&groupname=default&startAt=0","maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"self":"http://www.example.com/jira/rest/api/2/user?username=michael","name":"michael","key":"michael","emailAddress":"michael@example.com","displayName":"MichaelScofield","active":true,"timeZone":"Australia/Sydney"},{"self":"http://www.example.com/jira/rest/api/2/user?username=alex","name":"alex","key":"alex","emailAddress":"alex@example.com","displayName":"AlexanderMahone","active":true,"timeZone":"Australia/Sydney"}]}`)
 	})
 	if members, _, err := testClient.Group.Get("default"); err != nil {
@@ -24,9 +26,10 @@ func TestGroupService_Get(t *testing.T) {
 func TestGroupService_GetPage(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/member", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/group/member?groupname=default")
+		testRequestURL(t, r, "/rest/api/3/group/member?groupname=default")
 		startAt := r.URL.Query().Get("startAt")
 		if startAt == "0" {
 			fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/group/member?includeInactiveUsers=false&maxResults=2&groupname=default&startAt=0","nextPage":"`+testServer.URL+`/rest/api/2/group/member?groupname=default&includeInactiveUsers=false&maxResults=2&startAt=2","maxResults":2,"startAt":0,"total":4,"isLast":false,"values":[{"self":"http://www.example.com/jira/rest/api/2/user?username=michael","name":"michael","key":"michael","emailAddress":"michael@example.com","displayName":"MichaelScofield","active":true,"timeZone":"Australia/Sydney"},{"self":"http://www.example.com/jira/rest/api/2/user?username=alex","name":"alex","key":"alex","emailAddress":"alex@example.com","displayName":"AlexanderMahone","active":true,"timeZone":"Australia/Sydney"}]}`)
@@ -79,15 +82,16 @@ func TestGroupService_GetPage(t *testing.T) {
 func TestGroupService_Add(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/group/user?groupname=default")
+		testRequestURL(t, r, "/rest/api/3/group/user?groupname=default")
 
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"name":"default","self":"http://www.example.com/jira/rest/api/2/group?groupname=default","users":{"size":1,"items":[],"max-results":50,"start-index":0,"end-index":0},"expand":"users"}`)
 	})
 
-	if group, _, err := testClient.Group.Add("default", "theodore"); err != nil {
+	if group, _, err := testClient.Group.AddUser("default", "theodore"); err != nil {
 		t.Errorf("Error given: %s", err)
 	} else if group == nil {
 		t.Error("Expected group. Group is nil")
@@ -97,15 +101,197 @@ func TestGroupService_Add(t *testing.T) {
 func TestGroupService_Remove(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "DELETE")
-		testRequestURL(t, r, "/rest/api/2/group/user?groupname=default")
+		testRequestURL(t, r, "/rest/api/3/group/user?groupname=default")
 
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprint(w, `{"name":"default","self":"http://www.example.com/jira/rest/api/2/group?groupname=default","users":{"size":1,"items":[],"max-results":50,"start-index":0,"end-index":0},"expand":"users"}`)
 	})
 
-	if _, err := testClient.Group.Remove("default", "theodore"); err != nil {
+	if _, err := testClient.Group.RemoveUser("default", "theodore"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_AddUser_Dialect(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	var body map[string]string
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"default"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"default"}`)
+	})
+
+	if _, _, err := testClient.Group.AddUser("default", "5b10a2844c20165700ede21g"); err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if len(body) != 1 || body["accountId"] != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected the account id on JIRA Cloud. Got %v", body)
+	}
+
+	testClient.Dialect = DialectServer
+	if _, _, err := testClient.Group.AddUser("default", "theodore"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(body) != 1 || body["name"] != "theodore" {
+		t.Errorf("Expected the username on JIRA Server. Got %v", body)
+	}
+}
+
+func TestGroupService_RemoveUser_Dialect(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/3/group/user?groupname=default&accountId=5b10a2844c20165700ede21g")
+	})
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/group/user?groupname=default&username=theodore")
+	})
+
+	if _, err := testClient.Group.RemoveUser("default", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	testClient.Dialect = DialectServer
+	if _, err := testClient.Group.RemoveUser("default", "theodore"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_GetPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		startAt := r.URL.Query().Get("startAt")
+		if startAt == "0" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":[{"name":"michael"},{"name":"alex"}]}`)
+		} else if startAt == "2" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":[{"name":"lincoln"}]}`)
+		} else {
+			t.Errorf("startAt %s", startAt)
+		}
+	})
+
+	var members []string
+	err := testClient.Group.GetPages("default", &GroupSearchOptions{MaxResults: 2}, func(member GroupMember) error {
+		members = append(members, member.Name)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(members) != 3 {
+		t.Errorf("Expected 3 members, %v given", members)
+	}
+}
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/group")
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload) != 1 || payload["name"] != "team-a" {
+			t.Errorf("Expected only the group name in payload, got %v instead", payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"team-a","self":"http://www.example.com/jira/rest/api/2/group?groupname=team-a","users":{"size":0,"items":[],"max-results":50,"start-index":0,"end-index":0},"expand":"users"}`)
+	})
+
+	group, _, err := testClient.Group.Create("team-a")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if group == nil || group.Name != "team-a" {
+		t.Errorf("Expected group team-a. Got %+v", group)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/3/group?groupname=team+a&swapGroup=team-b")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Group.Delete("team a", "team-b"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_GetBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/group/bulk?groupName=jira-administrators&groupName=team-a&maxResults=10")
+
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":2,"isLast":true,"values":[{"name":"jira-administrators","groupId":"276f955c-63d7-42c8-9520-92d01dca0625"},{"name":"team-a","groupId":"6e87dc72-4f1f-421f-9382-2fee8b652487"}]}`)
+	})
+
+	groups, resp, err := testClient.Group.GetBulk(&GroupBulkOptions{GroupNames: []string{"jira-administrators", "team-a"}, MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(groups) != 2 || groups[1].GroupID != "6e87dc72-4f1f-421f-9382-2fee8b652487" {
+		t.Errorf("Expected the id of team-a. Got %+v", groups)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total of 2. Got %d", resp.Total)
+	}
+}
+
+func TestGroupService_GetAllMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if maxResults := r.URL.Query().Get("maxResults"); maxResults != "50" {
+			t.Errorf("Expected the default page size of 50. Got %s", maxResults)
+		}
+		startAt := r.URL.Query().Get("startAt")
+		if startAt == "0" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":[{"name":"michael"},{"name":"alex"}]}`)
+		} else if startAt == "2" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":[{"name":"lincoln"}]}`)
+		} else {
+			t.Errorf("startAt %s", startAt)
+		}
+	})
+
+	members, err := testClient.Group.GetAllMembers("default")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(members) != 3 || members[2].Name != "lincoln" {
+		t.Errorf("Expected 3 members, %v given", members)
+	}
 }
@@ -0,0 +1,973 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+// Services holds the services of a Client by their interfaces.
+// Code depending on Services instead of the Client can be tested with mocks of the services
+// instead of a JIRA instance or an HTTP server:
+//
+//	type mockIssues struct{ jira.IssueServiceAPI }
+//
+//	func (mockIssues) Get(issueID string, options *jira.GetQueryOptions) (*jira.Issue, *jira.Response, error) {
+//		return &jira.Issue{Key: issueID}, nil, nil
+//	}
+//
+//	services := &jira.Services{Issue: mockIssues{}}
+type Services struct {
+	Authentication      AuthenticationServiceAPI
+	Issue               IssueServiceAPI
+	Project             ProjectServiceAPI
+	Board               BoardServiceAPI
+	Sprint              SprintServiceAPI
+	User                UserServiceAPI
+	Group               GroupServiceAPI
+	Version             VersionServiceAPI
+	Priority            PriorityServiceAPI
+	Field               FieldServiceAPI
+	Component           ComponentServiceAPI
+	Resolution          ResolutionServiceAPI
+	StatusCategory      StatusCategoryServiceAPI
+	Status              StatusServiceAPI
+	Epic                EpicServiceAPI
+	Worklog             WorklogServiceAPI
+	Webhook             WebhookServiceAPI
+	Filter              FilterServiceAPI
+	Dashboard           DashboardServiceAPI
+	IssueLink           IssueLinkServiceAPI
+	IssueLinkType       IssueLinkTypeServiceAPI
+	IssueType           IssueTypeServiceAPI
+	Workflow            WorkflowServiceAPI
+	PermissionScheme    PermissionSchemeServiceAPI
+	Permission          PermissionServiceAPI
+	ProjectRole         ProjectRoleServiceAPI
+	NotificationScheme  NotificationSchemeServiceAPI
+	IssueSecurityScheme IssueSecuritySchemeServiceAPI
+	Screen              ScreenServiceAPI
+	ServerInfo          ServerInfoServiceAPI
+	JQL                 JQLServiceAPI
+	Label               LabelServiceAPI
+	Backlog             BacklogServiceAPI
+	ServiceDesk         ServiceDeskServiceAPI
+	Assets              AssetsServiceAPI
+	Audit               AuditServiceAPI
+	ApplicationRole     ApplicationRoleServiceAPI
+	Avatar              AvatarServiceAPI
+}
+
+// Services returns the services of the client by their interfaces.
+func (c *Client) Services() *Services {
+	return &Services{
+		Authentication:      c.Authentication,
+		Issue:               c.Issue,
+		Project:             c.Project,
+		Board:               c.Board,
+		Sprint:              c.Sprint,
+		User:                c.User,
+		Group:               c.Group,
+		Version:             c.Version,
+		Priority:            c.Priority,
+		Field:               c.Field,
+		Component:           c.Component,
+		Resolution:          c.Resolution,
+		StatusCategory:      c.StatusCategory,
+		Status:              c.Status,
+		Epic:                c.Epic,
+		Worklog:             c.Worklog,
+		Webhook:             c.Webhook,
+		Filter:              c.Filter,
+		Dashboard:           c.Dashboard,
+		IssueLink:           c.IssueLink,
+		IssueLinkType:       c.IssueLinkType,
+		IssueType:           c.IssueType,
+		Workflow:            c.Workflow,
+		PermissionScheme:    c.PermissionScheme,
+		Permission:          c.Permission,
+		ProjectRole:         c.ProjectRole,
+		NotificationScheme:  c.NotificationScheme,
+		IssueSecurityScheme: c.IssueSecurityScheme,
+		Screen:              c.Screen,
+		ServerInfo:          c.ServerInfo,
+		JQL:                 c.JQL,
+		Label:               c.Label,
+		Backlog:             c.Backlog,
+		ServiceDesk:         c.ServiceDesk,
+		Assets:              c.Assets,
+		Audit:               c.Audit,
+		ApplicationRole:     c.ApplicationRole,
+		Avatar:              c.Avatar,
+	}
+}
+
+// AuthenticationServiceAPI is the interface of the AuthenticationService, e.g. to replace it by a mock in tests.
+type AuthenticationServiceAPI interface {
+	AcquireSessionCookieWithContext(ctx context.Context, username, password string) (bool, error)
+	AcquireSessionCookie(username, password string) (bool, error)
+	SetBasicAuth(username, password string)
+	Authenticated() bool
+	LogoutWithContext(ctx context.Context) error
+	Logout() error
+	GetCurrentUserWithContext(ctx context.Context) (*Session, error)
+	GetCurrentUser() (*Session, error)
+}
+
+// IssueServiceAPI is the interface of the IssueService, e.g. to replace it by a mock in tests.
+type IssueServiceAPI interface {
+	GetWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	GetV3WithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	GetV3(issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	DownloadAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error)
+	DownloadAttachment(attachmentID string) (*Response, error)
+	PostAttachmentWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	PostAttachment(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	DownloadAttachmentToWithContext(ctx context.Context, attachmentID string, w io.Writer) (*Response, error)
+	DownloadAttachmentTo(attachmentID string, w io.Writer) (*Response, error)
+	PostAttachmentStreamWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	PostAttachmentStream(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	GetWorklogsWithContext(ctx context.Context, issueID string) (*Worklog, *Response, error)
+	GetWorklogs(issueID string) (*Worklog, *Response, error)
+	CreateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	Create(issue *Issue) (*Issue, *Response, error)
+	CreateV3WithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	CreateV3(issue *Issue) (*Issue, *Response, error)
+	BulkCreateWithContext(ctx context.Context, issues []*Issue) (*IssueBulkCreateResult, *Response, error)
+	BulkCreate(issues []*Issue) (*IssueBulkCreateResult, *Response, error)
+	BulkCreateAllWithContext(ctx context.Context, issues []*Issue) (*IssueBulkCreateResult, error)
+	BulkCreateAll(issues []*Issue) (*IssueBulkCreateResult, error)
+	UpdateWithOptionsWithContext(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error)
+	UpdateWithOptions(issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error)
+	UpdateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	Update(issue *Issue) (*Issue, *Response, error)
+	UpdateV3WithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	UpdateV3(issue *Issue) (*Issue, *Response, error)
+	UpdateIssueWithContext(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error)
+	UpdateIssue(jiraID string, data map[string]interface{}) (*Response, error)
+	EditWithContext(ctx context.Context, issueID string, update *IssueUpdate, opts *UpdateQueryOptions) (*Response, error)
+	Edit(issueID string, update *IssueUpdate, opts *UpdateQueryOptions) (*Response, error)
+	SetSecurityLevelWithContext(ctx context.Context, issueID, levelID string) (*Response, error)
+	SetSecurityLevel(issueID, levelID string) (*Response, error)
+	AddLabelsWithContext(ctx context.Context, issueID string, labels ...string) (*Response, error)
+	AddLabels(issueID string, labels ...string) (*Response, error)
+	RemoveLabelsWithContext(ctx context.Context, issueID string, labels ...string) (*Response, error)
+	RemoveLabels(issueID string, labels ...string) (*Response, error)
+	AddCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	AddComment(issueID string, comment *Comment) (*Comment, *Response, error)
+	AddCommentV3WithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	AddCommentV3(issueID string, comment *Comment) (*Comment, *Response, error)
+	GetCommentWithContext(ctx context.Context, issueID, commentID string) (*Comment, *Response, error)
+	GetComment(issueID, commentID string) (*Comment, *Response, error)
+	ListCommentsWithContext(ctx context.Context, issueID string, options *CommentListOptions) ([]*Comment, *Response, error)
+	ListComments(issueID string, options *CommentListOptions) ([]*Comment, *Response, error)
+	UpdateCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	UpdateComment(issueID string, comment *Comment) (*Comment, *Response, error)
+	UpdateCommentV3WithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	UpdateCommentV3(issueID string, comment *Comment) (*Comment, *Response, error)
+	DeleteCommentWithContext(ctx context.Context, issueID, commentID string) error
+	DeleteComment(issueID, commentID string) error
+	AddWorklogRecordWithOptionsWithContext(ctx context.Context, issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error)
+	AddWorklogRecordWithOptions(issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error)
+	AddWorklogRecordWithContext(ctx context.Context, issueID string, record *WorklogRecord) (*WorklogRecord, *Response, error)
+	AddWorklogRecord(issueID string, record *WorklogRecord) (*WorklogRecord, *Response, error)
+	UpdateWorklogRecordWithContext(ctx context.Context, issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error)
+	UpdateWorklogRecord(issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error)
+	DeleteWorklogRecordWithContext(ctx context.Context, issueID, worklogID string, opts *WorklogOptions) (*Response, error)
+	DeleteWorklogRecord(issueID, worklogID string, opts *WorklogOptions) (*Response, error)
+	AddLinkWithContext(ctx context.Context, issueLink *IssueLink) (*Response, error)
+	AddLink(issueLink *IssueLink) (*Response, error)
+	SearchWithContext(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error)
+	Search(jql string, options *SearchOptions) ([]Issue, *Response, error)
+	SearchIntoWithContext(ctx context.Context, jql string, options *SearchOptions, v interface{}) (*Response, error)
+	SearchInto(jql string, options *SearchOptions, v interface{}) (*Response, error)
+	GetIntoWithContext(ctx context.Context, issueID string, options *GetQueryOptions, v interface{}) (*Response, error)
+	GetInto(issueID string, options *GetQueryOptions, v interface{}) (*Response, error)
+	FindWithContext(ctx context.Context, jql string, tweaks ...SearchOption) ([]Issue, *Response, error)
+	Find(jql string, tweaks ...SearchOption) ([]Issue, *Response, error)
+	SearchStreamWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) (int, *Response, error)
+	SearchStream(jql string, options *SearchOptions, f func(Issue) error) (int, *Response, error)
+	SearchPagesWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error
+	SearchPages(jql string, options *SearchOptions, f func(Issue) error) error
+	GetCustomFieldsWithContext(ctx context.Context, issueID string) (CustomFields, *Response, error)
+	GetCustomFields(issueID string) (CustomFields, *Response, error)
+	GetTransitionsWithContext(ctx context.Context, id string) ([]Transition, *Response, error)
+	GetTransitions(id string) ([]Transition, *Response, error)
+	DoTransitionWithContext(ctx context.Context, ticketID, transitionID string) (*Response, error)
+	DoTransition(ticketID, transitionID string) (*Response, error)
+	DoTransitionWithFieldsWithContext(ctx context.Context, ticketID, transitionID string, fields map[string]interface{}) (*Response, error)
+	DoTransitionWithFields(ticketID, transitionID string, fields map[string]interface{}) (*Response, error)
+	DoTransitionWithPayloadWithContext(ctx context.Context, ticketID, payload interface{}) (*Response, error)
+	DoTransitionWithPayload(ticketID, payload interface{}) (*Response, error)
+	DeleteWithContext(ctx context.Context, issueID string) (*Response, error)
+	Delete(issueID string) (*Response, error)
+	GetWatchesWithContext(ctx context.Context, issueID string) (*Watches, *Response, error)
+	GetWatches(issueID string) (*Watches, *Response, error)
+	GetWatchersWithContext(ctx context.Context, issueID string) (*[]User, *Response, error)
+	GetWatchers(issueID string) (*[]User, *Response, error)
+	AddWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error)
+	AddWatcher(issueID string, userName string) (*Response, error)
+	RemoveWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error)
+	RemoveWatcher(issueID string, userName string) (*Response, error)
+	AddWatcherByAccountIDWithContext(ctx context.Context, issueID string, accountID string) (*Response, error)
+	AddWatcherByAccountID(issueID string, accountID string) (*Response, error)
+	RemoveWatcherByAccountIDWithContext(ctx context.Context, issueID string, accountID string) (*Response, error)
+	RemoveWatcherByAccountID(issueID string, accountID string) (*Response, error)
+	GetVotesWithContext(ctx context.Context, issueID string) (*Votes, *Response, error)
+	GetVotes(issueID string) (*Votes, *Response, error)
+	VoteWithContext(ctx context.Context, issueID string) (*Response, error)
+	Vote(issueID string) (*Response, error)
+	UnvoteWithContext(ctx context.Context, issueID string) (*Response, error)
+	Unvote(issueID string) (*Response, error)
+	UpdateAssigneeWithContext(ctx context.Context, issueID string, assignee *User) (*Response, error)
+	UpdateAssignee(issueID string, assignee *User) (*Response, error)
+	RankWithContext(ctx context.Context, options *IssueRankOptions) ([]IssueRankEntry, *Response, error)
+	Rank(options *IssueRankOptions) ([]IssueRankEntry, *Response, error)
+	NotifyWithContext(ctx context.Context, issueID string, notification *IssueNotification) (*Response, error)
+	Notify(issueID string, notification *IssueNotification) (*Response, error)
+	AssignWithContext(ctx context.Context, issueID, user string) (*Response, error)
+	Assign(issueID, user string) (*Response, error)
+	AssignAutomaticallyWithContext(ctx context.Context, issueID string) (*Response, error)
+	AssignAutomatically(issueID string) (*Response, error)
+	UnassignWithContext(ctx context.Context, issueID string) (*Response, error)
+	Unassign(issueID string) (*Response, error)
+	GetRemoteLinksWithContext(ctx context.Context, issueID string) ([]RemoteLink, *Response, error)
+	GetRemoteLinks(issueID string) ([]RemoteLink, *Response, error)
+	GetRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*RemoteLink, *Response, error)
+	GetRemoteLink(issueID string, linkID int) (*RemoteLink, *Response, error)
+	GetRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID string, globalID string) (*RemoteLink, *Response, error)
+	GetRemoteLinkByGlobalID(issueID string, globalID string) (*RemoteLink, *Response, error)
+	AddRemoteLinkWithContext(ctx context.Context, issueID string, remoteLink *RemoteLink) (*RemoteLink, *Response, error)
+	AddRemoteLink(issueID string, remoteLink *RemoteLink) (*RemoteLink, *Response, error)
+	UpdateRemoteLinkWithContext(ctx context.Context, issueID string, linkID int, remoteLink *RemoteLink) (*Response, error)
+	UpdateRemoteLink(issueID string, linkID int, remoteLink *RemoteLink) (*Response, error)
+	DeleteRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*Response, error)
+	DeleteRemoteLink(issueID string, linkID int) (*Response, error)
+	DeleteRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID string, globalID string) (*Response, error)
+	DeleteRemoteLinkByGlobalID(issueID string, globalID string) (*Response, error)
+	GetChangelogPageWithContext(ctx context.Context, issueID string, options *ChangelogListOptions) ([]ChangelogHistory, *Response, error)
+	GetChangelogPage(issueID string, options *ChangelogListOptions) ([]ChangelogHistory, *Response, error)
+	GetChangelogWithContext(ctx context.Context, issueID string) ([]ChangelogHistory, error)
+	GetChangelog(issueID string) ([]ChangelogHistory, error)
+	GetPickerSuggestionsWithContext(ctx context.Context, options *IssuePickerOptions) (*IssuePickerResult, *Response, error)
+	GetPickerSuggestions(options *IssuePickerOptions) (*IssuePickerResult, *Response, error)
+	SearchAllParallelWithContext(ctx context.Context, jql string, options *SearchOptions, workers int) ([]Issue, error)
+	SearchAllParallel(jql string, options *SearchOptions, workers int) ([]Issue, error)
+	GetManyWithContext(ctx context.Context, keys []string, fields ...string) (map[string]*Issue, []string, error)
+	GetMany(keys []string, fields ...string) (map[string]*Issue, []string, error)
+	SyncWithContext(ctx context.Context, options *IssueSyncOptions) (*IssueSyncResult, error)
+	Sync(options *IssueSyncOptions) (*IssueSyncResult, error)
+	GetCreateMetaWithContext(ctx context.Context, projectkeys string) (*CreateMetaInfo, *Response, error)
+	GetCreateMeta(projectkeys string) (*CreateMetaInfo, *Response, error)
+	GetCreateMetaWithOptionsWithContext(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error)
+	GetCreateMetaWithOptions(options *GetQueryOptions) (*CreateMetaInfo, *Response, error)
+	GetCreateMetaIssueTypesWithContext(ctx context.Context, projectIDOrKey string, options *CreateMetaPageOptions) ([]*MetaIssueType, *Response, error)
+	GetCreateMetaIssueTypes(projectIDOrKey string, options *CreateMetaPageOptions) ([]*MetaIssueType, *Response, error)
+	GetCreateMetaFieldsWithContext(ctx context.Context, projectIDOrKey, issueTypeID string, options *CreateMetaPageOptions) ([]MetaField, *Response, error)
+	GetCreateMetaFields(projectIDOrKey, issueTypeID string, options *CreateMetaPageOptions) ([]MetaField, *Response, error)
+	GetEditMetaWithContext(ctx context.Context, issueID string) (*EditMetaInfo, *Response, error)
+	GetEditMeta(issueID string) (*EditMetaInfo, *Response, error)
+}
+
+// ProjectServiceAPI is the interface of the ProjectService, e.g. to replace it by a mock in tests.
+type ProjectServiceAPI interface {
+	GetListWithContext(ctx context.Context) (*ProjectList, *Response, error)
+	GetList() (*ProjectList, *Response, error)
+	ListWithOptionsWithContext(ctx context.Context, options *GetAllProjectsQueryParams) (*ProjectList, *Response, error)
+	ListWithOptions(options *GetAllProjectsQueryParams) (*ProjectList, *Response, error)
+	GetWithContext(ctx context.Context, projectID string) (*Project, *Response, error)
+	Get(projectID string) (*Project, *Response, error)
+	GetPermissionSchemeWithContext(ctx context.Context, projectID string) (*PermissionScheme, *Response, error)
+	GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error)
+	CreateWithContext(ctx context.Context, options *ProjectOptions) (*ProjectIdentity, *Response, error)
+	Create(options *ProjectOptions) (*ProjectIdentity, *Response, error)
+	UpdateWithContext(ctx context.Context, projectID string, options *ProjectOptions) (*Project, *Response, error)
+	Update(projectID string, options *ProjectOptions) (*Project, *Response, error)
+	ArchiveWithContext(ctx context.Context, projectID string) (*Response, error)
+	Archive(projectID string) (*Response, error)
+	DeleteWithContext(ctx context.Context, projectID string) (*Response, error)
+	Delete(projectID string) (*Response, error)
+	GetPropertyKeysWithContext(ctx context.Context, projectID string) ([]EntityPropertyKey, *Response, error)
+	GetPropertyKeys(projectID string) ([]EntityPropertyKey, *Response, error)
+	GetPropertyWithContext(ctx context.Context, projectID, propertyKey string) (*EntityProperty, *Response, error)
+	GetProperty(projectID, propertyKey string) (*EntityProperty, *Response, error)
+	SetPropertyWithContext(ctx context.Context, projectID, propertyKey string, value interface{}) (*Response, error)
+	SetProperty(projectID, propertyKey string, value interface{}) (*Response, error)
+	DeletePropertyWithContext(ctx context.Context, projectID, propertyKey string) (*Response, error)
+	DeleteProperty(projectID, propertyKey string) (*Response, error)
+	GetFeaturesWithContext(ctx context.Context, projectID string) ([]ProjectFeature, *Response, error)
+	GetFeatures(projectID string) ([]ProjectFeature, *Response, error)
+	SetFeatureStateWithContext(ctx context.Context, projectID, featureKey, state string) ([]ProjectFeature, *Response, error)
+	SetFeatureState(projectID, featureKey, state string) ([]ProjectFeature, *Response, error)
+}
+
+// BoardServiceAPI is the interface of the BoardService, e.g. to replace it by a mock in tests.
+type BoardServiceAPI interface {
+	GetAllBoardsWithContext(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error)
+	GetAllBoards(opt *BoardListOptions) (*BoardsList, *Response, error)
+	GetBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error)
+	GetBoard(boardID int) (*Board, *Response, error)
+	CreateBoardWithContext(ctx context.Context, board *Board) (*Board, *Response, error)
+	CreateBoard(board *Board) (*Board, *Response, error)
+	DeleteBoardWithContext(ctx context.Context, boardID int) (*Board, *Response, error)
+	DeleteBoard(boardID int) (*Board, *Response, error)
+	GetBoardConfigurationWithContext(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error)
+	GetBoardConfiguration(boardID int) (*BoardConfiguration, *Response, error)
+	GetIssuesForBoardWithContext(ctx context.Context, boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	GetIssuesForBoard(boardID int, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	GetAllSprintsWithContext(ctx context.Context, boardID string) ([]Sprint, *Response, error)
+	GetAllSprints(boardID string) ([]Sprint, *Response, error)
+	GetAllSprintsWithOptionsWithContext(ctx context.Context, boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error)
+	GetAllSprintsWithOptions(boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error)
+	GetSprintsByStateWithContext(ctx context.Context, boardID int, states ...string) ([]Sprint, *Response, error)
+	GetSprintsByState(boardID int, states ...string) ([]Sprint, *Response, error)
+}
+
+// SprintServiceAPI is the interface of the SprintService, e.g. to replace it by a mock in tests.
+type SprintServiceAPI interface {
+	MoveIssuesToSprintWithContext(ctx context.Context, sprintID int, issueIDs []string) (*Response, error)
+	MoveIssuesToSprint(sprintID int, issueIDs []string) (*Response, error)
+	GetIssuesForSprintWithContext(ctx context.Context, sprintID int) ([]Issue, *Response, error)
+	GetIssuesForSprint(sprintID int) ([]Issue, *Response, error)
+	GetIssuesForSprintWithOptionsWithContext(ctx context.Context, sprintID int, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	GetIssuesForSprintWithOptions(sprintID int, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	GetIssueWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+	GetWithContext(ctx context.Context, sprintID int) (*Sprint, *Response, error)
+	Get(sprintID int) (*Sprint, *Response, error)
+	CreateWithContext(ctx context.Context, sprint *SprintOptions) (*Sprint, *Response, error)
+	Create(sprint *SprintOptions) (*Sprint, *Response, error)
+	UpdateWithContext(ctx context.Context, sprintID int, sprint *SprintOptions) (*Sprint, *Response, error)
+	Update(sprintID int, sprint *SprintOptions) (*Sprint, *Response, error)
+	UpdateStateWithContext(ctx context.Context, sprintID int, state string) (*Sprint, *Response, error)
+	UpdateState(sprintID int, state string) (*Sprint, *Response, error)
+}
+
+// UserServiceAPI is the interface of the UserService, e.g. to replace it by a mock in tests.
+type UserServiceAPI interface {
+	GetWithContext(ctx context.Context, username string) (*User, *Response, error)
+	Get(username string) (*User, *Response, error)
+	GetByAccountIDWithContext(ctx context.Context, accountID string) (*User, *Response, error)
+	GetByAccountID(accountID string) (*User, *Response, error)
+	GetUserWithContext(ctx context.Context, user *User) (*User, *Response, error)
+	GetUser(user *User) (*User, *Response, error)
+	CreateWithContext(ctx context.Context, user *User) (*User, *Response, error)
+	Create(user *User) (*User, *Response, error)
+	DeleteWithContext(ctx context.Context, username string) (*Response, error)
+	Delete(username string) (*Response, error)
+	DeleteByAccountIDWithContext(ctx context.Context, accountID string) (*Response, error)
+	DeleteByAccountID(accountID string) (*Response, error)
+	GetGroupsWithContext(ctx context.Context, username string) (*[]UserGroup, *Response, error)
+	GetGroups(username string) (*[]UserGroup, *Response, error)
+	GetGroupsByAccountIDWithContext(ctx context.Context, accountID string) (*[]UserGroup, *Response, error)
+	GetGroupsByAccountID(accountID string) (*[]UserGroup, *Response, error)
+	GetSelfWithContext(ctx context.Context) (*User, *Response, error)
+	GetSelf() (*User, *Response, error)
+	FindWithContext(ctx context.Context, tweaks ...SearchOption) ([]User, *Response, error)
+	Find(tweaks ...SearchOption) ([]User, *Response, error)
+	FindPagesWithContext(ctx context.Context, f func(User) error, tweaks ...SearchOption) error
+	FindPages(f func(User) error, tweaks ...SearchOption) error
+	FindWithQueryParamsWithContext(ctx context.Context, qp url.Values) ([]User, *Response, error)
+	FindWithQueryParams(qp url.Values) ([]User, *Response, error)
+	GetWithQueryParamsWithContext(ctx context.Context, qp url.Values) (*User, *Response, error)
+	GetWithQueryParams(qp url.Values) (*User, *Response, error)
+	GetBulkWithContext(ctx context.Context, accountIDs []string) ([]User, error)
+	GetBulk(accountIDs []string) ([]User, error)
+	FindAssignableWithContext(ctx context.Context, options *AssignableUserSearchOptions) ([]User, *Response, error)
+	FindAssignable(options *AssignableUserSearchOptions) ([]User, *Response, error)
+	FindAssignableInProjectsWithContext(ctx context.Context, options *AssignableUserSearchOptions) ([]User, *Response, error)
+	FindAssignableInProjects(options *AssignableUserSearchOptions) ([]User, *Response, error)
+	FindUsersWithPermissionWithContext(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error)
+	FindUsersWithPermission(options *UserPermissionSearchOptions) ([]User, *Response, error)
+	FindUsersWithBrowsePermissionWithContext(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error)
+	FindUsersWithBrowsePermission(options *UserPermissionSearchOptions) ([]User, *Response, error)
+	GetColumnsWithContext(ctx context.Context, username string) ([]UserColumn, *Response, error)
+	GetColumns(username string) ([]UserColumn, *Response, error)
+	SetColumnsWithContext(ctx context.Context, username string, columns []string) (*Response, error)
+	SetColumns(username string, columns []string) (*Response, error)
+	ResetColumnsWithContext(ctx context.Context, username string) (*Response, error)
+	ResetColumns(username string) (*Response, error)
+	ValidateAnonymizationWithContext(ctx context.Context, userKey string) (*UserAnonymizationValidation, *Response, error)
+	ValidateAnonymization(userKey string) (*UserAnonymizationValidation, *Response, error)
+	ScheduleAnonymizationWithContext(ctx context.Context, options *UserAnonymizationOptions) (*UserAnonymizationProgress, *Response, error)
+	ScheduleAnonymization(options *UserAnonymizationOptions) (*UserAnonymizationProgress, *Response, error)
+	GetAnonymizationProgressWithContext(ctx context.Context, taskID string) (*UserAnonymizationProgress, *Response, error)
+	GetAnonymizationProgress(taskID string) (*UserAnonymizationProgress, *Response, error)
+}
+
+// GroupServiceAPI is the interface of the GroupService, e.g. to replace it by a mock in tests.
+type GroupServiceAPI interface {
+	GetWithContext(ctx context.Context, name string) ([]GroupMember, *Response, error)
+	Get(name string) ([]GroupMember, *Response, error)
+	GetWithOptionsWithContext(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error)
+	GetWithOptions(name string, options *GroupSearchOptions) ([]GroupMember, *Response, error)
+	GetPagesWithContext(ctx context.Context, name string, options *GroupSearchOptions, f func(GroupMember) error) error
+	GetPages(name string, options *GroupSearchOptions, f func(GroupMember) error) error
+	GetAllMembersWithContext(ctx context.Context, name string) ([]GroupMember, error)
+	GetAllMembers(name string) ([]GroupMember, error)
+	AddUserWithContext(ctx context.Context, groupname string, userParams ...string) (*Group, *Response, error)
+	AddUser(groupname string, userParams ...string) (*Group, *Response, error)
+	RemoveUserWithContext(ctx context.Context, groupname string, username string) (*Response, error)
+	RemoveUser(groupname string, username string) (*Response, error)
+	GetListWithContext(ctx context.Context) (*GroupList, *Response, error)
+	GetList() (*GroupList, *Response, error)
+	GetListWithOptionsWithContext(ctx context.Context, v url.Values) (*GroupList, *Response, error)
+	GetListWithOptions(v url.Values) (*GroupList, *Response, error)
+	CreateWithContext(ctx context.Context, name string) (*GroupDetails, *Response, error)
+	Create(name string) (*GroupDetails, *Response, error)
+	DeleteWithContext(ctx context.Context, name string, swapGroup string) (*Response, error)
+	Delete(name string, swapGroup string) (*Response, error)
+	RemoveWithContext(ctx context.Context, g string) (*Response, error)
+	Remove(g string) (*Response, error)
+	GetBulkWithContext(ctx context.Context, options *GroupBulkOptions) ([]GroupIdentity, *Response, error)
+	GetBulk(options *GroupBulkOptions) ([]GroupIdentity, *Response, error)
+}
+
+// VersionServiceAPI is the interface of the VersionService, e.g. to replace it by a mock in tests.
+type VersionServiceAPI interface {
+	GetWithContext(ctx context.Context, versionID int) (*Version, *Response, error)
+	Get(versionID int) (*Version, *Response, error)
+	CreateWithContext(ctx context.Context, version *Version) (*Version, *Response, error)
+	Create(version *Version) (*Version, *Response, error)
+	UpdateWithContext(ctx context.Context, version *Version) (*Version, *Response, error)
+	Update(version *Version) (*Version, *Response, error)
+	GetListWithContext(ctx context.Context, projectIDOrKey string) ([]Version, *Response, error)
+	GetList(projectIDOrKey string) ([]Version, *Response, error)
+	ReleaseWithContext(ctx context.Context, versionID int, releaseDate string) (*Version, *Response, error)
+	Release(versionID int, releaseDate string) (*Version, *Response, error)
+	DeleteWithContext(ctx context.Context, versionID int, options *VersionDeleteOptions) (*Response, error)
+	Delete(versionID int, options *VersionDeleteOptions) (*Response, error)
+	MoveWithContext(ctx context.Context, versionID int, options *VersionMoveOptions) (*Version, *Response, error)
+	Move(versionID int, options *VersionMoveOptions) (*Version, *Response, error)
+	GetRelatedIssueCountsWithContext(ctx context.Context, versionID int) (*VersionIssueCounts, *Response, error)
+	GetRelatedIssueCounts(versionID int) (*VersionIssueCounts, *Response, error)
+	GetUnresolvedIssueCountWithContext(ctx context.Context, versionID int) (int, *Response, error)
+	GetUnresolvedIssueCount(versionID int) (int, *Response, error)
+}
+
+// PriorityServiceAPI is the interface of the PriorityService, e.g. to replace it by a mock in tests.
+type PriorityServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Priority, *Response, error)
+	GetList() ([]Priority, *Response, error)
+	GetWithContext(ctx context.Context, priorityID string) (*Priority, *Response, error)
+	Get(priorityID string) (*Priority, *Response, error)
+}
+
+// FieldServiceAPI is the interface of the FieldService, e.g. to replace it by a mock in tests.
+type FieldServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Field, *Response, error)
+	GetList() ([]Field, *Response, error)
+	CreateCustomFieldWithContext(ctx context.Context, options *CreateCustomFieldOptions) (*Field, *Response, error)
+	CreateCustomField(options *CreateCustomFieldOptions) (*Field, *Response, error)
+	GetContextsWithContext(ctx context.Context, fieldID string, options *FieldContextListOptions) ([]FieldContext, *Response, error)
+	GetContexts(fieldID string, options *FieldContextListOptions) ([]FieldContext, *Response, error)
+	CreateContextWithContext(ctx context.Context, fieldID string, fieldContext *FieldContext) (*FieldContext, *Response, error)
+	CreateContext(fieldID string, fieldContext *FieldContext) (*FieldContext, *Response, error)
+	UpdateContextWithContext(ctx context.Context, fieldID, contextID, name, description string) (*Response, error)
+	UpdateContext(fieldID, contextID, name, description string) (*Response, error)
+	DeleteContextWithContext(ctx context.Context, fieldID, contextID string) (*Response, error)
+	DeleteContext(fieldID, contextID string) (*Response, error)
+	GetContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options *FieldContextOptionListOptions) ([]FieldContextOption, *Response, error)
+	GetContextOptions(fieldID, contextID string, options *FieldContextOptionListOptions) ([]FieldContextOption, *Response, error)
+	CreateContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error)
+	CreateContextOptions(fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error)
+	UpdateContextOptionsWithContext(ctx context.Context, fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error)
+	UpdateContextOptions(fieldID, contextID string, options []FieldContextOption) ([]FieldContextOption, *Response, error)
+	SetContextOptionDisabledWithContext(ctx context.Context, fieldID, contextID string, option FieldContextOption, disabled bool) (*FieldContextOption, *Response, error)
+	SetContextOptionDisabled(fieldID, contextID string, option FieldContextOption, disabled bool) (*FieldContextOption, *Response, error)
+	ReorderContextOptionsWithContext(ctx context.Context, fieldID, contextID string, move *FieldContextOptionMove) (*Response, error)
+	ReorderContextOptions(fieldID, contextID string, move *FieldContextOptionMove) (*Response, error)
+	DeleteContextOptionWithContext(ctx context.Context, fieldID, contextID, optionID string) (*Response, error)
+	DeleteContextOption(fieldID, contextID, optionID string) (*Response, error)
+}
+
+// ComponentServiceAPI is the interface of the ComponentService, e.g. to replace it by a mock in tests.
+type ComponentServiceAPI interface {
+	CreateWithContext(ctx context.Context, options *CreateComponentOptions) (*ProjectComponent, *Response, error)
+	Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error)
+	GetWithContext(ctx context.Context, componentID string) (*ProjectComponent, *Response, error)
+	Get(componentID string) (*ProjectComponent, *Response, error)
+	GetListWithContext(ctx context.Context, projectIDOrKey string) ([]ProjectComponent, *Response, error)
+	GetList(projectIDOrKey string) ([]ProjectComponent, *Response, error)
+	UpdateWithContext(ctx context.Context, componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error)
+	Update(componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error)
+	SetLeadWithContext(ctx context.Context, componentID, leadUserName string) (*ProjectComponent, *Response, error)
+	SetLead(componentID, leadUserName string) (*ProjectComponent, *Response, error)
+	SetAssigneeTypeWithContext(ctx context.Context, componentID, assigneeType string) (*ProjectComponent, *Response, error)
+	SetAssigneeType(componentID, assigneeType string) (*ProjectComponent, *Response, error)
+	DeleteWithContext(ctx context.Context, componentID, moveIssuesTo string) (*Response, error)
+	Delete(componentID, moveIssuesTo string) (*Response, error)
+}
+
+// ResolutionServiceAPI is the interface of the ResolutionService, e.g. to replace it by a mock in tests.
+type ResolutionServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Resolution, *Response, error)
+	GetList() ([]Resolution, *Response, error)
+	GetWithContext(ctx context.Context, resolutionID string) (*Resolution, *Response, error)
+	Get(resolutionID string) (*Resolution, *Response, error)
+}
+
+// StatusCategoryServiceAPI is the interface of the StatusCategoryService, e.g. to replace it by a mock in tests.
+type StatusCategoryServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]StatusCategory, *Response, error)
+	GetList() ([]StatusCategory, *Response, error)
+	GetWithContext(ctx context.Context, idOrKey string) (*StatusCategory, *Response, error)
+	Get(idOrKey string) (*StatusCategory, *Response, error)
+}
+
+// StatusServiceAPI is the interface of the StatusService, e.g. to replace it by a mock in tests.
+type StatusServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Status, *Response, error)
+	GetList() ([]Status, *Response, error)
+	GetWithContext(ctx context.Context, idOrName string) (*Status, *Response, error)
+	Get(idOrName string) (*Status, *Response, error)
+}
+
+// EpicServiceAPI is the interface of the EpicService, e.g. to replace it by a mock in tests.
+type EpicServiceAPI interface {
+	GetWithContext(ctx context.Context, epicIDOrKey string) (*Epic, *Response, error)
+	Get(epicIDOrKey string) (*Epic, *Response, error)
+	GetIssuesWithContext(ctx context.Context, epicIDOrKey string, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	GetIssues(epicIDOrKey string, options *BoardIssuesOptions) ([]Issue, *Response, error)
+	MoveIssuesToEpicWithContext(ctx context.Context, epicIDOrKey string, issueIDs []string) (*Response, error)
+	MoveIssuesToEpic(epicIDOrKey string, issueIDs []string) (*Response, error)
+	RemoveIssuesFromEpicWithContext(ctx context.Context, issueIDs []string) (*Response, error)
+	RemoveIssuesFromEpic(issueIDs []string) (*Response, error)
+	RankWithContext(ctx context.Context, epicIDOrKey string, options *EpicRankOptions) (*Response, error)
+	Rank(epicIDOrKey string, options *EpicRankOptions) (*Response, error)
+}
+
+// WorklogServiceAPI is the interface of the WorklogService, e.g. to replace it by a mock in tests.
+type WorklogServiceAPI interface {
+	GetUpdatedWithContext(ctx context.Context, since int64) (*WorklogChangeList, *Response, error)
+	GetUpdated(since int64) (*WorklogChangeList, *Response, error)
+	GetDeletedWithContext(ctx context.Context, since int64) (*WorklogChangeList, *Response, error)
+	GetDeleted(since int64) (*WorklogChangeList, *Response, error)
+	ListWithContext(ctx context.Context, worklogIDs []int) ([]WorklogRecord, *Response, error)
+	List(worklogIDs []int) ([]WorklogRecord, *Response, error)
+}
+
+// WebhookServiceAPI is the interface of the WebhookService, e.g. to replace it by a mock in tests.
+type WebhookServiceAPI interface {
+	CreateWithContext(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error)
+	Create(webhook *Webhook) (*Webhook, *Response, error)
+	GetListWithContext(ctx context.Context) ([]Webhook, *Response, error)
+	GetList() ([]Webhook, *Response, error)
+	GetWithContext(ctx context.Context, webhookID int) (*Webhook, *Response, error)
+	Get(webhookID int) (*Webhook, *Response, error)
+	UpdateWithContext(ctx context.Context, webhookID int, webhook *Webhook) (*Webhook, *Response, error)
+	Update(webhookID int, webhook *Webhook) (*Webhook, *Response, error)
+	DeleteWithContext(ctx context.Context, webhookID int) (*Response, error)
+	Delete(webhookID int) (*Response, error)
+	RegisterWithContext(ctx context.Context, url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error)
+	Register(url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error)
+	GetRegisteredWithContext(ctx context.Context, options *SearchOptions) ([]WebhookDetails, *Response, error)
+	GetRegistered(options *SearchOptions) ([]WebhookDetails, *Response, error)
+	RefreshWithContext(ctx context.Context, webhookIDs ...int) (*Time, *Response, error)
+	Refresh(webhookIDs ...int) (*Time, *Response, error)
+	UnregisterWithContext(ctx context.Context, webhookIDs ...int) (*Response, error)
+	Unregister(webhookIDs ...int) (*Response, error)
+}
+
+// FilterServiceAPI is the interface of the FilterService, e.g. to replace it by a mock in tests.
+type FilterServiceAPI interface {
+	CreateWithContext(ctx context.Context, filter *Filter) (*Filter, *Response, error)
+	Create(filter *Filter) (*Filter, *Response, error)
+	GetWithContext(ctx context.Context, filterID int) (*Filter, *Response, error)
+	Get(filterID int) (*Filter, *Response, error)
+	UpdateWithContext(ctx context.Context, filterID int, filter *Filter) (*Filter, *Response, error)
+	Update(filterID int, filter *Filter) (*Filter, *Response, error)
+	DeleteWithContext(ctx context.Context, filterID int) (*Response, error)
+	Delete(filterID int) (*Response, error)
+	GetFavouriteListWithContext(ctx context.Context) ([]*Filter, *Response, error)
+	GetFavouriteList() ([]*Filter, *Response, error)
+	GetSharePermissionsWithContext(ctx context.Context, filterID int) ([]SharePermission, *Response, error)
+	GetSharePermissions(filterID int) ([]SharePermission, *Response, error)
+	AddSharePermissionWithContext(ctx context.Context, filterID int, permission *SharePermissionOptions) ([]SharePermission, *Response, error)
+	AddSharePermission(filterID int, permission *SharePermissionOptions) ([]SharePermission, *Response, error)
+	DeleteSharePermissionWithContext(ctx context.Context, filterID, permissionID int) (*Response, error)
+	DeleteSharePermission(filterID, permissionID int) (*Response, error)
+}
+
+// DashboardServiceAPI is the interface of the DashboardService, e.g. to replace it by a mock in tests.
+type DashboardServiceAPI interface {
+	GetListWithContext(ctx context.Context, options *DashboardListOptions) ([]Dashboard, *Response, error)
+	GetList(options *DashboardListOptions) ([]Dashboard, *Response, error)
+	GetWithContext(ctx context.Context, dashboardID string) (*Dashboard, *Response, error)
+	Get(dashboardID string) (*Dashboard, *Response, error)
+	GetItemPropertyKeysWithContext(ctx context.Context, dashboardID, itemID string) ([]EntityPropertyKey, *Response, error)
+	GetItemPropertyKeys(dashboardID, itemID string) ([]EntityPropertyKey, *Response, error)
+	GetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error)
+	GetItemProperty(dashboardID, itemID, propertyKey string) (*EntityProperty, *Response, error)
+	SetItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string, value interface{}) (*Response, error)
+	SetItemProperty(dashboardID, itemID, propertyKey string, value interface{}) (*Response, error)
+	DeleteItemPropertyWithContext(ctx context.Context, dashboardID, itemID, propertyKey string) (*Response, error)
+	DeleteItemProperty(dashboardID, itemID, propertyKey string) (*Response, error)
+}
+
+// IssueLinkServiceAPI is the interface of the IssueLinkService, e.g. to replace it by a mock in tests.
+type IssueLinkServiceAPI interface {
+	CreateWithContext(ctx context.Context, issueLink *IssueLink) (*Response, error)
+	Create(issueLink *IssueLink) (*Response, error)
+	GetWithContext(ctx context.Context, linkID string) (*IssueLink, *Response, error)
+	Get(linkID string) (*IssueLink, *Response, error)
+	DeleteWithContext(ctx context.Context, linkID string) (*Response, error)
+	Delete(linkID string) (*Response, error)
+}
+
+// IssueLinkTypeServiceAPI is the interface of the IssueLinkTypeService, e.g. to replace it by a mock in tests.
+type IssueLinkTypeServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]IssueLinkType, *Response, error)
+	GetList() ([]IssueLinkType, *Response, error)
+	GetWithContext(ctx context.Context, linkTypeID string) (*IssueLinkType, *Response, error)
+	Get(linkTypeID string) (*IssueLinkType, *Response, error)
+	CreateWithContext(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Create(linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	UpdateWithContext(ctx context.Context, linkTypeID string, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Update(linkTypeID string, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	DeleteWithContext(ctx context.Context, linkTypeID string) (*Response, error)
+	Delete(linkTypeID string) (*Response, error)
+}
+
+// IssueTypeServiceAPI is the interface of the IssueTypeService, e.g. to replace it by a mock in tests.
+type IssueTypeServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]IssueType, *Response, error)
+	GetList() ([]IssueType, *Response, error)
+	GetWithContext(ctx context.Context, issueTypeID string) (*IssueType, *Response, error)
+	Get(issueTypeID string) (*IssueType, *Response, error)
+	CreateWithContext(ctx context.Context, options *IssueTypeOptions) (*IssueType, *Response, error)
+	Create(options *IssueTypeOptions) (*IssueType, *Response, error)
+	UpdateWithContext(ctx context.Context, issueTypeID string, options *IssueTypeOptions) (*IssueType, *Response, error)
+	Update(issueTypeID string, options *IssueTypeOptions) (*IssueType, *Response, error)
+	DeleteWithContext(ctx context.Context, issueTypeID, alternativeIssueTypeID string) (*Response, error)
+	Delete(issueTypeID, alternativeIssueTypeID string) (*Response, error)
+	GetSchemesWithContext(ctx context.Context, options *IssueTypeSchemeListOptions) ([]IssueTypeScheme, *Response, error)
+	GetSchemes(options *IssueTypeSchemeListOptions) ([]IssueTypeScheme, *Response, error)
+	CreateSchemeWithContext(ctx context.Context, options *IssueTypeSchemeOptions) (string, *Response, error)
+	CreateScheme(options *IssueTypeSchemeOptions) (string, *Response, error)
+	AssignSchemeToProjectWithContext(ctx context.Context, schemeID, projectID string) (*Response, error)
+	AssignSchemeToProject(schemeID, projectID string) (*Response, error)
+	AddIssueTypesToSchemeWithContext(ctx context.Context, schemeID string, issueTypeIDs []string) (*Response, error)
+	AddIssueTypesToScheme(schemeID string, issueTypeIDs []string) (*Response, error)
+}
+
+// WorkflowServiceAPI is the interface of the WorkflowService, e.g. to replace it by a mock in tests.
+type WorkflowServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Workflow, *Response, error)
+	GetList() ([]Workflow, *Response, error)
+	SearchWithContext(ctx context.Context, options *WorkflowSearchOptions) ([]Workflow, *Response, error)
+	Search(options *WorkflowSearchOptions) ([]Workflow, *Response, error)
+	GetWithContext(ctx context.Context, name string) (*Workflow, *Response, error)
+	Get(name string) (*Workflow, *Response, error)
+	GetSchemesWithContext(ctx context.Context, options *WorkflowSchemeListOptions) ([]WorkflowScheme, *Response, error)
+	GetSchemes(options *WorkflowSchemeListOptions) ([]WorkflowScheme, *Response, error)
+	GetSchemeWithContext(ctx context.Context, schemeID int) (*WorkflowScheme, *Response, error)
+	GetScheme(schemeID int) (*WorkflowScheme, *Response, error)
+	CreateSchemeWithContext(ctx context.Context, scheme *WorkflowScheme) (*WorkflowScheme, *Response, error)
+	CreateScheme(scheme *WorkflowScheme) (*WorkflowScheme, *Response, error)
+	UpdateSchemeWithContext(ctx context.Context, scheme *WorkflowScheme) (*WorkflowScheme, *Response, error)
+	UpdateScheme(scheme *WorkflowScheme) (*WorkflowScheme, *Response, error)
+	DeleteSchemeWithContext(ctx context.Context, schemeID int) (*Response, error)
+	DeleteScheme(schemeID int) (*Response, error)
+	GetSchemeProjectAssociationsWithContext(ctx context.Context, projectIDs []string) ([]WorkflowSchemeProjectAssociation, *Response, error)
+	GetSchemeProjectAssociations(projectIDs []string) ([]WorkflowSchemeProjectAssociation, *Response, error)
+	AssignSchemeToProjectWithContext(ctx context.Context, schemeID, projectID string) (*Response, error)
+	AssignSchemeToProject(schemeID, projectID string) (*Response, error)
+	GetTransitionPropertiesWithContext(ctx context.Context, transitionID int, options *WorkflowTransitionPropertyOptions) ([]EntityProperty, *Response, error)
+	GetTransitionProperties(transitionID int, options *WorkflowTransitionPropertyOptions) ([]EntityProperty, *Response, error)
+	AddTransitionPropertyWithContext(ctx context.Context, transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error)
+	AddTransitionProperty(transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error)
+	UpdateTransitionPropertyWithContext(ctx context.Context, transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error)
+	UpdateTransitionProperty(transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error)
+	DeleteTransitionPropertyWithContext(ctx context.Context, transitionID int, key string, options *WorkflowTransitionPropertyOptions) (*Response, error)
+	DeleteTransitionProperty(transitionID int, key string, options *WorkflowTransitionPropertyOptions) (*Response, error)
+}
+
+// PermissionSchemeServiceAPI is the interface of the PermissionSchemeService, e.g. to replace it by a mock in tests.
+type PermissionSchemeServiceAPI interface {
+	GetListWithContext(ctx context.Context, options *PermissionSchemeGetOptions) ([]PermissionScheme, *Response, error)
+	GetList(options *PermissionSchemeGetOptions) ([]PermissionScheme, *Response, error)
+	GetWithContext(ctx context.Context, schemeID int, options *PermissionSchemeGetOptions) (*PermissionScheme, *Response, error)
+	Get(schemeID int, options *PermissionSchemeGetOptions) (*PermissionScheme, *Response, error)
+	CreateWithContext(ctx context.Context, scheme *PermissionScheme) (*PermissionScheme, *Response, error)
+	Create(scheme *PermissionScheme) (*PermissionScheme, *Response, error)
+	UpdateWithContext(ctx context.Context, scheme *PermissionScheme) (*PermissionScheme, *Response, error)
+	Update(scheme *PermissionScheme) (*PermissionScheme, *Response, error)
+	DeleteWithContext(ctx context.Context, schemeID int) (*Response, error)
+	Delete(schemeID int) (*Response, error)
+	GetGrantsWithContext(ctx context.Context, schemeID int, options *PermissionSchemeGetOptions) ([]PermissionGrant, *Response, error)
+	GetGrants(schemeID int, options *PermissionSchemeGetOptions) ([]PermissionGrant, *Response, error)
+	GetGrantWithContext(ctx context.Context, schemeID, grantID int, options *PermissionSchemeGetOptions) (*PermissionGrant, *Response, error)
+	GetGrant(schemeID, grantID int, options *PermissionSchemeGetOptions) (*PermissionGrant, *Response, error)
+	AddGrantWithContext(ctx context.Context, schemeID int, grant *PermissionGrant) (*PermissionGrant, *Response, error)
+	AddGrant(schemeID int, grant *PermissionGrant) (*PermissionGrant, *Response, error)
+	DeleteGrantWithContext(ctx context.Context, schemeID, grantID int) (*Response, error)
+	DeleteGrant(schemeID, grantID int) (*Response, error)
+	AssignToProjectWithContext(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error)
+	AssignToProject(projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error)
+}
+
+// PermissionServiceAPI is the interface of the PermissionService, e.g. to replace it by a mock in tests.
+type PermissionServiceAPI interface {
+	GetMyPermissionsWithContext(ctx context.Context, options *MyPermissionsOptions) (map[string]Permission, *Response, error)
+	GetMyPermissions(options *MyPermissionsOptions) (map[string]Permission, *Response, error)
+	CheckWithContext(ctx context.Context, check *PermissionCheckRequest) (*PermissionCheckResult, *Response, error)
+	Check(check *PermissionCheckRequest) (*PermissionCheckResult, *Response, error)
+}
+
+// ProjectRoleServiceAPI is the interface of the ProjectRoleService, e.g. to replace it by a mock in tests.
+type ProjectRoleServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]Role, *Response, error)
+	GetList() ([]Role, *Response, error)
+	GetWithContext(ctx context.Context, roleID int) (*Role, *Response, error)
+	Get(roleID int) (*Role, *Response, error)
+	GetProjectRolesWithContext(ctx context.Context, projectIDOrKey string) (map[string]string, *Response, error)
+	GetProjectRoles(projectIDOrKey string) (map[string]string, *Response, error)
+	GetProjectRoleWithContext(ctx context.Context, projectIDOrKey string, roleID int) (*Role, *Response, error)
+	GetProjectRole(projectIDOrKey string, roleID int) (*Role, *Response, error)
+	AddUsersWithContext(ctx context.Context, projectIDOrKey string, roleID int, users []string) (*Role, *Response, error)
+	AddUsers(projectIDOrKey string, roleID int, users []string) (*Role, *Response, error)
+	AddGroupsWithContext(ctx context.Context, projectIDOrKey string, roleID int, groups []string) (*Role, *Response, error)
+	AddGroups(projectIDOrKey string, roleID int, groups []string) (*Role, *Response, error)
+	RemoveUserWithContext(ctx context.Context, projectIDOrKey string, roleID int, user string) (*Response, error)
+	RemoveUser(projectIDOrKey string, roleID int, user string) (*Response, error)
+	RemoveGroupWithContext(ctx context.Context, projectIDOrKey string, roleID int, group string) (*Response, error)
+	RemoveGroup(projectIDOrKey string, roleID int, group string) (*Response, error)
+}
+
+// NotificationSchemeServiceAPI is the interface of the NotificationSchemeService, e.g. to replace it by a mock in tests.
+type NotificationSchemeServiceAPI interface {
+	GetListWithContext(ctx context.Context, options *NotificationSchemeListOptions) ([]NotificationScheme, *Response, error)
+	GetList(options *NotificationSchemeListOptions) ([]NotificationScheme, *Response, error)
+	GetWithContext(ctx context.Context, schemeID int, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error)
+	Get(schemeID int, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error)
+	GetForProjectWithContext(ctx context.Context, projectIDOrKey string, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error)
+	GetForProject(projectIDOrKey string, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error)
+	CreateWithContext(ctx context.Context, options *NotificationSchemeOptions) (string, *Response, error)
+	Create(options *NotificationSchemeOptions) (string, *Response, error)
+	UpdateWithContext(ctx context.Context, schemeID int, options *NotificationSchemeOptions) (*Response, error)
+	Update(schemeID int, options *NotificationSchemeOptions) (*Response, error)
+}
+
+// IssueSecuritySchemeServiceAPI is the interface of the IssueSecuritySchemeService, e.g. to replace it by a mock in tests.
+type IssueSecuritySchemeServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]IssueSecurityScheme, *Response, error)
+	GetList() ([]IssueSecurityScheme, *Response, error)
+	GetWithContext(ctx context.Context, schemeID int) (*IssueSecurityScheme, *Response, error)
+	Get(schemeID int) (*IssueSecurityScheme, *Response, error)
+	GetForProjectWithContext(ctx context.Context, projectIDOrKey string) (*IssueSecurityScheme, *Response, error)
+	GetForProject(projectIDOrKey string) (*IssueSecurityScheme, *Response, error)
+	GetLevelWithContext(ctx context.Context, levelID string) (*SecurityLevel, *Response, error)
+	GetLevel(levelID string) (*SecurityLevel, *Response, error)
+	GetProjectLevelsWithContext(ctx context.Context, projectIDOrKey string) ([]SecurityLevel, *Response, error)
+	GetProjectLevels(projectIDOrKey string) ([]SecurityLevel, *Response, error)
+	GetLevelMembersWithContext(ctx context.Context, schemeID int, options *SecurityLevelMemberListOptions) ([]SecurityLevelMember, *Response, error)
+	GetLevelMembers(schemeID int, options *SecurityLevelMemberListOptions) ([]SecurityLevelMember, *Response, error)
+}
+
+// ScreenServiceAPI is the interface of the ScreenService, e.g. to replace it by a mock in tests.
+type ScreenServiceAPI interface {
+	GetListWithContext(ctx context.Context, options *ScreenListOptions) ([]Screen, *Response, error)
+	GetList(options *ScreenListOptions) ([]Screen, *Response, error)
+	GetAvailableFieldsWithContext(ctx context.Context, screenID int) ([]ScreenField, *Response, error)
+	GetAvailableFields(screenID int) ([]ScreenField, *Response, error)
+	AddFieldToDefaultScreenWithContext(ctx context.Context, fieldID string) (*Response, error)
+	AddFieldToDefaultScreen(fieldID string) (*Response, error)
+	GetTabsWithContext(ctx context.Context, screenID int) ([]ScreenTab, *Response, error)
+	GetTabs(screenID int) ([]ScreenTab, *Response, error)
+	AddTabWithContext(ctx context.Context, screenID int, name string) (*ScreenTab, *Response, error)
+	AddTab(screenID int, name string) (*ScreenTab, *Response, error)
+	RenameTabWithContext(ctx context.Context, screenID, tabID int, name string) (*ScreenTab, *Response, error)
+	RenameTab(screenID, tabID int, name string) (*ScreenTab, *Response, error)
+	DeleteTabWithContext(ctx context.Context, screenID, tabID int) (*Response, error)
+	DeleteTab(screenID, tabID int) (*Response, error)
+	GetTabFieldsWithContext(ctx context.Context, screenID, tabID int) ([]ScreenField, *Response, error)
+	GetTabFields(screenID, tabID int) ([]ScreenField, *Response, error)
+	AddTabFieldWithContext(ctx context.Context, screenID, tabID int, fieldID string) (*ScreenField, *Response, error)
+	AddTabField(screenID, tabID int, fieldID string) (*ScreenField, *Response, error)
+	RemoveTabFieldWithContext(ctx context.Context, screenID, tabID int, fieldID string) (*Response, error)
+	RemoveTabField(screenID, tabID int, fieldID string) (*Response, error)
+}
+
+// ServerInfoServiceAPI is the interface of the ServerInfoService, e.g. to replace it by a mock in tests.
+type ServerInfoServiceAPI interface {
+	GetWithContext(ctx context.Context, options *ServerInfoOptions) (*ServerInfo, *Response, error)
+	Get(options *ServerInfoOptions) (*ServerInfo, *Response, error)
+	IsCloudWithContext(ctx context.Context) (bool, *Response, error)
+	IsCloud() (bool, *Response, error)
+}
+
+// JQLServiceAPI is the interface of the JQLService, e.g. to replace it by a mock in tests.
+type JQLServiceAPI interface {
+	ParseWithContext(ctx context.Context, validation string, queries ...string) ([]ParsedJQLQuery, *Response, error)
+	Parse(validation string, queries ...string) ([]ParsedJQLQuery, *Response, error)
+	ValidateWithContext(ctx context.Context, query string) (*Response, error)
+	Validate(query string) (*Response, error)
+	GetAutocompleteDataWithContext(ctx context.Context) (*JQLAutocompleteData, *Response, error)
+	GetAutocompleteData() (*JQLAutocompleteData, *Response, error)
+	GetFieldSuggestionsWithContext(ctx context.Context, options *JQLSuggestionOptions) ([]JQLSuggestion, *Response, error)
+	GetFieldSuggestions(options *JQLSuggestionOptions) ([]JQLSuggestion, *Response, error)
+}
+
+// LabelServiceAPI is the interface of the LabelService, e.g. to replace it by a mock in tests.
+type LabelServiceAPI interface {
+	GetListWithContext(ctx context.Context, options *LabelListOptions) ([]string, *Response, error)
+	GetList(options *LabelListOptions) ([]string, *Response, error)
+}
+
+// BacklogServiceAPI is the interface of the BacklogService, e.g. to replace it by a mock in tests.
+type BacklogServiceAPI interface {
+	MoveIssuesWithContext(ctx context.Context, issueIDs []string) (*Response, error)
+	MoveIssues(issueIDs []string) (*Response, error)
+	MoveIssuesToBoardWithContext(ctx context.Context, boardID int, options *BacklogMoveOptions) (*Response, error)
+	MoveIssuesToBoard(boardID int, options *BacklogMoveOptions) (*Response, error)
+}
+
+// ServiceDeskServiceAPI is the interface of the ServiceDeskService, e.g. to replace it by a mock in tests.
+type ServiceDeskServiceAPI interface {
+	CreateCustomerRequestWithContext(ctx context.Context, options *CreateCustomerRequestOptions) (*CustomerRequest, *Response, error)
+	CreateCustomerRequest(options *CreateCustomerRequestOptions) (*CustomerRequest, *Response, error)
+	GetCustomerRequestWithContext(ctx context.Context, issueIDOrKey string, options *CustomerRequestGetOptions) (*CustomerRequest, *Response, error)
+	GetCustomerRequest(issueIDOrKey string, options *CustomerRequestGetOptions) (*CustomerRequest, *Response, error)
+	GetRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestParticipantsList, *Response, error)
+	GetRequestParticipants(issueIDOrKey string, options *ServiceDeskListOptions) (*RequestParticipantsList, *Response, error)
+	AddRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error)
+	AddRequestParticipants(issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error)
+	RemoveRequestParticipantsWithContext(ctx context.Context, issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error)
+	RemoveRequestParticipants(issueIDOrKey string, users ...string) (*RequestParticipantsList, *Response, error)
+	GetRequestSLAWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error)
+	GetRequestSLA(issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error)
+	GetApprovalsWithContext(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*ApprovalsList, *Response, error)
+	GetApprovals(issueIDOrKey string, options *ServiceDeskListOptions) (*ApprovalsList, *Response, error)
+	GetApprovalWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	GetApproval(issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	AnswerApprovalWithContext(ctx context.Context, issueIDOrKey, approvalID, decision string) (*Approval, *Response, error)
+	AnswerApproval(issueIDOrKey, approvalID, decision string) (*Approval, *Response, error)
+	ApproveWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	Approve(issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	DeclineWithContext(ctx context.Context, issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	Decline(issueIDOrKey, approvalID string) (*Approval, *Response, error)
+	GetOrganizationsWithContext(ctx context.Context, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error)
+	GetOrganizations(options *ServiceDeskListOptions) (*OrganizationsList, *Response, error)
+	GetOrganizationWithContext(ctx context.Context, organizationID string) (*Organization, *Response, error)
+	GetOrganization(organizationID string) (*Organization, *Response, error)
+	CreateOrganizationWithContext(ctx context.Context, name string) (*Organization, *Response, error)
+	CreateOrganization(name string) (*Organization, *Response, error)
+	DeleteOrganizationWithContext(ctx context.Context, organizationID string) (*Response, error)
+	DeleteOrganization(organizationID string) (*Response, error)
+	GetOrganizationUsersWithContext(ctx context.Context, organizationID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error)
+	GetOrganizationUsers(organizationID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error)
+	AddOrganizationUsersWithContext(ctx context.Context, organizationID string, users ...string) (*Response, error)
+	AddOrganizationUsers(organizationID string, users ...string) (*Response, error)
+	RemoveOrganizationUsersWithContext(ctx context.Context, organizationID string, users ...string) (*Response, error)
+	RemoveOrganizationUsers(organizationID string, users ...string) (*Response, error)
+	GetServiceDeskOrganizationsWithContext(ctx context.Context, serviceDeskID string, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error)
+	GetServiceDeskOrganizations(serviceDeskID string, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error)
+	AddOrganizationWithContext(ctx context.Context, serviceDeskID, organizationID string) (*Response, error)
+	AddOrganization(serviceDeskID, organizationID string) (*Response, error)
+	RemoveOrganizationWithContext(ctx context.Context, serviceDeskID, organizationID string) (*Response, error)
+	RemoveOrganization(serviceDeskID, organizationID string) (*Response, error)
+	GetCustomersWithContext(ctx context.Context, serviceDeskID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error)
+	GetCustomers(serviceDeskID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error)
+	AddCustomersWithContext(ctx context.Context, serviceDeskID string, users ...string) (*Response, error)
+	AddCustomers(serviceDeskID string, users ...string) (*Response, error)
+	RemoveCustomersWithContext(ctx context.Context, serviceDeskID string, users ...string) (*Response, error)
+	RemoveCustomers(serviceDeskID string, users ...string) (*Response, error)
+	CreateCustomerWithContext(ctx context.Context, email, displayName string) (*User, *Response, error)
+	CreateCustomer(email, displayName string) (*User, *Response, error)
+	GetQueuesWithContext(ctx context.Context, serviceDeskID string, options *QueueListOptions) (*QueuesList, *Response, error)
+	GetQueues(serviceDeskID string, options *QueueListOptions) (*QueuesList, *Response, error)
+	GetQueueWithContext(ctx context.Context, serviceDeskID, queueID string, options *QueueListOptions) (*Queue, *Response, error)
+	GetQueue(serviceDeskID, queueID string, options *QueueListOptions) (*Queue, *Response, error)
+	GetQueueIssuesWithContext(ctx context.Context, serviceDeskID, queueID string, options *ServiceDeskListOptions) (*QueueIssuesList, *Response, error)
+	GetQueueIssues(serviceDeskID, queueID string, options *ServiceDeskListOptions) (*QueueIssuesList, *Response, error)
+	GetQueueIssuesPagesWithContext(ctx context.Context, serviceDeskID, queueID string, options *ServiceDeskListOptions, f func(Issue) error) error
+	GetQueueIssuesPages(serviceDeskID, queueID string, options *ServiceDeskListOptions, f func(Issue) error) error
+	GetListWithContext(ctx context.Context, options *ServiceDeskListOptions) (*ServiceDesksList, *Response, error)
+	GetList(options *ServiceDeskListOptions) (*ServiceDesksList, *Response, error)
+	GetWithContext(ctx context.Context, serviceDeskID string) (*ServiceDesk, *Response, error)
+	Get(serviceDeskID string) (*ServiceDesk, *Response, error)
+	GetRequestTypesWithContext(ctx context.Context, serviceDeskID string, options *RequestTypeListOptions) (*RequestTypesList, *Response, error)
+	GetRequestTypes(serviceDeskID string, options *RequestTypeListOptions) (*RequestTypesList, *Response, error)
+	GetRequestTypeWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestType, *Response, error)
+	GetRequestType(serviceDeskID, requestTypeID string) (*RequestType, *Response, error)
+	GetRequestTypeFieldsWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error)
+	GetRequestTypeFields(serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error)
+}
+
+// AssetsServiceAPI is the interface of the AssetsService, e.g. to replace it by a mock in tests.
+type AssetsServiceAPI interface {
+	GetWorkspaceIDWithContext(ctx context.Context) (string, *Response, error)
+	GetWorkspaceID() (string, *Response, error)
+	GetObjectSchemasWithContext(ctx context.Context) ([]ObjectSchema, *Response, error)
+	GetObjectSchemas() ([]ObjectSchema, *Response, error)
+	GetObjectSchemaWithContext(ctx context.Context, schemaID AssetsID) (*ObjectSchema, *Response, error)
+	GetObjectSchema(schemaID AssetsID) (*ObjectSchema, *Response, error)
+	GetObjectTypesWithContext(ctx context.Context, schemaID AssetsID) ([]ObjectType, *Response, error)
+	GetObjectTypes(schemaID AssetsID) ([]ObjectType, *Response, error)
+	GetObjectTypeWithContext(ctx context.Context, objectTypeID AssetsID) (*ObjectType, *Response, error)
+	GetObjectType(objectTypeID AssetsID) (*ObjectType, *Response, error)
+	GetObjectTypeAttributesWithContext(ctx context.Context, objectTypeID AssetsID) ([]ObjectTypeAttribute, *Response, error)
+	GetObjectTypeAttributes(objectTypeID AssetsID) ([]ObjectTypeAttribute, *Response, error)
+	GetObjectWithContext(ctx context.Context, objectID AssetsID) (*AssetObject, *Response, error)
+	GetObject(objectID AssetsID) (*AssetObject, *Response, error)
+	CreateObjectWithContext(ctx context.Context, input *AssetObjectInput) (*AssetObject, *Response, error)
+	CreateObject(input *AssetObjectInput) (*AssetObject, *Response, error)
+	UpdateObjectWithContext(ctx context.Context, objectID AssetsID, input *AssetObjectInput) (*AssetObject, *Response, error)
+	UpdateObject(objectID AssetsID, input *AssetObjectInput) (*AssetObject, *Response, error)
+	DeleteObjectWithContext(ctx context.Context, objectID AssetsID) (*Response, error)
+	DeleteObject(objectID AssetsID) (*Response, error)
+	FindObjectsWithContext(ctx context.Context, aql string, options *AssetsSearchOptions) ([]AssetObject, *Response, error)
+	FindObjects(aql string, options *AssetsSearchOptions) ([]AssetObject, *Response, error)
+	UpsertObjectWithContext(ctx context.Context, aql string, input *AssetObjectInput) (*AssetObject, *Response, error)
+	UpsertObject(aql string, input *AssetObjectInput) (*AssetObject, *Response, error)
+}
+
+// AuditServiceAPI is the interface of the AuditService, e.g. to replace it by a mock in tests.
+type AuditServiceAPI interface {
+	GetRecordsWithContext(ctx context.Context, options *AuditRecordOptions) ([]AuditRecord, *Response, error)
+	GetRecords(options *AuditRecordOptions) ([]AuditRecord, *Response, error)
+	GetRecordsPagesWithContext(ctx context.Context, options *AuditRecordOptions, f func(AuditRecord) error) error
+	GetRecordsPages(options *AuditRecordOptions, f func(AuditRecord) error) error
+}
+
+// ApplicationRoleServiceAPI is the interface of the ApplicationRoleService, e.g. to replace it by a mock in tests.
+type ApplicationRoleServiceAPI interface {
+	GetListWithContext(ctx context.Context) ([]ApplicationRole, *Response, error)
+	GetList() ([]ApplicationRole, *Response, error)
+	GetWithContext(ctx context.Context, key string) (*ApplicationRole, *Response, error)
+	Get(key string) (*ApplicationRole, *Response, error)
+	UpdateWithContext(ctx context.Context, key string, update *ApplicationRoleUpdate) (*ApplicationRole, *Response, error)
+	Update(key string, update *ApplicationRoleUpdate) (*ApplicationRole, *Response, error)
+	RemoveGroupWithContext(ctx context.Context, key, group string) (*ApplicationRole, *Response, error)
+	RemoveGroup(key, group string) (*ApplicationRole, *Response, error)
+}
+
+// AvatarServiceAPI is the interface of the AvatarService, e.g. to replace it by a mock in tests.
+type AvatarServiceAPI interface {
+	GetSystemAvatarsWithContext(ctx context.Context, avatarType string) ([]Avatar, *Response, error)
+	GetSystemAvatars(avatarType string) ([]Avatar, *Response, error)
+	GetProjectAvatarsWithContext(ctx context.Context, projectKey string) (*Avatars, *Response, error)
+	GetProjectAvatars(projectKey string) (*Avatars, *Response, error)
+	LoadTemporaryProjectAvatarWithContext(ctx context.Context, projectKey, filename, contentType string, data []byte) (*AvatarCropping, *Response, error)
+	LoadTemporaryProjectAvatar(projectKey, filename, contentType string, data []byte) (*AvatarCropping, *Response, error)
+	CreateProjectAvatarWithContext(ctx context.Context, projectKey string, cropping *AvatarCropping) (*Avatar, *Response, error)
+	CreateProjectAvatar(projectKey string, cropping *AvatarCropping) (*Avatar, *Response, error)
+	SetProjectAvatarWithContext(ctx context.Context, projectKey, avatarID string) (*Response, error)
+	SetProjectAvatar(projectKey, avatarID string) (*Response, error)
+	DeleteProjectAvatarWithContext(ctx context.Context, projectKey, avatarID string) (*Response, error)
+	DeleteProjectAvatar(projectKey, avatarID string) (*Response, error)
+	UploadProjectAvatarWithContext(ctx context.Context, projectKey, filename, contentType string, data []byte) (*Avatar, *Response, error)
+	UploadProjectAvatar(projectKey, filename, contentType string, data []byte) (*Avatar, *Response, error)
+	GetUserAvatarsWithContext(ctx context.Context, username string) (*Avatars, *Response, error)
+	GetUserAvatars(username string) (*Avatars, *Response, error)
+	LoadTemporaryUserAvatarWithContext(ctx context.Context, username, filename, contentType string, data []byte) (*AvatarCropping, *Response, error)
+	LoadTemporaryUserAvatar(username, filename, contentType string, data []byte) (*AvatarCropping, *Response, error)
+	CreateUserAvatarWithContext(ctx context.Context, username string, cropping *AvatarCropping) (*Avatar, *Response, error)
+	CreateUserAvatar(username string, cropping *AvatarCropping) (*Avatar, *Response, error)
+	SetUserAvatarWithContext(ctx context.Context, username, avatarID string) (*Response, error)
+	SetUserAvatar(username, avatarID string) (*Response, error)
+	UploadUserAvatarWithContext(ctx context.Context, username, filename, contentType string, data []byte) (*Avatar, *Response, error)
+	UploadUserAvatar(username, filename, contentType string, data []byte) (*Avatar, *Response, error)
+}
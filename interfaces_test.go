@@ -0,0 +1,44 @@
+package jira
+
+import "testing"
+
+type mockIssueService struct {
+	IssueServiceAPI
+	requested []string
+}
+
+func (m *mockIssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	m.requested = append(m.requested, issueID)
+	return &Issue{Key: issueID}, nil, nil
+}
+
+// summaryOf stands for code of a consumer depending on the interfaces of the services
+func summaryOf(services *Services, issueID string) string {
+	issue, _, err := services.Issue.Get(issueID, nil)
+	if err != nil {
+		return ""
+	}
+	return issue.Key
+}
+
+func TestClient_Services(t *testing.T) {
+	c, err := NewClient(testJIRAInstanceURL)
+	if err != nil {
+		t.Fatalf("Got an error: %s", err)
+	}
+
+	services := c.Services()
+	if services.Issue != c.Issue || services.User != c.User || services.Avatar != c.Avatar {
+		t.Error("Expected the services of the client")
+	}
+}
+
+func TestServices_Mock(t *testing.T) {
+	mock := &mockIssueService{}
+	if got := summaryOf(&Services{Issue: mock}, "EX-1"); got != "EX-1" {
+		t.Errorf("Expected EX-1. Got %s", got)
+	}
+	if len(mock.requested) != 1 {
+		t.Errorf("Expected the mock to be called once. Got %d", len(mock.requested))
+	}
+}
@@ -2,13 +2,16 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +23,8 @@ import (
 const (
 	// AssigneeAutomatic represents the value of the "Assignee: Automatic" of JIRA
 	AssigneeAutomatic = "-1"
+	// AssigneeUnassigned represents an issue without assignee, which is sent to JIRA as null
+	AssigneeUnassigned = ""
 )
 
 // IssueService handles Issues for the JIRA instance / API.
@@ -58,9 +63,30 @@ type ChangelogHistory struct {
 	Items   []ChangelogItems `json:"items" structs:"items"`
 }
 
-// Changelog reflects the change log of an issue
+// Changelog reflects the change log of an issue.
+// When returned with an issue, it may only hold the first page of the histories, see Total.
 type Changelog struct {
-	Histories []ChangelogHistory `json:"histories,omitempty"`
+	StartAt    int                `json:"startAt,omitempty"`
+	MaxResults int                `json:"maxResults,omitempty"`
+	Total      int                `json:"total,omitempty"`
+	Histories  []ChangelogHistory `json:"histories,omitempty"`
+}
+
+// ChangelogListOptions specifies the optional parameters to the IssueService.GetChangelogPage method
+type ChangelogListOptions struct {
+	// StartAt is the index of the first history entry to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of history entries to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// changelogResult is a single page of the change log of an issue
+type changelogResult struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Histories  []ChangelogHistory `json:"values"`
 }
 
 // Attachment represents a JIRA attachment
@@ -94,48 +120,57 @@ type IssueFields struct {
 	//      * "workratio": -1,
 	//      * "lastViewed": null,
 	//      * "environment": null,
-	Expand                        string        `json:"expand,omitempty" structs:"expand,omitempty"`
-	Type                          IssueType     `json:"issuetype,omitempty" structs:"issuetype,omitempty"`
-	Project                       Project       `json:"project,omitempty" structs:"project,omitempty"`
-	Resolution                    *Resolution   `json:"resolution,omitempty" structs:"resolution,omitempty"`
-	Priority                      *Priority     `json:"priority,omitempty" structs:"priority,omitempty"`
-	Resolutiondate                Time          `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created                       Time          `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate                       Date          `json:"duedate,omitempty" structs:"duedate,omitempty"`
-	Watches                       *Watches      `json:"watches,omitempty" structs:"watches,omitempty"`
-	Assignee                      *User         `json:"assignee,omitempty" structs:"assignee,omitempty"`
-	Updated                       Time          `json:"updated,omitempty" structs:"updated,omitempty"`
-	Description                   string        `json:"description,omitempty" structs:"description,omitempty"`
-	Summary                       string        `json:"summary,omitempty" structs:"summary,omitempty"`
-	Creator                       *User         `json:"Creator,omitempty" structs:"Creator,omitempty"`
-	Reporter                      *User         `json:"reporter,omitempty" structs:"reporter,omitempty"`
-	Components                    []*Component  `json:"components,omitempty" structs:"components,omitempty"`
-	Status                        *Status       `json:"status,omitempty" structs:"status,omitempty"`
-	Progress                      *Progress     `json:"progress,omitempty" structs:"progress,omitempty"`
-	AggregateProgress             *Progress     `json:"aggregateprogress,omitempty" structs:"aggregateprogress,omitempty"`
-	TimeTracking                  *TimeTracking `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
-	TimeSpent                     int           `json:"timespent,omitempty" structs:"timespent,omitempty"`
-	TimeEstimate                  int           `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
-	TimeOriginalEstimate          int           `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
-	Worklog                       *Worklog      `json:"worklog,omitempty" structs:"worklog,omitempty"`
-	IssueLinks                    []*IssueLink  `json:"issuelinks,omitempty" structs:"issuelinks,omitempty"`
-	Comments                      *Comments     `json:"comment,omitempty" structs:"comment,omitempty"`
-	FixVersions                   []*FixVersion `json:"fixVersions,omitempty" structs:"fixVersions,omitempty"`
-	Labels                        []string      `json:"labels,omitempty" structs:"labels,omitempty"`
-	Subtasks                      []*Subtasks   `json:"subtasks,omitempty" structs:"subtasks,omitempty"`
-	Attachments                   []*Attachment `json:"attachment,omitempty" structs:"attachment,omitempty"`
-	Epic                          *Epic         `json:"epic,omitempty" structs:"epic,omitempty"`
-	Sprint                        *Sprint       `json:"sprint,omitempty" structs:"sprint,omitempty"`
-	Parent                        *Parent       `json:"parent,omitempty" structs:"parent,omitempty"`
-	AggregateTimeOriginalEstimate int           `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
-	AggregateTimeSpent            int           `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
-	AggregateTimeEstimate         int           `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+	Expand                        string         `json:"expand,omitempty" structs:"expand,omitempty"`
+	Type                          IssueType      `json:"issuetype,omitempty" structs:"issuetype,omitempty"`
+	Project                       Project        `json:"project,omitempty" structs:"project,omitempty"`
+	Resolution                    *Resolution    `json:"resolution,omitempty" structs:"resolution,omitempty"`
+	Priority                      *Priority      `json:"priority,omitempty" structs:"priority,omitempty"`
+	Resolutiondate                Time           `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created                       Time           `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate                       Date           `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Watches                       *Watches       `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                         *Votes         `json:"votes,omitempty" structs:"votes,omitempty"`
+	Security                      *SecurityLevel `json:"security,omitempty" structs:"security,omitempty"`
+	Assignee                      *User          `json:"assignee,omitempty" structs:"assignee,omitempty"`
+	Updated                       Time           `json:"updated,omitempty" structs:"updated,omitempty"`
+	Description                   string         `json:"description,omitempty" structs:"description,omitempty"`
+	DescriptionADF                *ADFNode       `json:"-" structs:"-"`
+	Summary                       string         `json:"summary,omitempty" structs:"summary,omitempty"`
+	Creator                       *User          `json:"Creator,omitempty" structs:"Creator,omitempty"`
+	Reporter                      *User          `json:"reporter,omitempty" structs:"reporter,omitempty"`
+	Components                    []*Component   `json:"components,omitempty" structs:"components,omitempty"`
+	Status                        *Status        `json:"status,omitempty" structs:"status,omitempty"`
+	Progress                      *Progress      `json:"progress,omitempty" structs:"progress,omitempty"`
+	AggregateProgress             *Progress      `json:"aggregateprogress,omitempty" structs:"aggregateprogress,omitempty"`
+	TimeTracking                  *TimeTracking  `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
+	TimeSpent                     int            `json:"timespent,omitempty" structs:"timespent,omitempty"`
+	TimeEstimate                  int            `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
+	TimeOriginalEstimate          int            `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
+	Worklog                       *Worklog       `json:"worklog,omitempty" structs:"worklog,omitempty"`
+	IssueLinks                    []*IssueLink   `json:"issuelinks,omitempty" structs:"issuelinks,omitempty"`
+	Comments                      *Comments      `json:"comment,omitempty" structs:"comment,omitempty"`
+	FixVersions                   []*FixVersion  `json:"fixVersions,omitempty" structs:"fixVersions,omitempty"`
+	Labels                        []string       `json:"labels,omitempty" structs:"labels,omitempty"`
+	Subtasks                      []*Subtasks    `json:"subtasks,omitempty" structs:"subtasks,omitempty"`
+	Attachments                   []*Attachment  `json:"attachment,omitempty" structs:"attachment,omitempty"`
+	Epic                          *Epic          `json:"epic,omitempty" structs:"epic,omitempty"`
+	Sprint                        *Sprint        `json:"sprint,omitempty" structs:"sprint,omitempty"`
+	Parent                        *Parent        `json:"parent,omitempty" structs:"parent,omitempty"`
+	AggregateTimeOriginalEstimate int            `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
+	AggregateTimeSpent            int            `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         int            `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
 	Unknowns                      tcontainer.MarshalMap
 }
 
 // MarshalJSON is a custom JSON marshal function for the IssueFields structs.
 // It handles JIRA custom fields and maps those from / to "Unknowns" key.
+// The description is sent as wiki markup, the V3 methods of IssueService send DescriptionADF instead.
 func (i *IssueFields) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.toMap())
+}
+
+// toMap returns the fields by their JSON keys, including the custom fields of "Unknowns"
+func (i *IssueFields) toMap() map[string]interface{} {
 	m := structs.Map(i)
 	unknowns, okay := m["Unknowns"]
 	if okay {
@@ -145,6 +180,44 @@ func (i *IssueFields) MarshalJSON() ([]byte, error) {
 		}
 		delete(m, "Unknowns")
 	}
+	return m
+}
+
+// adfIssue is an issue sent to the REST API v3, which expects the description as ADF document
+type adfIssue struct {
+	*Issue
+	Fields *adfIssueFields `json:"fields,omitempty"`
+}
+
+// adfIssueFields are the fields of an adfIssue
+type adfIssueFields IssueFields
+
+// newADFIssue returns the issue to send to the REST API v3
+func newADFIssue(issue *Issue) *adfIssue {
+	return &adfIssue{Issue: issue, Fields: (*adfIssueFields)(issue.Fields)}
+}
+
+// usesADF reports whether the REST API at apiBase expects rich text fields as ADF documents
+func usesADF(apiBase string) bool {
+	return apiBase == restAPIBase
+}
+
+// issueBody returns the issue to send to the REST API at apiBase
+func issueBody(apiBase string, issue *Issue) interface{} {
+	if usesADF(apiBase) {
+		return newADFIssue(issue)
+	}
+	return issue
+}
+
+// MarshalJSON sends DescriptionADF as the description, or a document of the plain text Description if it is not set.
+func (i *adfIssueFields) MarshalJSON() ([]byte, error) {
+	m := (*IssueFields)(i).toMap()
+	if i.DescriptionADF != nil {
+		m["description"] = i.DescriptionADF
+	} else if i.Description != "" {
+		m["description"] = NewADFDocumentFromText(i.Description)
+	}
 	return json.Marshal(m)
 }
 
@@ -156,6 +229,7 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 	// Details for this way: http://choly.ca/post/go-json-marshalling/
 	type Alias IssueFields
 	aux := &struct {
+		Description json.RawMessage `json:"description,omitempty"`
 		*Alias
 	}{
 		Alias: (*Alias)(i),
@@ -163,6 +237,9 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	if err := unmarshalRichText(aux.Description, &i.Description, &i.DescriptionADF); err != nil {
+		return err
+	}
 
 	totalMap := tcontainer.NewMarshalMap()
 	err := json.Unmarshal(data, &totalMap)
@@ -197,6 +274,25 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 
 }
 
+// unmarshalRichText decodes a rich text field, which is a wiki markup string in the REST API v2
+// and an ADF document in the REST API v3.
+// For ADF documents the plain text is stored in text as well.
+func unmarshalRichText(data json.RawMessage, text *string, doc **ADFNode) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	if data[0] != '{' {
+		return json.Unmarshal(data, text)
+	}
+	node := new(ADFNode)
+	if err := json.Unmarshal(data, node); err != nil {
+		return err
+	}
+	*doc = node
+	*text = node.PlainText()
+	return nil
+}
+
 // IssueRenderedFields represents rendered fields of a JIRA issue.
 // Not all IssueFields are rendered.
 type IssueRenderedFields struct {
@@ -234,10 +330,20 @@ type Watches struct {
 	Watchers   []*Watcher `json:"watchers,omitempty" structs:"watchers,omitempty"`
 }
 
+// Votes represents how many and which users voted for a JIRA issue.
+// Voters are only returned by IssueService.GetVotes and only to users allowed to see them.
+type Votes struct {
+	Self     string  `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int     `json:"votes,omitempty" structs:"votes,omitempty"`
+	HasVoted bool    `json:"hasVoted,omitempty" structs:"hasVoted,omitempty"`
+	Voters   []*User `json:"voters,omitempty" structs:"voters,omitempty"`
+}
+
 // Watcher represents a simplified user that "observes" the issue
 type Watcher struct {
 	Self        string `json:"self,omitempty" structs:"self,omitempty"`
 	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	AccountID   string `json:"accountId,omitempty" structs:"accountId,omitempty"`
 	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
 	Active      bool   `json:"active,omitempty" structs:"active,omitempty"`
 }
@@ -378,18 +484,87 @@ type Worklog struct {
 }
 
 // WorklogRecord represents one entry of a Worklog
+// In the REST API v3 the comment is an ADF document, which is stored in CommentADF next to its plain text in Comment.
 type WorklogRecord struct {
-	Self             string `json:"self,omitempty" structs:"self,omitempty"`
-	Author           *User  `json:"author,omitempty" structs:"author,omitempty"`
-	UpdateAuthor     *User  `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
-	Comment          string `json:"comment,omitempty" structs:"comment,omitempty"`
-	Created          *Time  `json:"created,omitempty" structs:"created,omitempty"`
-	Updated          *Time  `json:"updated,omitempty" structs:"updated,omitempty"`
-	Started          *Time  `json:"started,omitempty" structs:"started,omitempty"`
-	TimeSpent        string `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
-	TimeSpentSeconds int    `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
-	ID               string `json:"id,omitempty" structs:"id,omitempty"`
-	IssueID          string `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	Self             string   `json:"self,omitempty" structs:"self,omitempty"`
+	Author           *User    `json:"author,omitempty" structs:"author,omitempty"`
+	UpdateAuthor     *User    `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
+	Comment          string   `json:"comment,omitempty" structs:"comment,omitempty"`
+	CommentADF       *ADFNode `json:"-" structs:"-"`
+	Created          *Time    `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          *Time    `json:"updated,omitempty" structs:"updated,omitempty"`
+	Started          *Time    `json:"started,omitempty" structs:"started,omitempty"`
+	TimeSpent        string   `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
+	TimeSpentSeconds int      `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
+	ID               string   `json:"id,omitempty" structs:"id,omitempty"`
+	IssueID          string   `json:"issueId,omitempty" structs:"issueId,omitempty"`
+}
+
+// UnmarshalJSON decodes the comment of the worklog record as wiki markup or as ADF document.
+func (r *WorklogRecord) UnmarshalJSON(data []byte) error {
+	type Alias WorklogRecord
+	aux := &struct {
+		Comment json.RawMessage `json:"comment,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	return unmarshalRichText(aux.Comment, &r.Comment, &r.CommentADF)
+}
+
+// adfWorklogRecord is a worklog record sent to the REST API v3, which expects the comment as ADF document
+type adfWorklogRecord WorklogRecord
+
+// MarshalJSON sends CommentADF as the comment of the worklog record, or a document of the plain text Comment if it is not set.
+func (r adfWorklogRecord) MarshalJSON() ([]byte, error) {
+	type Alias WorklogRecord
+	aux := struct {
+		Comment *ADFNode `json:"comment,omitempty"`
+		Alias
+	}{
+		Comment: r.CommentADF,
+		Alias:   (Alias)(r),
+	}
+	if aux.Comment == nil && r.Comment != "" {
+		aux.Comment = NewADFDocumentFromText(r.Comment)
+	}
+	return json.Marshal(aux)
+}
+
+// worklogRecordBody returns the worklog record to send to the REST API at apiBase
+func worklogRecordBody(apiBase string, record *WorklogRecord) interface{} {
+	if usesADF(apiBase) {
+		return (*adfWorklogRecord)(record)
+	}
+	return record
+}
+
+// These constants are the ways JIRA can adjust the remaining estimate of an issue when work is logged
+const (
+	AdjustEstimateAuto   = "auto"
+	AdjustEstimateNew    = "new"
+	AdjustEstimateLeave  = "leave"
+	AdjustEstimateManual = "manual"
+)
+
+// WorklogOptions specifies the optional parameters to the IssueService worklog methods
+type WorklogOptions struct {
+	// NotifyUsers sends the email with notification that the issue was updated to users that watch it.
+	// A nil value keeps JIRA's default, which is to notify.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+	// AdjustEstimate defines how the remaining estimate is changed, e.g. AdjustEstimateAuto.
+	AdjustEstimate string `url:"adjustEstimate,omitempty"`
+	// NewEstimate is the new remaining estimate, required with AdjustEstimateNew, e.g. "2d".
+	NewEstimate string `url:"newEstimate,omitempty"`
+	// ReduceBy is the amount to reduce the remaining estimate by when adding a worklog with AdjustEstimateManual.
+	ReduceBy string `url:"reduceBy,omitempty"`
+	// IncreaseBy is the amount to increase the remaining estimate by when deleting a worklog with AdjustEstimateManual.
+	IncreaseBy string `url:"increaseBy,omitempty"`
+	// Expand is a comma-separated list of the parameters to expand, e.g. "properties".
+	Expand string `url:"expand,omitempty"`
 }
 
 // TimeTracking represents the timetracking fields of a JIRA issue.
@@ -430,24 +605,113 @@ type IssueLinkType struct {
 	Outward string `json:"outward" structs:"outward"`
 }
 
+// RemoteLink represents a link from a JIRA issue to an object in another system, e.g. a build or a pull request.
+// Links with a GlobalID are unique per issue, creating a link with an existing GlobalID updates that link.
+type RemoteLink struct {
+	ID           int                    `json:"id,omitempty" structs:"id,omitempty"`
+	Self         string                 `json:"self,omitempty" structs:"self,omitempty"`
+	GlobalID     string                 `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty" structs:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty" structs:"relationship,omitempty"`
+	Object       *RemoteLinkObject      `json:"object,omitempty" structs:"object,omitempty"`
+}
+
+// RemoteLinkApplication represents the application a remote link points to
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// RemoteLinkObject represents the object a remote link points to
+type RemoteLinkObject struct {
+	URL     string            `json:"url,omitempty" structs:"url,omitempty"`
+	Title   string            `json:"title,omitempty" structs:"title,omitempty"`
+	Summary string            `json:"summary,omitempty" structs:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty" structs:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty" structs:"status,omitempty"`
+}
+
+// RemoteLinkIcon represents an icon shown next to a remote link
+type RemoteLinkIcon struct {
+	URL16x16 string `json:"url16x16,omitempty" structs:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty" structs:"title,omitempty"`
+	Link     string `json:"link,omitempty" structs:"link,omitempty"`
+}
+
+// RemoteLinkStatus represents the status of the object a remote link points to.
+// Resolved objects are shown struck through.
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved,omitempty" structs:"resolved,omitempty"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty" structs:"icon,omitempty"`
+}
+
 // Comments represents a list of Comment.
 type Comments struct {
 	Comments []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
 }
 
 // Comment represents a comment by a person to an issue in JIRA.
+// In the REST API v3 the body is an ADF document, which is stored in BodyADF next to its plain text in Body.
 type Comment struct {
 	ID           string            `json:"id,omitempty" structs:"id,omitempty"`
 	Self         string            `json:"self,omitempty" structs:"self,omitempty"`
 	Name         string            `json:"name,omitempty" structs:"name,omitempty"`
 	Author       User              `json:"author,omitempty" structs:"author,omitempty"`
 	Body         string            `json:"body,omitempty" structs:"body,omitempty"`
+	BodyADF      *ADFNode          `json:"-" structs:"-"`
 	UpdateAuthor User              `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
 	Updated      string            `json:"updated,omitempty" structs:"updated,omitempty"`
 	Created      string            `json:"created,omitempty" structs:"created,omitempty"`
 	Visibility   CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
 }
 
+// adfComment is a comment sent to the REST API v3, which expects the body as ADF document
+type adfComment Comment
+
+// MarshalJSON sends BodyADF as the body of the comment, or a document of the plain text Body if it is not set.
+func (c adfComment) MarshalJSON() ([]byte, error) {
+	type Alias Comment
+	aux := struct {
+		Body *ADFNode `json:"body,omitempty"`
+		Alias
+	}{
+		Body:  c.adfBody(),
+		Alias: (Alias)(c),
+	}
+	return json.Marshal(aux)
+}
+
+// adfBody returns BodyADF, or a document of the plain text Body if it is not set
+func (c adfComment) adfBody() *ADFNode {
+	if c.BodyADF == nil && c.Body != "" {
+		return NewADFDocumentFromText(c.Body)
+	}
+	return c.BodyADF
+}
+
+// commentBody returns the comment to send to the REST API at apiBase
+func commentBody(apiBase string, comment *Comment) interface{} {
+	if usesADF(apiBase) {
+		return (*adfComment)(comment)
+	}
+	return comment
+}
+
+// UnmarshalJSON decodes the body of the comment as wiki markup or as ADF document.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type Alias Comment
+	aux := &struct {
+		Body json.RawMessage `json:"body,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	return unmarshalRichText(aux.Body, &c.Body, &c.BodyADF)
+}
+
 // FixVersion represents a software release in which an issue is fixed.
 type FixVersion struct {
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
@@ -467,6 +731,32 @@ type CommentVisibility struct {
 	Value string `json:"value,omitempty" structs:"value,omitempty"`
 }
 
+// These constants are the types a comment visibility can be restricted by
+const (
+	CommentVisibilityTypeRole  = "role"
+	CommentVisibilityTypeGroup = "group"
+)
+
+// CommentListOptions specifies the optional parameters to the IssueService.ListComments
+type CommentListOptions struct {
+	// StartAt is the index of the first comment to return. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of comments to return per page.
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the comments by their created date, e.g. "created" or "-created".
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand is a comma-separated list of the parameters to expand, e.g. "renderedBody".
+	Expand string `url:"expand,omitempty"`
+}
+
+// commentsResult is a single page of the comments of an issue
+type commentsResult struct {
+	StartAt    int        `json:"startAt" structs:"startAt"`
+	MaxResults int        `json:"maxResults" structs:"maxResults"`
+	Total      int        `json:"total" structs:"total"`
+	Comments   []*Comment `json:"comments" structs:"comments"`
+}
+
 // SearchOptions specifies the optional parameters to various List methods that
 // support pagination.
 // Pagination is used for the JIRA REST APIs to conserve server resources and limit
@@ -494,6 +784,15 @@ type searchResult struct {
 	Total      int     `json:"total" structs:"total"`
 }
 
+// searchIntoResult is the result of the SearchInto method,
+// which decodes the issues into the value passed by the caller
+type searchIntoResult struct {
+	Issues     interface{} `json:"issues" structs:"issues"`
+	StartAt    int         `json:"startAt" structs:"startAt"`
+	MaxResults int         `json:"maxResults" structs:"maxResults"`
+	Total      int         `json:"total" structs:"total"`
+}
+
 // GetQueryOptions specifies the optional parameters for the Get Issue methods
 type GetQueryOptions struct {
 	// Fields is the list of fields to return for the issue. By default, all fields are returned.
@@ -505,13 +804,203 @@ type GetQueryOptions struct {
 	FieldsByKeys  bool   `url:"fieldsByKeys,omitempty"`
 	UpdateHistory bool   `url:"updateHistory,omitempty"`
 	ProjectKeys   string `url:"projectKeys,omitempty"`
+	// IssueTypeIDs and IssueTypeNames restrict the create meta information to these issue types, separated by commas
+	IssueTypeIDs   string `url:"issuetypeIds,omitempty"`
+	IssueTypeNames string `url:"issuetypeNames,omitempty"`
+}
+
+// These constants are the operations of the update block of an issue edit
+const (
+	IssueUpdateOperationAdd    = "add"
+	IssueUpdateOperationRemove = "remove"
+	IssueUpdateOperationSet    = "set"
+	IssueUpdateOperationEdit   = "edit"
+)
+
+// IssueUpdate represents an edit of an issue, which is passed to IssueService.Edit.
+// Fields holds the new values of fields, which replace the old values.
+// Update holds the operations for each field, e.g. adding a label or a fix version, which change the old values.
+// The operations a field supports are listed by the edit meta data of the issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+type IssueUpdate struct {
+	Fields map[string]interface{}            `json:"fields,omitempty" structs:"fields,omitempty"`
+	Update map[string][]IssueUpdateOperation `json:"update,omitempty" structs:"update,omitempty"`
+}
+
+// IssueUpdateOperation is an operation of the update block of an issue edit.
+// It maps one of the IssueUpdateOperation constants to its value, e.g. {"add": "triaged"}.
+type IssueUpdateOperation map[string]interface{}
+
+// NewIssueUpdate returns an empty issue edit.
+func NewIssueUpdate() *IssueUpdate {
+	return &IssueUpdate{}
+}
+
+// SetField sets the new value of a field in the fields block.
+func (u *IssueUpdate) SetField(field string, value interface{}) *IssueUpdate {
+	if u.Fields == nil {
+		u.Fields = map[string]interface{}{}
+	}
+	u.Fields[field] = value
+	return u
+}
+
+// Operation adds the operation with the given value for a field to the update block.
+// operation is one of the IssueUpdateOperation constants.
+func (u *IssueUpdate) Operation(field, operation string, value interface{}) *IssueUpdate {
+	if u.Update == nil {
+		u.Update = map[string][]IssueUpdateOperation{}
+	}
+	u.Update[field] = append(u.Update[field], IssueUpdateOperation{operation: value})
+	return u
+}
+
+// Add adds a value to a field holding a list, e.g. a label or {"name": "1.0"} to the fixVersions.
+func (u *IssueUpdate) Add(field string, value interface{}) *IssueUpdate {
+	return u.Operation(field, IssueUpdateOperationAdd, value)
+}
+
+// Remove removes a value from a field holding a list.
+func (u *IssueUpdate) Remove(field string, value interface{}) *IssueUpdate {
+	return u.Operation(field, IssueUpdateOperationRemove, value)
+}
+
+// Set replaces the value of a field with the set operation.
+func (u *IssueUpdate) Set(field string, value interface{}) *IssueUpdate {
+	return u.Operation(field, IssueUpdateOperationSet, value)
+}
+
+// Edit changes a value of a field with the edit operation, e.g. the estimates of the timetracking field.
+func (u *IssueUpdate) Edit(field string, value interface{}) *IssueUpdate {
+	return u.Operation(field, IssueUpdateOperationEdit, value)
+}
+
+// AddComment adds a comment to the issue within the same edit.
+// Only the body and the visibility of the comment are sent.
+func (u *IssueUpdate) AddComment(comment *Comment) *IssueUpdate {
+	return u.Add("comment", issueUpdateComment{comment: comment})
+}
+
+// adf returns the edit to send to the REST API v3, which expects the added comments as ADF documents
+func (u *IssueUpdate) adf() *IssueUpdate {
+	comments := u.Update["comment"]
+	if len(comments) == 0 {
+		return u
+	}
+	update := &IssueUpdate{Fields: u.Fields, Update: make(map[string][]IssueUpdateOperation, len(u.Update))}
+	for field, operations := range u.Update {
+		update.Update[field] = operations
+	}
+	update.Update["comment"] = make([]IssueUpdateOperation, len(comments))
+	for i, operation := range comments {
+		adfOperation := IssueUpdateOperation{}
+		for name, value := range operation {
+			if comment, ok := value.(issueUpdateComment); ok {
+				comment.adf = true
+				value = comment
+			}
+			adfOperation[name] = value
+		}
+		update.Update["comment"][i] = adfOperation
+	}
+	return update
+}
+
+// issueUpdateComment is the value of an operation adding a comment within an edit.
+// The body is sent as wiki markup, or as ADF document if adf is set.
+type issueUpdateComment struct {
+	comment *Comment
+	adf     bool
+}
+
+// MarshalJSON sends the body and the visibility of the comment.
+func (c issueUpdateComment) MarshalJSON() ([]byte, error) {
+	value := map[string]interface{}{"body": c.comment.Body}
+	if c.adf {
+		value["body"] = (*adfComment)(c.comment).adfBody()
+	}
+	if c.comment.Visibility.Type != "" {
+		value["visibility"] = c.comment.Visibility
+	}
+	return json.Marshal(value)
+}
+
+// IssueNotification represents an email notification about an issue, which is sent by IssueService.Notify.
+// If Subject is empty, JIRA uses the key and the summary of the issue.
+type IssueNotification struct {
+	Subject  string                   `json:"subject,omitempty" structs:"subject,omitempty"`
+	TextBody string                   `json:"textBody,omitempty" structs:"textBody,omitempty"`
+	HTMLBody string                   `json:"htmlBody,omitempty" structs:"htmlBody,omitempty"`
+	To       *NotificationRecipients  `json:"to,omitempty" structs:"to,omitempty"`
+	Restrict *NotificationRestriction `json:"restrict,omitempty" structs:"restrict,omitempty"`
+}
+
+// NotificationRecipients are the recipients of an issue notification.
+// Users are identified by their AccountID on JIRA Cloud and their Name on JIRA Server.
+type NotificationRecipients struct {
+	Reporter bool               `json:"reporter,omitempty" structs:"reporter,omitempty"`
+	Assignee bool               `json:"assignee,omitempty" structs:"assignee,omitempty"`
+	Watchers bool               `json:"watchers,omitempty" structs:"watchers,omitempty"`
+	Voters   bool               `json:"voters,omitempty" structs:"voters,omitempty"`
+	Users    []NotificationUser `json:"users,omitempty" structs:"users,omitempty"`
+	Groups   []UserGroup        `json:"groups,omitempty" structs:"groups,omitempty"`
+}
+
+// NotificationUser identifies a user receiving an issue notification
+type NotificationUser struct {
+	AccountID string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	Name      string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// NotificationRestriction restricts the recipients of an issue notification
+// to the members of the Groups and the users with the Permissions, e.g. {Key: "BROWSE"}.
+type NotificationRestriction struct {
+	Groups      []UserGroup  `json:"groups,omitempty" structs:"groups,omitempty"`
+	Permissions []Permission `json:"permissions,omitempty" structs:"permissions,omitempty"`
+}
+
+// IssueRankOptions specifies the issues to rank and where to rank them.
+// Either RankBeforeIssue or RankAfterIssue must be set.
+type IssueRankOptions struct {
+	Issues            []string `json:"issues" structs:"issues"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty" structs:"rankBeforeIssue,omitempty"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty" structs:"rankAfterIssue,omitempty"`
+	RankCustomFieldID int      `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// IssueRankEntry is the result of ranking a single issue, returned if not all issues could be ranked.
+type IssueRankEntry struct {
+	IssueID  int      `json:"issueId" structs:"issueId"`
+	IssueKey string   `json:"issueKey" structs:"issueKey"`
+	Status   int      `json:"status" structs:"status"`
+	Errors   []string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// issueRankResult is the response of a rank request which partially failed
+type issueRankResult struct {
+	Entries []IssueRankEntry `json:"entries"`
+}
+
+// UpdateQueryOptions specifies the optional parameters to the Edit issue
+type UpdateQueryOptions struct {
+	// NotifyUsers sends the email with notification that the issue was updated to users that watch it.
+	// Admin or project admin permissions are required to disable the notification.
+	// A nil value keeps JIRA's default, which is to notify.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+	// OverrideScreenSecurity allows to update fields which are not on the edit screen.
+	// Only connect add-on users with admin permissions can use this flag.
+	OverrideScreenSecurity bool `url:"overrideScreenSecurity,omitempty"`
+	// OverrideEditableFlag updates the issue even if the issue is not editable due to being in a status with jira.issue.editable set to false.
+	// Only connect add-on users with admin permissions can use this flag.
+	OverrideEditableFlag bool `url:"overrideEditableFlag,omitempty"`
 }
 
 // CustomFields represents custom fields of JIRA
 // This can heavily differ between JIRA instances
 type CustomFields map[string]string
 
-// Get returns a full representation of the issue for the given issue key.
+// GetWithContext returns a full representation of the issue for the given issue key.
 // JIRA will attempt to identify the issue by the issueIdOrKey path parameter.
 // This can be an issue id, or an issue key.
 // If the issue cannot be found via an exact match, JIRA will also look for the issue in a case-insensitive way, or by looking to see if the issue was moved.
@@ -519,9 +1008,33 @@ type CustomFields map[string]string
 // The given options will be appended to the query string
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
+func (s *IssueService) GetWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.get(ctx, s.client.apiBase(ctx), issueID, options)
+}
+
+// Get wraps GetWithContext using the background context.
 func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	return s.GetWithContext(context.Background(), issueID, options)
+}
+
+// GetV3WithContext returns a full representation of the issue for the given issue key from the REST API v3.
+// The description and the comments of the issue are ADF documents, which are stored in
+// IssueFields.DescriptionADF and Comment.BodyADF next to their plain text.
+// The REST API v3 is only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-get
+func (s *IssueService) GetV3WithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.get(ctx, restAPIBase, issueID, options)
+}
+
+// GetV3 wraps GetV3WithContext using the background context.
+func (s *IssueService) GetV3(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.GetV3WithContext(context.Background(), issueID, options)
+}
+
+func (s *IssueService) get(ctx context.Context, apiBase, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s", apiBase, issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -544,13 +1057,13 @@ func (s *IssueService) Get(issueID string, options *GetQueryOptions) (*Issue, *R
 	return issue, resp, nil
 }
 
-// DownloadAttachment returns a Response of an attachment for a given attachmentID.
+// DownloadAttachmentWithContext returns a Response of an attachment for a given attachmentID.
 // The attachment is in the Response.Body of the response.
 // This is an io.ReadCloser.
 // The caller should close the resp.Body.
-func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error) {
+func (s *IssueService) DownloadAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("secure/attachment/%s/", attachmentID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -564,9 +1077,14 @@ func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error
 	return resp, nil
 }
 
-// PostAttachment uploads r (io.Reader) as an attachment to a given issueID
-func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
+// DownloadAttachment wraps DownloadAttachmentWithContext using the background context.
+func (s *IssueService) DownloadAttachment(attachmentID string) (*Response, error) {
+	return s.DownloadAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// PostAttachmentWithContext uploads r (io.Reader) as an attachment to a given issueID
+func (s *IssueService) PostAttachmentWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/attachments", s.client.apiBase(ctx), issueID)
 
 	b := new(bytes.Buffer)
 	writer := multipart.NewWriter(b)
@@ -584,7 +1102,7 @@ func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentNam
 	}
 	writer.Close()
 
-	req, err := s.client.NewMultiPartRequest("POST", apiEndpoint, b)
+	req, err := s.client.NewMultiPartRequestWithContext(ctx, "POST", apiEndpoint, b)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -602,14 +1120,88 @@ func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentNam
 	return attachment, resp, nil
 }
 
-// GetWorklogs gets all the worklogs for an issue.
+// PostAttachment wraps PostAttachmentWithContext using the background context.
+func (s *IssueService) PostAttachment(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+	return s.PostAttachmentWithContext(context.Background(), issueID, r, attachmentName)
+}
+
+// DownloadAttachmentToWithContext streams the content of the attachment for a given attachmentID into w.
+// The content is copied as it is read from the connection and is never held in memory as a whole.
+// The response body is closed before returning.
+func (s *IssueService) DownloadAttachmentToWithContext(ctx context.Context, attachmentID string, w io.Writer) (*Response, error) {
+	resp, err := s.DownloadAttachmentWithContext(ctx, attachmentID)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// DownloadAttachmentTo wraps DownloadAttachmentToWithContext using the background context.
+func (s *IssueService) DownloadAttachmentTo(attachmentID string, w io.Writer) (*Response, error) {
+	return s.DownloadAttachmentToWithContext(context.Background(), attachmentID, w)
+}
+
+// PostAttachmentStreamWithContext uploads r (io.Reader) as an attachment to a given issueID.
+// Unlike PostAttachment the multipart body is written while it is sent, so r is never held in memory as a whole.
+// As the body can only be read once, the request is not retried by a RetryPolicy.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/attachments-addAttachment
+func (s *IssueService) PostAttachmentStreamWithContext(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/attachments", s.client.apiBase(ctx), issueID)
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	req, err := s.client.NewRawRequestWithContext(ctx, "POST", apiEndpoint, pr)
+	if err != nil {
+		pr.Close()
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	go func() {
+		fw, err := writer.CreateFormFile("file", attachmentName)
+		if err == nil && r != nil {
+			_, err = io.Copy(fw, r)
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	// PostAttachment response returns a JSON array (as multiple attachments can be posted)
+	attachment := new([]Attachment)
+	resp, err := s.client.Do(req, attachment)
+	// Unblock the writer in case the request failed before the body was consumed
+	pr.Close()
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return attachment, resp, nil
+}
+
+// PostAttachmentStream wraps PostAttachmentStreamWithContext using the background context.
+func (s *IssueService) PostAttachmentStream(issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
+	return s.PostAttachmentStreamWithContext(context.Background(), issueID, r, attachmentName)
+}
+
+// GetWorklogsWithContext gets all the worklogs for an issue.
 // This method is especially important if you need to read all the worklogs, not just the first page.
 //
 // https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/worklog-getIssueWorklog
-func (s *IssueService) GetWorklogs(issueID string) (*Worklog, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
+func (s *IssueService) GetWorklogsWithContext(ctx context.Context, issueID string) (*Worklog, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/worklog", s.client.apiBase(ctx), issueID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -619,107 +1211,481 @@ func (s *IssueService) GetWorklogs(issueID string) (*Worklog, *Response, error)
 	return v, resp, err
 }
 
-// Create creates an issue or a sub-task from a JSON representation.
+// GetWorklogs wraps GetWorklogsWithContext using the background context.
+func (s *IssueService) GetWorklogs(issueID string) (*Worklog, *Response, error) {
+	return s.GetWorklogsWithContext(context.Background(), issueID)
+}
+
+// CreateWithContext creates an issue or a sub-task from a JSON representation.
 // Creating a sub-task is similar to creating a regular issue, with two important differences:
 // The issueType field must correspond to a sub-task issue type and you must provide a parent field in the issue create request containing the id or key of the parent issue.
+// On JIRA Cloud the description is sent as ADF document, see Client.Dialect and CreateV3WithContext.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-createIssues
+func (s *IssueService) CreateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	return s.create(ctx, apiBase, issueBody(apiBase, issue))
+}
+
+// Create wraps CreateWithContext using the background context.
 func (s *IssueService) Create(issue *Issue) (*Issue, *Response, error) {
-	apiEndpoint := "rest/api/2/issue"
-	req, err := s.client.NewRequest("POST", apiEndpoint, issue)
+	return s.CreateWithContext(context.Background(), issue)
+}
+
+// CreateV3WithContext creates an issue or a sub-task with the REST API v3.
+// Set IssueFields.DescriptionADF to create the issue with a rich text description,
+// the plain text Description is sent as ADF document otherwise.
+// The REST API v3 is only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-post
+func (s *IssueService) CreateV3WithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	return s.create(ctx, restAPIBase, newADFIssue(issue))
+}
+
+// CreateV3 wraps CreateV3WithContext using the background context.
+func (s *IssueService) CreateV3(issue *Issue) (*Issue, *Response, error) {
+	return s.CreateV3WithContext(context.Background(), issue)
+}
+
+func (s *IssueService) create(ctx context.Context, apiBase string, issue interface{}) (*Issue, *Response, error) {
+	apiEndpoint := apiBase + "/issue"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, issue)
 	if err != nil {
 		return nil, nil, err
 	}
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
 		// incase of error return the resp for further inspection
-		return nil, resp, err
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
 	}
 
 	responseIssue := new(Issue)
 	defer resp.Body.Close()
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, resp, fmt.Errorf("Could not read the returned data")
-	}
-	err = json.Unmarshal(data, responseIssue)
+	err = json.NewDecoder(resp.Body).Decode(responseIssue)
 	if err != nil {
 		return nil, resp, fmt.Errorf("Could not unmarshall the data into struct")
 	}
 	return responseIssue, resp, nil
 }
 
-// Update updates an issue from a JSON representation. The issue is found by key.
-//
-// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-editIssue
-func (s *IssueService) Update(issue *Issue) (*Issue, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", issue.Key)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, issue)
-	if err != nil {
-		return nil, nil, err
-	}
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
+// issueBulkCreateMaxIssues is the number of issues JIRA accepts in a single bulk create request.
+const issueBulkCreateMaxIssues = 50
 
-	// This is just to follow the rest of the API's convention of returning an issue.
-	// Returning the same pointer here is pointless, so we return a copy instead.
-	ret := *issue
-	return &ret, resp, nil
+// issueBulkCreateRequest is the payload of a bulk create request
+type issueBulkCreateRequest struct {
+	IssueUpdates []interface{} `json:"issueUpdates" structs:"issueUpdates"`
 }
 
-// UpdateIssue updates an issue from a JSON representation. The issue is found by key.
-//
-// https://docs.atlassian.com/jira/REST/7.4.0/#api/2/issue-editIssue
-func (s *IssueService) UpdateIssue(jiraID string, data map[string]interface{}) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", jiraID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, data)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		return resp, err
-	}
+// IssueBulkCreateResult is the result of creating issues in bulk.
+// Issues holds the issues which were created, Errors the issues which could not be created.
+type IssueBulkCreateResult struct {
+	Issues []Issue                `json:"issues,omitempty" structs:"issues,omitempty"`
+	Errors []IssueBulkCreateError `json:"errors,omitempty" structs:"errors,omitempty"`
+}
 
-	// This is just to follow the rest of the API's convention of returning an issue.
-	// Returning the same pointer here is pointless, so we return a copy instead.
-	return resp, nil
+// IssueBulkCreateError describes why a single issue of a bulk create request could not be created.
+// FailedElementNumber is the index of the issue in the slice passed to BulkCreate.
+type IssueBulkCreateError struct {
+	Status              int   `json:"status,omitempty" structs:"status,omitempty"`
+	ElementErrors       Error `json:"elementErrors,omitempty" structs:"elementErrors,omitempty"`
+	FailedElementNumber int   `json:"failedElementNumber" structs:"failedElementNumber"`
 }
 
-// AddComment adds a new comment to issueID.
+// BulkCreateWithContext creates up to 50 issues or sub-tasks in a single request, see CreateWithContext.
+// Issues which could not be created are reported in the Errors of the result.
+// If none of the issues could be created, the result is returned along with an error.
+// Use BulkCreateAllWithContext to create more than 50 issues.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
-func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
-	req, err := s.client.NewRequest("POST", apiEndpoint, comment)
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-createIssues
+func (s *IssueService) BulkCreateWithContext(ctx context.Context, issues []*Issue) (*IssueBulkCreateResult, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	payload := &issueBulkCreateRequest{IssueUpdates: make([]interface{}, len(issues))}
+	for i, issue := range issues {
+		payload.IssueUpdates[i] = issueBody(apiBase, issue)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiBase+"/issue/bulk", payload)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	responseComment := new(Comment)
-	resp, err := s.client.Do(req, responseComment)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
+	result := new(IssueBulkCreateResult)
+	resp, err := s.client.Do(req, result)
+	if err == nil {
+		return result, resp, nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	// JIRA answers 400 Bad Request with the errors of every issue when none of them could be created
+	defer resp.Body.Close()
+	data, readErr := ioutil.ReadAll(resp.Body)
+	if readErr != nil || json.Unmarshal(data, result) != nil || len(result.Errors) == 0 {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, &Error{HTTPError: err, HTTPStatusCode: resp.StatusCode}
+}
+
+// BulkCreate wraps BulkCreateWithContext using the background context.
+func (s *IssueService) BulkCreate(issues []*Issue) (*IssueBulkCreateResult, *Response, error) {
+	return s.BulkCreateWithContext(context.Background(), issues)
+}
+
+// BulkCreateAllWithContext creates any number of issues, sending them in bulk create requests of up to 50 issues.
+// The results of all requests are merged, the FailedElementNumber of the errors refers to the index in issues.
+// It stops at the first request which fails as a whole, returning the issues created until then along with the error.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-createIssues
+func (s *IssueService) BulkCreateAllWithContext(ctx context.Context, issues []*Issue) (*IssueBulkCreateResult, error) {
+	merged := &IssueBulkCreateResult{Issues: []Issue{}, Errors: []IssueBulkCreateError{}}
+	for offset := 0; offset < len(issues); offset += issueBulkCreateMaxIssues {
+		end := offset + issueBulkCreateMaxIssues
+		if end > len(issues) {
+			end = len(issues)
+		}
+
+		result, _, err := s.BulkCreateWithContext(ctx, issues[offset:end])
+		if result == nil {
+			return merged, err
+		}
+		merged.Issues = append(merged.Issues, result.Issues...)
+		for _, e := range result.Errors {
+			e.FailedElementNumber += offset
+			merged.Errors = append(merged.Errors, e)
+		}
+	}
+	return merged, nil
+}
+
+// BulkCreateAll wraps BulkCreateAllWithContext using the background context.
+func (s *IssueService) BulkCreateAll(issues []*Issue) (*IssueBulkCreateResult, error) {
+	return s.BulkCreateAllWithContext(context.Background(), issues)
+}
+
+// UpdateWithOptionsWithContext updates an issue from a JSON representation,
+// while also specifying query params. The issue is found by key.
+// On JIRA Cloud the description is sent as ADF document, see Client.Dialect and UpdateV3WithContext.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-editIssue
+func (s *IssueService) UpdateWithOptionsWithContext(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	return s.update(ctx, apiBase, issue, issueBody(apiBase, issue), opts)
+}
+
+// UpdateWithOptions wraps UpdateWithOptionsWithContext using the background context.
+func (s *IssueService) UpdateWithOptions(issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	return s.UpdateWithOptionsWithContext(context.Background(), issue, opts)
+}
+
+// UpdateV3WithContext updates an issue with the REST API v3. The issue is found by key.
+// Set IssueFields.DescriptionADF to update the description with rich text,
+// the plain text Description is sent as ADF document otherwise.
+// The REST API v3 is only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-put
+func (s *IssueService) UpdateV3WithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	return s.update(ctx, restAPIBase, issue, newADFIssue(issue), nil)
+}
+
+// UpdateV3 wraps UpdateV3WithContext using the background context.
+func (s *IssueService) UpdateV3(issue *Issue) (*Issue, *Response, error) {
+	return s.UpdateV3WithContext(context.Background(), issue)
+}
+
+func (s *IssueService) update(ctx context.Context, apiBase string, issue *Issue, body interface{}, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%v", apiBase, issue.Key)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	// This is just to follow the rest of the API's convention of returning an issue.
+	// Returning the same pointer here is pointless, so we return a copy instead.
+	ret := *issue
+	return &ret, resp, nil
+}
+
+// UpdateWithContext updates an issue from a JSON representation. The issue is found by key.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue-editIssue
+func (s *IssueService) UpdateWithContext(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	return s.UpdateWithOptionsWithContext(ctx, issue, nil)
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *IssueService) Update(issue *Issue) (*Issue, *Response, error) {
+	return s.UpdateWithContext(context.Background(), issue)
+}
+
+// UpdateIssueWithContext updates an issue from a JSON representation. The issue is found by key.
+//
+// https://docs.atlassian.com/jira/REST/7.4.0/#api/2/issue-editIssue
+func (s *IssueService) UpdateIssueWithContext(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%v", s.client.apiBase(ctx), jiraID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// This is just to follow the rest of the API's convention of returning an issue.
+	// Returning the same pointer here is pointless, so we return a copy instead.
+	return resp, nil
+}
+
+// UpdateIssue wraps UpdateIssueWithContext using the background context.
+func (s *IssueService) UpdateIssue(jiraID string, data map[string]interface{}) (*Response, error) {
+	return s.UpdateIssueWithContext(context.Background(), jiraID, data)
+}
+
+// EditWithContext edits an issue with the fields and update operations of the given IssueUpdate.
+// In contrast to UpdateWithContext, values can be added to and removed from fields holding lists,
+// and a comment can be added within the same request.
+// On JIRA Cloud the added comments are sent as ADF documents, see Client.Dialect.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) EditWithContext(ctx context.Context, issueID string, update *IssueUpdate, opts *UpdateQueryOptions) (*Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	apiEndpoint, err := addOptions(fmt.Sprintf("%s/issue/%s", apiBase, issueID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if usesADF(apiBase) {
+		update = update.adf()
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, update)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Edit wraps EditWithContext using the background context.
+func (s *IssueService) Edit(issueID string, update *IssueUpdate, opts *UpdateQueryOptions) (*Response, error) {
+	return s.EditWithContext(context.Background(), issueID, update, opts)
+}
+
+// SetSecurityLevelWithContext sets the issue security level with the given id on an issue.
+// To set the security level when creating an issue, use the Security of the IssueFields instead.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) SetSecurityLevelWithContext(ctx context.Context, issueID, levelID string) (*Response, error) {
+	data := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"security": &SecurityLevel{ID: levelID},
+		},
+	}
+	resp, err := s.UpdateIssueWithContext(ctx, issueID, data)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetSecurityLevel wraps SetSecurityLevelWithContext using the background context.
+func (s *IssueService) SetSecurityLevel(issueID, levelID string) (*Response, error) {
+	return s.SetSecurityLevelWithContext(context.Background(), issueID, levelID)
+}
+
+// AddLabelsWithContext adds the given labels to an issue.
+// The labels are added with the "add" operation of an update, so concurrent changes of other labels are not lost.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) AddLabelsWithContext(ctx context.Context, issueID string, labels ...string) (*Response, error) {
+	return s.updateLabels(ctx, issueID, IssueUpdateOperationAdd, labels)
+}
+
+// AddLabels wraps AddLabelsWithContext using the background context.
+func (s *IssueService) AddLabels(issueID string, labels ...string) (*Response, error) {
+	return s.AddLabelsWithContext(context.Background(), issueID, labels...)
+}
+
+// RemoveLabelsWithContext removes the given labels from an issue.
+// The labels are removed with the "remove" operation of an update, so concurrent changes of other labels are not lost.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-editIssue
+func (s *IssueService) RemoveLabelsWithContext(ctx context.Context, issueID string, labels ...string) (*Response, error) {
+	return s.updateLabels(ctx, issueID, IssueUpdateOperationRemove, labels)
+}
+
+// RemoveLabels wraps RemoveLabelsWithContext using the background context.
+func (s *IssueService) RemoveLabels(issueID string, labels ...string) (*Response, error) {
+	return s.RemoveLabelsWithContext(context.Background(), issueID, labels...)
+}
+
+func (s *IssueService) updateLabels(ctx context.Context, issueID, operation string, labels []string) (*Response, error) {
+	update := NewIssueUpdate()
+	for _, label := range labels {
+		update.Operation("labels", operation, label)
+	}
+	return s.EditWithContext(ctx, issueID, update, nil)
+}
+
+// AddCommentWithContext adds a new comment to issueID.
+// On JIRA Cloud the body is sent as ADF document, see Client.Dialect and AddCommentV3WithContext.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
+func (s *IssueService) AddCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	return s.addComment(ctx, apiBase, issueID, commentBody(apiBase, comment))
+}
+
+// AddComment wraps AddCommentWithContext using the background context.
+func (s *IssueService) AddComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.AddCommentWithContext(context.Background(), issueID, comment)
+}
+
+// AddCommentV3WithContext adds a new comment to issueID with the REST API v3.
+// Set Comment.BodyADF to add a rich text comment, the plain text Body is sent as ADF document otherwise.
+// The REST API v3 is only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-post
+func (s *IssueService) AddCommentV3WithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.addComment(ctx, restAPIBase, issueID, (*adfComment)(comment))
+}
+
+// AddCommentV3 wraps AddCommentV3WithContext using the background context.
+func (s *IssueService) AddCommentV3(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.AddCommentV3WithContext(context.Background(), issueID, comment)
+}
+
+func (s *IssueService) addComment(ctx context.Context, apiBase, issueID string, comment interface{}) (*Comment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/comment", apiBase, issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseComment := new(Comment)
+	resp, err := s.client.Do(req, responseComment)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
 		return nil, resp, jerr
 	}
 
 	return responseComment, resp, nil
 }
 
-// UpdateComment updates the body of a comment, identified by comment.ID, on the issueID.
+// GetCommentWithContext returns the comment identified by commentID on the issueID.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-getComment
+func (s *IssueService) GetCommentWithContext(ctx context.Context, issueID, commentID string) (*Comment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/comment/%s", s.client.apiBase(ctx), issueID, commentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(Comment)
+	resp, err := s.client.Do(req, comment)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return comment, resp, nil
+}
+
+// GetComment wraps GetCommentWithContext using the background context.
+func (s *IssueService) GetComment(issueID, commentID string) (*Comment, *Response, error) {
+	return s.GetCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// ListCommentsWithContext returns a page of the comments on the issueID.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-getComments
+func (s *IssueService) ListCommentsWithContext(ctx context.Context, issueID string, options *CommentListOptions) ([]*Comment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/comment", s.client.apiBase(ctx), issueID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(commentsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Comments, resp, nil
+}
+
+// ListComments wraps ListCommentsWithContext using the background context.
+func (s *IssueService) ListComments(issueID string, options *CommentListOptions) ([]*Comment, *Response, error) {
+	return s.ListCommentsWithContext(context.Background(), issueID, options)
+}
+
+// UpdateCommentWithContext updates the body of a comment, identified by comment.ID, on the issueID.
+// The visibility of the comment is changed as well if comment.Visibility is set.
+// On JIRA Cloud the body is sent as ADF document, see Client.Dialect and UpdateCommentV3WithContext.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-updateComment
+func (s *IssueService) UpdateCommentWithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	if usesADF(apiBase) {
+		return s.updateComment(ctx, apiBase, issueID, comment, (*adfComment)(comment).adfBody())
+	}
+	return s.updateComment(ctx, apiBase, issueID, comment, comment.Body)
+}
+
+// UpdateComment wraps UpdateCommentWithContext using the background context.
 func (s *IssueService) UpdateComment(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.UpdateCommentWithContext(context.Background(), issueID, comment)
+}
+
+// UpdateCommentV3WithContext updates the body of a comment, identified by comment.ID, on the issueID with the REST API v3.
+// Set Comment.BodyADF to update the comment with rich text, the plain text Body is sent as ADF document otherwise.
+// The visibility of the comment is changed as well if comment.Visibility is set.
+// The REST API v3 is only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-id-put
+func (s *IssueService) UpdateCommentV3WithContext(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.updateComment(ctx, restAPIBase, issueID, comment, (*adfComment)(comment).adfBody())
+}
+
+// UpdateCommentV3 wraps UpdateCommentV3WithContext using the background context.
+func (s *IssueService) UpdateCommentV3(issueID string, comment *Comment) (*Comment, *Response, error) {
+	return s.UpdateCommentV3WithContext(context.Background(), issueID, comment)
+}
+
+func (s *IssueService) updateComment(ctx context.Context, apiBase, issueID string, comment *Comment, body interface{}) (*Comment, *Response, error) {
 	reqBody := struct {
-		Body string `json:"body"`
+		Body       interface{}        `json:"body"`
+		Visibility *CommentVisibility `json:"visibility,omitempty"`
 	}{
-		Body: comment.Body,
+		Body: body,
+	}
+	if comment.Visibility.Type != "" {
+		reqBody.Visibility = &comment.Visibility
 	}
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, comment.ID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, reqBody)
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/comment/%s", apiBase, issueID, comment.ID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, reqBody)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -727,18 +1693,19 @@ func (s *IssueService) UpdateComment(issueID string, comment *Comment) (*Comment
 	responseComment := new(Comment)
 	resp, err := s.client.Do(req, responseComment)
 	if err != nil {
-		return nil, resp, err
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
 	}
 
 	return responseComment, resp, nil
 }
 
-// DeleteComment Deletes a comment from an issueID.
+// DeleteCommentWithContext Deletes a comment from an issueID.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-issue-issueIdOrKey-comment-id-delete
-func (s *IssueService) DeleteComment(issueID, commentID string) error {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, commentID)
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+func (s *IssueService) DeleteCommentWithContext(ctx context.Context, issueID, commentID string) error {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/comment/%s", s.client.apiBase(ctx), issueID, commentID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -752,12 +1719,68 @@ func (s *IssueService) DeleteComment(issueID, commentID string) error {
 	return nil
 }
 
-// AddWorklogRecord adds a new worklog record to issueID.
+// DeleteComment wraps DeleteCommentWithContext using the background context.
+func (s *IssueService) DeleteComment(issueID, commentID string) error {
+	return s.DeleteCommentWithContext(context.Background(), issueID, commentID)
+}
+
+// AddWorklogRecordWithOptionsWithContext adds a new worklog record to issueID,
+// adjusting the remaining estimate as described by opts.
+// On JIRA Cloud the comment is sent as ADF document, set CommentADF to send rich text.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-post
+func (s *IssueService) AddWorklogRecordWithOptionsWithContext(ctx context.Context, issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/worklog", apiBase, issueID)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", url, worklogRecordBody(apiBase, record))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseRecord := new(WorklogRecord)
+	resp, err := s.client.Do(req, responseRecord)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return responseRecord, resp, nil
+}
+
+// AddWorklogRecordWithOptions wraps AddWorklogRecordWithOptionsWithContext using the background context.
+func (s *IssueService) AddWorklogRecordWithOptions(issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error) {
+	return s.AddWorklogRecordWithOptionsWithContext(context.Background(), issueID, record, opts)
+}
+
+// AddWorklogRecordWithContext adds a new worklog record to issueID.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-post
+func (s *IssueService) AddWorklogRecordWithContext(ctx context.Context, issueID string, record *WorklogRecord) (*WorklogRecord, *Response, error) {
+	return s.AddWorklogRecordWithOptionsWithContext(ctx, issueID, record, nil)
+}
+
+// AddWorklogRecord wraps AddWorklogRecordWithContext using the background context.
 func (s *IssueService) AddWorklogRecord(issueID string, record *WorklogRecord) (*WorklogRecord, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
-	req, err := s.client.NewRequest("POST", apiEndpoint, record)
+	return s.AddWorklogRecordWithContext(context.Background(), issueID, record)
+}
+
+// UpdateWorklogRecordWithContext updates the worklog record, identified by record.ID, on the issueID.
+// The remaining estimate is adjusted as described by opts, which may be nil.
+// On JIRA Cloud the comment is sent as ADF document, set CommentADF to send rich text.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-id-put
+func (s *IssueService) UpdateWorklogRecordWithContext(ctx context.Context, issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/worklog/%s", apiBase, issueID, record.ID)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", url, worklogRecordBody(apiBase, record))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -772,12 +1795,54 @@ func (s *IssueService) AddWorklogRecord(issueID string, record *WorklogRecord) (
 	return responseRecord, resp, nil
 }
 
-// AddLink adds a link between two issues.
+// UpdateWorklogRecord wraps UpdateWorklogRecordWithContext using the background context.
+func (s *IssueService) UpdateWorklogRecord(issueID string, record *WorklogRecord, opts *WorklogOptions) (*WorklogRecord, *Response, error) {
+	return s.UpdateWorklogRecordWithContext(context.Background(), issueID, record, opts)
+}
+
+// DeleteWorklogRecordWithContext deletes the worklog record identified by worklogID from the issueID.
+// The remaining estimate is adjusted as described by opts, which may be nil.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-id-delete
+func (s *IssueService) DeleteWorklogRecordWithContext(ctx context.Context, issueID, worklogID string, opts *WorklogOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/worklog/%s", s.client.apiBase(ctx), issueID, worklogID)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// DeleteWorklogRecord wraps DeleteWorklogRecordWithContext using the background context.
+func (s *IssueService) DeleteWorklogRecord(issueID, worklogID string, opts *WorklogOptions) (*Response, error) {
+	return s.DeleteWorklogRecordWithContext(context.Background(), issueID, worklogID, opts)
+}
+
+// AddLinkWithContext adds a link between two issues.
+// On JIRA Cloud the comment of the link is sent as ADF document, see Client.Dialect.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
-func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink")
-	req, err := s.client.NewRequest("POST", apiEndpoint, issueLink)
+func (s *IssueService) AddLinkWithContext(ctx context.Context, issueLink *IssueLink) (*Response, error) {
+	apiBase := s.client.apiBase(ctx)
+	var body interface{} = issueLink
+	if usesADF(apiBase) && issueLink.Comment != nil {
+		body = &struct {
+			*IssueLink
+			Comment *adfComment `json:"comment,omitempty"`
+		}{issueLink, (*adfComment)(issueLink.Comment)}
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiBase+"/issueLink", body)
 	if err != nil {
 		return nil, err
 	}
@@ -790,19 +1855,16 @@ func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
 	return resp, err
 }
 
-// Search will search for tickets according to the jql
+// AddLink wraps AddLinkWithContext using the background context.
+func (s *IssueService) AddLink(issueLink *IssueLink) (*Response, error) {
+	return s.AddLinkWithContext(context.Background(), issueLink)
+}
+
+// SearchWithContext will search for tickets according to the jql
 //
 // JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
-func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
-	var u string
-	if options == nil {
-		u = fmt.Sprintf("rest/api/2/search?jql=%s", url.QueryEscape(jql))
-	} else {
-		u = fmt.Sprintf("rest/api/2/search?jql=%s&startAt=%d&maxResults=%d&expand=%s&fields=%s&validateQuery=%s", url.QueryEscape(jql),
-			options.StartAt, options.MaxResults, options.Expand, strings.Join(options.Fields, ","), options.ValidateQuery)
-	}
-
-	req, err := s.client.NewRequest("GET", u, nil)
+func (s *IssueService) SearchWithContext(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", searchEndpoint(s.client.apiBase(ctx), jql, options), nil)
 	if err != nil {
 		return []Issue{}, nil, err
 	}
@@ -815,123 +1877,952 @@ func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Res
 	return v.Issues, resp, err
 }
 
-// SearchPages will get issues from all pages in a search
-//
-// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
-func (s *IssueService) SearchPages(jql string, options *SearchOptions, f func(Issue) error) error {
+// Search wraps SearchWithContext using the background context.
+func (s *IssueService) Search(jql string, options *SearchOptions) ([]Issue, *Response, error) {
+	return s.SearchWithContext(context.Background(), jql, options)
+}
+
+func searchEndpoint(apiBase, jql string, options *SearchOptions) string {
 	if options == nil {
-		options = &SearchOptions{
-			StartAt:    0,
-			MaxResults: 50,
-		}
+		return fmt.Sprintf("%s/search?jql=%s", apiBase, url.QueryEscape(jql))
 	}
+	return fmt.Sprintf("%s/search?jql=%s&startAt=%d&maxResults=%d&expand=%s&fields=%s&validateQuery=%s", apiBase, url.QueryEscape(jql),
+		options.StartAt, options.MaxResults, options.Expand, strings.Join(options.Fields, ","), options.ValidateQuery)
+}
 
-	if options.MaxResults == 0 {
-		options.MaxResults = 50
+// SearchIntoWithContext searches for issues like SearchWithContext, but decodes them into v,
+// which has to be a pointer to a slice of a struct defined by the caller.
+// Its fields are decoded with the JSON tags of the struct, so custom fields can be typed by tagging them with their id:
+//
+//	var issues []struct {
+//		Key    string `json:"key"`
+//		Fields struct {
+//			Summary     string  `json:"summary"`
+//			StoryPoints float64 `json:"customfield_10002"`
+//		} `json:"fields"`
+//	}
+//	_, err := client.Issue.SearchInto("project = OPS", nil, &issues)
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) SearchIntoWithContext(ctx context.Context, jql string, options *SearchOptions, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", searchEndpoint(s.client.apiBase(ctx), jql, options), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	issues, resp, err := s.Search(jql, options)
+	result := &searchIntoResult{Issues: v}
+	resp, err := s.client.Do(req, result)
 	if err != nil {
-		return err
+		return resp, NewJiraError(resp, err)
 	}
+	return resp, nil
+}
 
-	for {
-		for _, issue := range issues {
-			err = f(issue)
-			if err != nil {
+// SearchInto wraps SearchIntoWithContext using the background context.
+func (s *IssueService) SearchInto(jql string, options *SearchOptions, v interface{}) (*Response, error) {
+	return s.SearchIntoWithContext(context.Background(), jql, options, v)
+}
+
+// GetIntoWithContext returns the issue for the given issue key like GetWithContext, but decodes it into v,
+// which has to be a pointer to a struct defined by the caller, see SearchIntoWithContext.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
+func (s *IssueService) GetIntoWithContext(ctx context.Context, issueID string, options *GetQueryOptions, v interface{}) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("%s/issue/%s", s.client.apiBase(ctx), issueID), options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetInto wraps GetIntoWithContext using the background context.
+func (s *IssueService) GetInto(issueID string, options *GetQueryOptions, v interface{}) (*Response, error) {
+	return s.GetIntoWithContext(context.Background(), issueID, options, v)
+}
+
+// WithFields sets the issue fields to return in search results
+func WithFields(fields ...string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "fields", value: url.QueryEscape(strings.Join(fields, ","))})
+		return s
+	}
+}
+
+// WithExpand sets the sections to expand in search results, e.g. "changelog"
+func WithExpand(expand string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "expand", value: url.QueryEscape(expand)})
+		return s
+	}
+}
+
+// WithValidateQuery sets how strictly the JQL query is validated.
+// Valid values: strict, warn, none.
+func WithValidateQuery(validateQuery string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "validateQuery", value: url.QueryEscape(validateQuery)})
+		return s
+	}
+}
+
+// FindWithContext searches for issues matching the jql.
+// Paging and the returned data can be tweaked with WithStartAt, WithMaxResults, WithFields, WithExpand and WithValidateQuery.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) FindWithContext(ctx context.Context, jql string, tweaks ...userSearchF) ([]Issue, *Response, error) {
+	search := userSearch{{name: "jql", value: url.QueryEscape(jql)}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+
+	apiEndpoint := s.client.apiBase(ctx) + "/search?" + search.queryString()
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(searchResult)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return v.Issues, resp, nil
+}
+
+// Find wraps FindWithContext using the background context.
+func (s *IssueService) Find(jql string, tweaks ...userSearchF) ([]Issue, *Response, error) {
+	return s.FindWithContext(context.Background(), jql, tweaks...)
+}
+
+// SearchStreamWithContext searches for a page of issues like SearchWithContext,
+// but calls f with every issue while the response is read instead of decoding the whole page at once.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// It returns the number of issues passed to f.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) SearchStreamWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) (int, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", searchEndpoint(s.client.apiBase(ctx), jql, options), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	count := 0
+	page := new(searchResult)
+	err = decodeSearchStream(json.NewDecoder(resp.Body), page, func(issue Issue) error {
+		count++
+		return f(issue)
+	})
+	resp.populatePageValues(page)
+	return count, resp, err
+}
+
+// SearchStream wraps SearchStreamWithContext using the background context.
+func (s *IssueService) SearchStream(jql string, options *SearchOptions, f func(Issue) error) (int, *Response, error) {
+	return s.SearchStreamWithContext(context.Background(), jql, options, f)
+}
+
+// decodeSearchStream decodes a page of search results from dec, calling f with each issue instead of storing it in page
+func decodeSearchStream(dec *json.Decoder, page *searchResult, f func(Issue) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case "issues":
+			if err := expectDelim(dec, '['); err != nil {
+				return err
+			}
+			for dec.More() {
+				var issue Issue
+				if err := dec.Decode(&issue); err != nil {
+					return err
+				}
+				if err := f(issue); err != nil {
+					return err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
 				return err
 			}
+		case "startAt":
+			err = dec.Decode(&page.StartAt)
+		case "maxResults":
+			err = dec.Decode(&page.MaxResults)
+		case "total":
+			err = dec.Decode(&page.Total)
+		default:
+			var skipped json.RawMessage
+			err = dec.Decode(&skipped)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+// expectDelim reads the next token from dec and returns an error if it is not delim
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("Expected %v in the response, got %v", delim, token)
+	}
+	return nil
+}
+
+// SearchPagesWithContext will get issues from all pages in a search.
+// The pages are streamed, so only a single issue is kept in memory at a time, see SearchStreamWithContext.
+//
+// JIRA API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
+func (s *IssueService) SearchPagesWithContext(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
+	opt := SearchOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	return fetchPages(opt.StartAt, opt.MaxResults, func(startAt, maxResults int) (*Response, int, error) {
+		opt.StartAt = startAt
+		opt.MaxResults = maxResults
+		count, resp, err := s.SearchStreamWithContext(ctx, jql, &opt, f)
+		return resp, count, err
+	})
+}
+
+// SearchPages wraps SearchPagesWithContext using the background context.
+func (s *IssueService) SearchPages(jql string, options *SearchOptions, f func(Issue) error) error {
+	return s.SearchPagesWithContext(context.Background(), jql, options, f)
+}
+
+// GetCustomFieldsWithContext returns a map of customfield_* keys with string values
+func (s *IssueService) GetCustomFieldsWithContext(ctx context.Context, issueID string) (CustomFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s", s.client.apiBase(ctx), issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(map[string]interface{})
+	resp, err := s.client.Do(req, issue)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	m := *issue
+	f := m["fields"]
+	cf := make(CustomFields)
+	if f == nil {
+		return cf, resp, nil
+	}
+
+	if rec, ok := f.(map[string]interface{}); ok {
+		for key, val := range rec {
+			if strings.Contains(key, "customfield") {
+				if valMap, ok := val.(map[string]interface{}); ok {
+					if v, ok := valMap["value"]; ok {
+						val = v
+					}
+				}
+				cf[key] = fmt.Sprint(val)
+			}
+		}
+	}
+	return cf, resp, nil
+}
+
+// GetCustomFields wraps GetCustomFieldsWithContext using the background context.
+func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
+	return s.GetCustomFieldsWithContext(context.Background(), issueID)
+}
+
+// GetTransitionsWithContext gets a list of the transitions possible for this issue by the current user,
+// along with fields that are required and their types.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getTransitions
+func (s *IssueService) GetTransitionsWithContext(ctx context.Context, id string) ([]Transition, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/transitions?expand=transitions.fields", s.client.apiBase(ctx), id)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(transitionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return result.Transitions, resp, err
+}
+
+// GetTransitions wraps GetTransitionsWithContext using the background context.
+func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error) {
+	return s.GetTransitionsWithContext(context.Background(), id)
+}
+
+// DoTransitionWithContext performs a transition on an issue.
+// When performing the transition you can update or set other issue fields.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithContext(ctx context.Context, ticketID, transitionID string) (*Response, error) {
+	payload := CreateTransitionPayload{
+		Transition: TransitionPayload{
+			ID: transitionID,
+		},
+	}
+	return s.DoTransitionWithPayloadWithContext(ctx, ticketID, payload)
+}
+
+// DoTransition wraps DoTransitionWithContext using the background context.
+func (s *IssueService) DoTransition(ticketID, transitionID string) (*Response, error) {
+	return s.DoTransitionWithContext(context.Background(), ticketID, transitionID)
+}
+
+// DoTransitionWithFieldsWithContext performs a transition on an issue and sets the given fields in the same request.
+// The keys of fields are field ids, e.g. "resolution" or "customfield_10010",
+// and only fields which are on the transition screen can be set.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithFieldsWithContext(ctx context.Context, ticketID, transitionID string, fields map[string]interface{}) (*Response, error) {
+	payload := struct {
+		Transition TransitionPayload      `json:"transition"`
+		Fields     map[string]interface{} `json:"fields,omitempty"`
+	}{
+		Transition: TransitionPayload{
+			ID: transitionID,
+		},
+		Fields: fields,
+	}
+	return s.DoTransitionWithPayloadWithContext(ctx, ticketID, payload)
+}
+
+// DoTransitionWithFields wraps DoTransitionWithFieldsWithContext using the background context.
+func (s *IssueService) DoTransitionWithFields(ticketID, transitionID string, fields map[string]interface{}) (*Response, error) {
+	return s.DoTransitionWithFieldsWithContext(context.Background(), ticketID, transitionID, fields)
+}
+
+// DoTransitionWithPayloadWithContext performs a transition on an issue using any payload.
+// When performing the transition you can update or set other issue fields.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) DoTransitionWithPayloadWithContext(ctx context.Context, ticketID, payload interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/transitions", s.client.apiBase(ctx), ticketID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// DoTransitionWithPayload wraps DoTransitionWithPayloadWithContext using the background context.
+func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*Response, error) {
+	return s.DoTransitionWithPayloadWithContext(context.Background(), ticketID, payload)
+}
+
+// InitIssueWithMetaAndFields returns Issue with with values from fieldsConfig properly set.
+//  * metaProject should contain metaInformation about the project where the issue should be created.
+//  * metaIssuetype is the MetaInformation about the Issuetype that needs to be created.
+//  * fieldsConfig is a key->value pair where key represents the name of the field as seen in the UI
+//		And value is the string value for that particular key.
+// Note: This method doesn't verify that the fieldsConfig is complete with mandatory fields. The fieldsConfig is
+//		 supposed to be already verified with MetaIssueType.CheckCompleteAndAvailable. It will however return
+//		 error if the key is not found.
+//		 All values will be packed into Unknowns. This is much convenient. If the struct fields needs to be
+//		 configured as well, marshalling and unmarshalling will set the proper fields.
+func InitIssueWithMetaAndFields(metaProject *MetaProject, metaIssuetype *MetaIssueType, fieldsConfig map[string]string) (*Issue, error) {
+	issue := new(Issue)
+	issueFields := new(IssueFields)
+	issueFields.Unknowns = tcontainer.NewMarshalMap()
+
+	// map the field names the User presented to jira's internal key
+	allFields, _ := metaIssuetype.GetAllFields()
+	for key, value := range fieldsConfig {
+		jiraKey, found := allFields[key]
+		if !found {
+			return nil, fmt.Errorf("key %s is not found in the list of fields", key)
+		}
+
+		valueType, err := metaIssuetype.Fields.String(jiraKey + "/schema/type")
+		if err != nil {
+			return nil, err
 		}
+		switch valueType {
+		case "array":
+			elemType, err := metaIssuetype.Fields.String(jiraKey + "/schema/items")
+			if err != nil {
+				return nil, err
+			}
+			switch elemType {
+			case "component":
+				issueFields.Unknowns[jiraKey] = []Component{{Name: value}}
+			case "option":
+				issueFields.Unknowns[jiraKey] = []map[string]string{{"value": value}}
+			default:
+				issueFields.Unknowns[jiraKey] = []string{value}
+			}
+		case "string":
+			issueFields.Unknowns[jiraKey] = value
+		case "date":
+			issueFields.Unknowns[jiraKey] = value
+		case "datetime":
+			issueFields.Unknowns[jiraKey] = value
+		case "any":
+			// Treat any as string
+			issueFields.Unknowns[jiraKey] = value
+		case "project":
+			issueFields.Unknowns[jiraKey] = Project{
+				Name: metaProject.Name,
+				ID:   metaProject.Id,
+			}
+		case "priority":
+			issueFields.Unknowns[jiraKey] = Priority{Name: value}
+		case "user":
+			issueFields.Unknowns[jiraKey] = User{
+				Name: value,
+			}
+		case "issuetype":
+			issueFields.Unknowns[jiraKey] = IssueType{
+				Name: value,
+			}
+		case "option":
+			issueFields.Unknowns[jiraKey] = Option{
+				Value: value,
+			}
+		default:
+			return nil, fmt.Errorf("Unknown issue type encountered: %s for %s", valueType, key)
+		}
+	}
+
+	issue.Fields = issueFields
+
+	return issue, nil
+}
+
+// DeleteWithContext will delete a specified issue.
+// Sub-tasks of the issue are deleted as well; without this the request fails if the issue has sub-tasks.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-deleteIssue
+func (s *IssueService) DeleteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s?deleteSubtasks=true", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *IssueService) Delete(issueID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), issueID)
+}
+
+// GetWatchesWithContext returns the watchers of the given issue as reported by JIRA,
+// without looking up the details of every watching user like GetWatchersWithContext does.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) GetWatchesWithContext(ctx context.Context, issueID string) (*Watches, *Response, error) {
+	watchesAPIEndpoint := fmt.Sprintf("%s/issue/%s/watchers", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", watchesAPIEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return watches, resp, nil
+}
+
+// GetWatches wraps GetWatchesWithContext using the background context.
+func (s *IssueService) GetWatches(issueID string) (*Watches, *Response, error) {
+	return s.GetWatchesWithContext(context.Background(), issueID)
+}
+
+// GetWatchersWithContext wil return all the users watching/observing the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
+func (s *IssueService) GetWatchersWithContext(ctx context.Context, issueID string) (*[]User, *Response, error) {
+	watchesAPIEndpoint := fmt.Sprintf("%s/issue/%s/watchers", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", watchesAPIEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, nil, NewJiraError(resp, err)
+	}
+
+	result := []User{}
+	user := new(User)
+	for _, watcher := range watches.Watchers {
+		if watcher.AccountID != "" {
+			user, resp, err = s.client.User.GetByAccountIDWithContext(ctx, watcher.AccountID)
+		} else {
+			user, resp, err = s.client.User.GetWithContext(ctx, watcher.Name)
+		}
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		result = append(result, *user)
+	}
+
+	return &result, resp, nil
+}
+
+// GetWatchers wraps GetWatchersWithContext using the background context.
+func (s *IssueService) GetWatchers(issueID string) (*[]User, *Response, error) {
+	return s.GetWatchersWithContext(context.Background(), issueID)
+}
+
+// AddWatcherWithContext adds watcher to the given issue
+// The user is given by name on JIRA Server and by account id on JIRA Cloud, see Client.Dialect.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-addWatcher
+func (s *IssueService) AddWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/watchers", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// AddWatcher wraps AddWatcherWithContext using the background context.
+func (s *IssueService) AddWatcher(issueID string, userName string) (*Response, error) {
+	return s.AddWatcherWithContext(context.Background(), issueID, userName)
+}
+
+// RemoveWatcherWithContext removes given user from given issue
+// The user is given by name on JIRA Server and by account id on JIRA Cloud, see Client.Dialect.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-removeWatcher
+func (s *IssueService) RemoveWatcherWithContext(ctx context.Context, issueID string, userName string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/watchers?%s=%s", s.client.apiBase(ctx), issueID,
+		s.client.dialect(ctx).userParam(), url.QueryEscape(userName))
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// RemoveWatcher wraps RemoveWatcherWithContext using the background context.
+func (s *IssueService) RemoveWatcher(issueID string, userName string) (*Response, error) {
+	return s.RemoveWatcherWithContext(context.Background(), issueID, userName)
+}
+
+// AddWatcherByAccountIDWithContext adds the user with the given account id as watcher to the given issue
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-issueIdOrKey-watchers-post
+func (s *IssueService) AddWatcherByAccountIDWithContext(ctx context.Context, issueID string, accountID string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/watchers", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// AddWatcherByAccountID wraps AddWatcherByAccountIDWithContext using the background context.
+func (s *IssueService) AddWatcherByAccountID(issueID string, accountID string) (*Response, error) {
+	return s.AddWatcherByAccountIDWithContext(context.Background(), issueID, accountID)
+}
+
+// RemoveWatcherByAccountIDWithContext removes the user with the given account id from the watchers of the given issue
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-issueIdOrKey-watchers-delete
+func (s *IssueService) RemoveWatcherByAccountIDWithContext(ctx context.Context, issueID string, accountID string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/watchers?accountId=%s", s.client.apiBase(ctx), issueID, url.QueryEscape(accountID))
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// RemoveWatcherByAccountID wraps RemoveWatcherByAccountIDWithContext using the background context.
+func (s *IssueService) RemoveWatcherByAccountID(issueID string, accountID string) (*Response, error) {
+	return s.RemoveWatcherByAccountIDWithContext(context.Background(), issueID, accountID)
+}
+
+// GetVotesWithContext returns the number of votes and the voters of the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-getVotes
+func (s *IssueService) GetVotesWithContext(ctx context.Context, issueID string) (*Votes, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/votes", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	votes := new(Votes)
+	resp, err := s.client.Do(req, votes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return votes, resp, nil
+}
+
+// GetVotes wraps GetVotesWithContext using the background context.
+func (s *IssueService) GetVotes(issueID string) (*Votes, *Response, error) {
+	return s.GetVotesWithContext(context.Background(), issueID)
+}
+
+// VoteWithContext casts a vote of the current user for the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-addVote
+func (s *IssueService) VoteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/votes", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// Vote wraps VoteWithContext using the background context.
+func (s *IssueService) Vote(issueID string) (*Response, error) {
+	return s.VoteWithContext(context.Background(), issueID)
+}
+
+// UnvoteWithContext removes the vote of the current user from the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-removeVote
+func (s *IssueService) UnvoteWithContext(ctx context.Context, issueID string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/votes", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// Unvote wraps UnvoteWithContext using the background context.
+func (s *IssueService) Unvote(issueID string) (*Response, error) {
+	return s.UnvoteWithContext(context.Background(), issueID)
+}
+
+// UpdateAssigneeWithContext updates the user assigned to work on the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
+func (s *IssueService) UpdateAssigneeWithContext(ctx context.Context, issueID string, assignee *User) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/assignee", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndPoint, assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
+	return resp, err
+}
+
+// UpdateAssignee wraps UpdateAssigneeWithContext using the background context.
+func (s *IssueService) UpdateAssignee(issueID string, assignee *User) (*Response, error) {
+	return s.UpdateAssigneeWithContext(context.Background(), issueID, assignee)
+}
+
+// RankWithContext moves issues before or after another issue, e.g. to reorder the backlog of a board.
+// The maximum number of issues that can be ranked in one operation is 50.
+// If some of the issues could not be ranked, the result of each issue is returned.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/issue-rankIssues
+func (s *IssueService) RankWithContext(ctx context.Context, options *IssueRankOptions) ([]IssueRankEntry, *Response, error) {
+	apiEndpoint := "rest/agile/1.0/issue/rank"
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, resp, nil
+	}
+
+	defer resp.Body.Close()
+	result := new(issueRankResult)
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, resp, err
+	}
+	return result.Entries, resp, nil
+}
+
+// Rank wraps RankWithContext using the background context.
+func (s *IssueService) Rank(options *IssueRankOptions) ([]IssueRankEntry, *Response, error) {
+	return s.RankWithContext(context.Background(), options)
+}
+
+// NotifyWithContext sends an email notification about the given issue to the recipients of the notification.
+// The notification is queued by JIRA, so there is no error for recipients which cannot be notified.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-notify
+func (s *IssueService) NotifyWithContext(ctx context.Context, issueID string, notification *IssueNotification) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/notify", s.client.apiBase(ctx), issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, notification)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Notify wraps NotifyWithContext using the background context.
+func (s *IssueService) Notify(issueID string, notification *IssueNotification) (*Response, error) {
+	return s.NotifyWithContext(context.Background(), issueID, notification)
+}
+
+// AssignWithContext assigns the given issue to a user, which is the account id on JIRA Cloud and the username on JIRA Server.
+// AssigneeAutomatic assigns the issue to the default assignee of the project, AssigneeUnassigned removes the assignee.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
+func (s *IssueService) AssignWithContext(ctx context.Context, issueID, user string) (*Response, error) {
+	key := "accountId"
+	if !s.client.dialect(ctx).UsesAccountID() {
+		key = "name"
+	}
+	var value interface{} = user
+	if user == AssigneeUnassigned {
+		value = nil
+	}
+
+	apiEndpoint := fmt.Sprintf("%s/issue/%s/assignee", s.client.apiBase(ctx), issueID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, map[string]interface{}{key: value})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Assign wraps AssignWithContext using the background context.
+func (s *IssueService) Assign(issueID, user string) (*Response, error) {
+	return s.AssignWithContext(context.Background(), issueID, user)
+}
+
+// AssignAutomaticallyWithContext assigns the given issue to the default assignee of its project.
+func (s *IssueService) AssignAutomaticallyWithContext(ctx context.Context, issueID string) (*Response, error) {
+	return s.AssignWithContext(ctx, issueID, AssigneeAutomatic)
+}
+
+// AssignAutomatically wraps AssignAutomaticallyWithContext using the background context.
+func (s *IssueService) AssignAutomatically(issueID string) (*Response, error) {
+	return s.AssignAutomaticallyWithContext(context.Background(), issueID)
+}
+
+// UnassignWithContext removes the assignee from the given issue.
+func (s *IssueService) UnassignWithContext(ctx context.Context, issueID string) (*Response, error) {
+	return s.AssignWithContext(ctx, issueID, AssigneeUnassigned)
+}
+
+// Unassign wraps UnassignWithContext using the background context.
+func (s *IssueService) Unassign(issueID string) (*Response, error) {
+	return s.UnassignWithContext(context.Background(), issueID)
+}
+
+// GetRemoteLinksWithContext returns the remote links of the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-getRemoteIssueLinks
+func (s *IssueService) GetRemoteLinksWithContext(ctx context.Context, issueID string) ([]RemoteLink, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remoteLinks := []RemoteLink{}
+	resp, err := s.client.Do(req, &remoteLinks)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return remoteLinks, resp, nil
+}
+
+// GetRemoteLinks wraps GetRemoteLinksWithContext using the background context.
+func (s *IssueService) GetRemoteLinks(issueID string) ([]RemoteLink, *Response, error) {
+	return s.GetRemoteLinksWithContext(context.Background(), issueID)
+}
+
+// GetRemoteLinkWithContext returns the remote link with the given id of the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-getRemoteIssueLinkById
+func (s *IssueService) GetRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*RemoteLink, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.apiBase(ctx), issueID, linkID)
+	return s.getRemoteLink(ctx, apiEndPoint)
+}
+
+// GetRemoteLink wraps GetRemoteLinkWithContext using the background context.
+func (s *IssueService) GetRemoteLink(issueID string, linkID int) (*RemoteLink, *Response, error) {
+	return s.GetRemoteLinkWithContext(context.Background(), issueID, linkID)
+}
 
-		if resp.StartAt+resp.MaxResults >= resp.Total {
-			return nil
-		}
+// GetRemoteLinkByGlobalIDWithContext returns the remote link with the given global id of the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-getRemoteIssueLinks
+func (s *IssueService) GetRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID string, globalID string) (*RemoteLink, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink?globalId=%s", s.client.apiBase(ctx), issueID, url.QueryEscape(globalID))
+	return s.getRemoteLink(ctx, apiEndPoint)
+}
 
-		options.StartAt += resp.MaxResults
-		issues, resp, err = s.Search(jql, options)
-		if err != nil {
-			return err
-		}
-	}
+// GetRemoteLinkByGlobalID wraps GetRemoteLinkByGlobalIDWithContext using the background context.
+func (s *IssueService) GetRemoteLinkByGlobalID(issueID string, globalID string) (*RemoteLink, *Response, error) {
+	return s.GetRemoteLinkByGlobalIDWithContext(context.Background(), issueID, globalID)
 }
 
-// GetCustomFields returns a map of customfield_* keys with string values
-func (s *IssueService) GetCustomFields(issueID string) (CustomFields, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+// getRemoteLink requests a single remote link.
+func (s *IssueService) getRemoteLink(ctx context.Context, apiEndPoint string) (*RemoteLink, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	issue := new(map[string]interface{})
-	resp, err := s.client.Do(req, issue)
+	remoteLink := new(RemoteLink)
+	resp, err := s.client.Do(req, remoteLink)
 	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	m := *issue
-	f := m["fields"]
-	cf := make(CustomFields)
-	if f == nil {
-		return cf, resp, nil
+		return nil, resp, NewJiraError(resp, err)
 	}
 
-	if rec, ok := f.(map[string]interface{}); ok {
-		for key, val := range rec {
-			if strings.Contains(key, "customfield") {
-				if valMap, ok := val.(map[string]interface{}); ok {
-					if v, ok := valMap["value"]; ok {
-						val = v
-					}
-				}
-				cf[key] = fmt.Sprint(val)
-			}
-		}
-	}
-	return cf, resp, nil
+	return remoteLink, resp, nil
 }
 
-// GetTransitions gets a list of the transitions possible for this issue by the current user,
-// along with fields that are required and their types.
+// AddRemoteLinkWithContext adds a remote link to the given issue.
+// If the issue already has a remote link with the GlobalID of remoteLink, that link is updated instead,
+// so links identified by a GlobalID can be set again without creating duplicates.
+// The returned RemoteLink only holds the ID and Self of the link.
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getTransitions
-func (s *IssueService) GetTransitions(id string) ([]Transition, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions?expand=transitions.fields", id)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-createOrUpdateRemoteIssueLink
+func (s *IssueService) AddRemoteLinkWithContext(ctx context.Context, issueID string, remoteLink *RemoteLink) (*RemoteLink, *Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink", s.client.apiBase(ctx), issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndPoint, remoteLink)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	result := new(transitionResult)
+	result := new(RemoteLink)
 	resp, err := s.client.Do(req, result)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
-	return result.Transitions, resp, err
+
+	return result, resp, nil
 }
 
-// DoTransition performs a transition on an issue.
-// When performing the transition you can update or set other issue fields.
-//
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
-func (s *IssueService) DoTransition(ticketID, transitionID string) (*Response, error) {
-	payload := CreateTransitionPayload{
-		Transition: TransitionPayload{
-			ID: transitionID,
-		},
-	}
-	return s.DoTransitionWithPayload(ticketID, payload)
+// AddRemoteLink wraps AddRemoteLinkWithContext using the background context.
+func (s *IssueService) AddRemoteLink(issueID string, remoteLink *RemoteLink) (*RemoteLink, *Response, error) {
+	return s.AddRemoteLinkWithContext(context.Background(), issueID, remoteLink)
 }
 
-// DoTransitionWithPayload performs a transition on an issue using any payload.
-// When performing the transition you can update or set other issue fields.
+// UpdateRemoteLinkWithContext updates the remote link with the given id of the given issue
 //
-// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
-func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions", ticketID)
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-updateRemoteIssueLink
+func (s *IssueService) UpdateRemoteLinkWithContext(ctx context.Context, issueID string, linkID int, remoteLink *RemoteLink) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.apiBase(ctx), issueID, linkID)
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, payload)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndPoint, remoteLink)
 	if err != nil {
 		return nil, err
 	}
@@ -944,196 +2835,384 @@ func (s *IssueService) DoTransitionWithPayload(ticketID, payload interface{}) (*
 	return resp, err
 }
 
-// InitIssueWithMetaAndFields returns Issue with with values from fieldsConfig properly set.
-//  * metaProject should contain metaInformation about the project where the issue should be created.
-//  * metaIssuetype is the MetaInformation about the Issuetype that needs to be created.
-//  * fieldsConfig is a key->value pair where key represents the name of the field as seen in the UI
-//		And value is the string value for that particular key.
-// Note: This method doesn't verify that the fieldsConfig is complete with mandatory fields. The fieldsConfig is
-//		 supposed to be already verified with MetaIssueType.CheckCompleteAndAvailable. It will however return
-//		 error if the key is not found.
-//		 All values will be packed into Unknowns. This is much convenient. If the struct fields needs to be
-//		 configured as well, marshalling and unmarshalling will set the proper fields.
-func InitIssueWithMetaAndFields(metaProject *MetaProject, metaIssuetype *MetaIssueType, fieldsConfig map[string]string) (*Issue, error) {
-	issue := new(Issue)
-	issueFields := new(IssueFields)
-	issueFields.Unknowns = tcontainer.NewMarshalMap()
-
-	// map the field names the User presented to jira's internal key
-	allFields, _ := metaIssuetype.GetAllFields()
-	for key, value := range fieldsConfig {
-		jiraKey, found := allFields[key]
-		if !found {
-			return nil, fmt.Errorf("key %s is not found in the list of fields", key)
-		}
-
-		valueType, err := metaIssuetype.Fields.String(jiraKey + "/schema/type")
-		if err != nil {
-			return nil, err
-		}
-		switch valueType {
-		case "array":
-			elemType, err := metaIssuetype.Fields.String(jiraKey + "/schema/items")
-			if err != nil {
-				return nil, err
-			}
-			switch elemType {
-			case "component":
-				issueFields.Unknowns[jiraKey] = []Component{{Name: value}}
-			case "option":
-				issueFields.Unknowns[jiraKey] = []map[string]string{{"value": value}}
-			default:
-				issueFields.Unknowns[jiraKey] = []string{value}
-			}
-		case "string":
-			issueFields.Unknowns[jiraKey] = value
-		case "date":
-			issueFields.Unknowns[jiraKey] = value
-		case "datetime":
-			issueFields.Unknowns[jiraKey] = value
-		case "any":
-			// Treat any as string
-			issueFields.Unknowns[jiraKey] = value
-		case "project":
-			issueFields.Unknowns[jiraKey] = Project{
-				Name: metaProject.Name,
-				ID:   metaProject.Id,
-			}
-		case "priority":
-			issueFields.Unknowns[jiraKey] = Priority{Name: value}
-		case "user":
-			issueFields.Unknowns[jiraKey] = User{
-				Name: value,
-			}
-		case "issuetype":
-			issueFields.Unknowns[jiraKey] = IssueType{
-				Name: value,
-			}
-		case "option":
-			issueFields.Unknowns[jiraKey] = Option{
-				Value: value,
-			}
-		default:
-			return nil, fmt.Errorf("Unknown issue type encountered: %s for %s", valueType, key)
-		}
-	}
+// UpdateRemoteLink wraps UpdateRemoteLinkWithContext using the background context.
+func (s *IssueService) UpdateRemoteLink(issueID string, linkID int, remoteLink *RemoteLink) (*Response, error) {
+	return s.UpdateRemoteLinkWithContext(context.Background(), issueID, linkID, remoteLink)
+}
 
-	issue.Fields = issueFields
+// DeleteRemoteLinkWithContext deletes the remote link with the given id from the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-deleteRemoteIssueLinkById
+func (s *IssueService) DeleteRemoteLinkWithContext(ctx context.Context, issueID string, linkID int) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.apiBase(ctx), issueID, linkID)
+	return s.deleteRemoteLink(ctx, apiEndPoint)
+}
 
-	return issue, nil
+// DeleteRemoteLink wraps DeleteRemoteLinkWithContext using the background context.
+func (s *IssueService) DeleteRemoteLink(issueID string, linkID int) (*Response, error) {
+	return s.DeleteRemoteLinkWithContext(context.Background(), issueID, linkID)
 }
 
-// Delete will delete a specified issue.
-func (s *IssueService) Delete(issueID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+// DeleteRemoteLinkByGlobalIDWithContext deletes the remote link with the given global id from the given issue
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-deleteRemoteIssueLinkByGlobalId
+func (s *IssueService) DeleteRemoteLinkByGlobalIDWithContext(ctx context.Context, issueID string, globalID string) (*Response, error) {
+	apiEndPoint := fmt.Sprintf("%s/issue/%s/remotelink?globalId=%s", s.client.apiBase(ctx), issueID, url.QueryEscape(globalID))
+	return s.deleteRemoteLink(ctx, apiEndPoint)
+}
 
-	// to enable deletion of subtasks; without this, the request will fail if the issue has subtasks
-	deletePayload := make(map[string]interface{})
-	deletePayload["deleteSubtasks"] = "true"
-	content, _ := json.Marshal(deletePayload)
+// DeleteRemoteLinkByGlobalID wraps DeleteRemoteLinkByGlobalIDWithContext using the background context.
+func (s *IssueService) DeleteRemoteLinkByGlobalID(issueID string, globalID string) (*Response, error) {
+	return s.DeleteRemoteLinkByGlobalIDWithContext(context.Background(), issueID, globalID)
+}
 
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, content)
+// deleteRemoteLink deletes a single remote link.
+func (s *IssueService) deleteRemoteLink(ctx context.Context, apiEndPoint string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndPoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+
 	return resp, err
 }
 
-// GetWatchers wil return all the users watching/observing the given issue
+// GetChangelogPageWithContext returns a page of the change log of the given issue, oldest entries first.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// The endpoint is only available on Jira Cloud.
 //
-// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssueWatchers
-func (s *IssueService) GetWatchers(issueID string) (*[]User, *Response, error) {
-	watchesAPIEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-issueIdOrKey-changelog-get
+func (s *IssueService) GetChangelogPageWithContext(ctx context.Context, issueID string, options *ChangelogListOptions) ([]ChangelogHistory, *Response, error) {
+	apiEndPoint, err := addOptions(fmt.Sprintf("%s/issue/%s/changelog", s.client.apiBase(ctx), issueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	req, err := s.client.NewRequest("GET", watchesAPIEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndPoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	watches := new(Watches)
-	resp, err := s.client.Do(req, watches)
+	result := new(changelogResult)
+	resp, err := s.client.Do(req, result)
 	if err != nil {
-		return nil, nil, NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
 
-	result := []User{}
-	user := new(User)
-	for _, watcher := range watches.Watchers {
-		user, resp, err = s.client.User.Get(watcher.Name)
+	return result.Histories, resp, nil
+}
+
+// GetChangelogPage wraps GetChangelogPageWithContext using the background context.
+func (s *IssueService) GetChangelogPage(issueID string, options *ChangelogListOptions) ([]ChangelogHistory, *Response, error) {
+	return s.GetChangelogPageWithContext(context.Background(), issueID, options)
+}
+
+// GetChangelogWithContext returns the complete change log of the given issue, following all result pages.
+// Where the paginated change log endpoint does not exist, e.g. on Jira Server,
+// the change log is read from the issue expanded with "changelog".
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-issueIdOrKey-changelog-get
+func (s *IssueService) GetChangelogWithContext(ctx context.Context, issueID string) ([]ChangelogHistory, error) {
+	histories := []ChangelogHistory{}
+	err := fetchPages(0, 0, func(startAt, maxResults int) (*Response, int, error) {
+		page, resp, err := s.GetChangelogPageWithContext(ctx, issueID, &ChangelogListOptions{StartAt: startAt, MaxResults: maxResults})
 		if err != nil {
-			return nil, resp, NewJiraError(resp, err)
+			return resp, 0, err
 		}
-		result = append(result, *user)
+		histories = append(histories, page...)
+		return resp, len(page), nil
+	})
+	if err == nil {
+		return histories, nil
+	}
+	if !IsNotFound(err) || len(histories) > 0 {
+		return nil, err
 	}
 
-	return &result, resp, nil
+	issue, _, err := s.GetWithContext(ctx, issueID, &GetQueryOptions{Expand: "changelog"})
+	if err != nil {
+		return nil, err
+	}
+	if issue.Changelog == nil {
+		return histories, nil
+	}
+	return issue.Changelog.Histories, nil
+}
+
+// GetChangelog wraps GetChangelogWithContext using the background context.
+func (s *IssueService) GetChangelog(issueID string) ([]ChangelogHistory, error) {
+	return s.GetChangelogWithContext(context.Background(), issueID)
+}
+
+func (c ChangelogHistory) CreatedTime() (time.Time, error) {
+	var t time.Time
+	// Ignore null
+	if string(c.Created) == "null" {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02T15:04:05.999-0700", c.Created)
+	return t, err
+}
+
+// IssuePickerOptions specifies the parameters to the IssueService.GetPickerSuggestions method
+type IssuePickerOptions struct {
+	// Query is the text the user typed, it is matched against the key and summary of the issues
+	Query string `url:"query,omitempty"`
+	// CurrentJQL is a JQL query the suggestions of the "Current Search" section are restricted to
+	CurrentJQL string `url:"currentJQL,omitempty"`
+	// CurrentIssueKey is the issue the suggestions are for, it is excluded from the suggestions
+	CurrentIssueKey string `url:"currentIssueKey,omitempty"`
+	// CurrentProjectID is the project the suggestions are restricted to
+	CurrentProjectID string `url:"currentProjectId,omitempty"`
+	// ShowSubTasks includes subtasks in the suggestions
+	ShowSubTasks bool `url:"showSubTasks,omitempty"`
+	// ShowSubTaskParent includes the parent of CurrentIssueKey in the suggestions, if it is a subtask
+	ShowSubTaskParent bool `url:"showSubTaskParent,omitempty"`
+}
+
+// IssuePickerResult holds the suggested issues of the issue picker, grouped into sections,
+// e.g. "History Search" and "Current Search", in the order JIRA ranks them.
+type IssuePickerResult struct {
+	Sections []IssuePickerSection `json:"sections,omitempty" structs:"sections,omitempty"`
+}
+
+// IssuePickerSection represents a section of suggested issues of the issue picker
+type IssuePickerSection struct {
+	ID     string                  `json:"id,omitempty" structs:"id,omitempty"`
+	Label  string                  `json:"label,omitempty" structs:"label,omitempty"`
+	Sub    string                  `json:"sub,omitempty" structs:"sub,omitempty"`
+	Msg    string                  `json:"msg,omitempty" structs:"msg,omitempty"`
+	Issues []IssuePickerSuggestion `json:"issues,omitempty" structs:"issues,omitempty"`
+}
+
+// IssuePickerSuggestion represents a suggested issue of the issue picker.
+// KeyHTML and SummaryHTML highlight the matched text with HTML tags, SummaryText is the plain summary.
+type IssuePickerSuggestion struct {
+	ID          int    `json:"id,omitempty" structs:"id,omitempty"`
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	KeyHTML     string `json:"keyHtml,omitempty" structs:"keyHtml,omitempty"`
+	Img         string `json:"img,omitempty" structs:"img,omitempty"`
+	Summary     string `json:"summary,omitempty" structs:"summary,omitempty"`
+	SummaryText string `json:"summaryText,omitempty" structs:"summaryText,omitempty"`
+}
+
+// Suggestions returns the suggested issues of all sections in their order, without duplicates.
+func (r *IssuePickerResult) Suggestions() []IssuePickerSuggestion {
+	var suggestions []IssuePickerSuggestion
+	seen := map[string]bool{}
+	for _, section := range r.Sections {
+		for _, issue := range section.Issues {
+			if seen[issue.Key] {
+				continue
+			}
+			seen[issue.Key] = true
+			suggestions = append(suggestions, issue)
+		}
+	}
+	return suggestions
 }
 
-// AddWatcher adds watcher to the given issue
+// GetPickerSuggestionsWithContext returns the issues JIRA suggests for the text the user typed, e.g. for autocompletion.
 //
-// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-addWatcher
-func (s *IssueService) AddWatcher(issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getIssuePickerResource
+func (s *IssueService) GetPickerSuggestionsWithContext(ctx context.Context, options *IssuePickerOptions) (*IssuePickerResult, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.apiBase(ctx)+"/issue/picker", options)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	req, err := s.client.NewRequest("POST", apiEndPoint, userName)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	result := new(IssuePickerResult)
+	resp, err := s.client.Do(req, result)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, resp, NewJiraError(resp, err)
 	}
+	return result, resp, nil
+}
 
-	return resp, err
+// GetPickerSuggestions wraps GetPickerSuggestionsWithContext using the background context.
+func (s *IssueService) GetPickerSuggestions(options *IssuePickerOptions) (*IssuePickerResult, *Response, error) {
+	return s.GetPickerSuggestionsWithContext(context.Background(), options)
 }
 
-// RemoveWatcher removes given user from given issue
+// SearchAllParallelWithContext returns all issues matching the jql in the order of the search.
+// After the first page, the remaining pages are fetched concurrently by up to workers goroutines, see FetchAllParallel.
 //
-// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-removeWatcher
-func (s *IssueService) RemoveWatcher(issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
-
-	req, err := s.client.NewRequest("DELETE", apiEndPoint, userName)
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) SearchAllParallelWithContext(ctx context.Context, jql string, options *SearchOptions, workers int) ([]Issue, error) {
+	opt := SearchOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	var issues []Issue
+	err := FetchAllParallel(ctx, opt.StartAt, opt.MaxResults, workers, func(ctx context.Context, startAt, maxResults int) (interface{}, int, *Response, error) {
+		pageOptions := opt
+		pageOptions.StartAt = startAt
+		pageOptions.MaxResults = maxResults
+		page, resp, err := s.SearchWithContext(ctx, jql, &pageOptions)
+		return page, len(page), resp, err
+	}, func(page interface{}) error {
+		issues = append(issues, page.([]Issue)...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return issues, nil
+}
 
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		err = NewJiraError(resp, err)
+// SearchAllParallel wraps SearchAllParallelWithContext using the background context.
+func (s *IssueService) SearchAllParallel(jql string, options *SearchOptions, workers int) ([]Issue, error) {
+	return s.SearchAllParallelWithContext(context.Background(), jql, options, workers)
+}
+
+// issueGetManyChunkSize is the number of keys looked up by a single search of GetMany
+const issueGetManyChunkSize = 100
+
+// GetManyWithContext returns the issues with the given keys, keyed by their key, with the given fields or all fields if none are given.
+// The keys are looked up with searches for "key in (...)", each for up to 100 keys.
+// The keys of issues which do not exist or cannot be seen by the user are returned as missing keys.
+// An issue moved to another project is returned under its new key, its old key is reported as missing.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) GetManyWithContext(ctx context.Context, keys []string, fields ...string) (map[string]*Issue, []string, error) {
+	var unique []string
+	seen := map[string]bool{}
+	for _, key := range keys {
+		normalized := strings.ToUpper(strings.TrimSpace(key))
+		if normalized == "" || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		unique = append(unique, normalized)
 	}
 
-	return resp, err
+	issues := map[string]*Issue{}
+	for start := 0; start < len(unique); start += issueGetManyChunkSize {
+		end := start + issueGetManyChunkSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range unique[start:end] {
+			quoted = append(quoted, strconv.Quote(key))
+		}
+		// with the validation "warn", keys of missing issues are reported as warnings instead of failing the search
+		options := &SearchOptions{MaxResults: end - start, Fields: fields, ValidateQuery: "warn"}
+		err := s.SearchPagesWithContext(ctx, "key in ("+strings.Join(quoted, ",")+")", options, func(issue Issue) error {
+			issues[issue.Key] = &issue
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var missing []string
+	for _, key := range unique {
+		if _, ok := issues[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return issues, missing, nil
+}
+
+// GetMany wraps GetManyWithContext using the background context.
+func (s *IssueService) GetMany(keys []string, fields ...string) (map[string]*Issue, []string, error) {
+	return s.GetManyWithContext(context.Background(), keys, fields...)
 }
 
-// UpdateAssignee updates the user assigned to work on the given issue
+// IssueSyncOptions specifies the issues to synchronize with IssueService.Sync
+type IssueSyncOptions struct {
+	// JQL restricts the synchronized issues, e.g. "project = EX". All issues are synchronized if it is empty.
+	JQL string
+	// Since is the time of the last synchronization, e.g. the SyncedAt of its result.
+	// All issues matching JQL are returned as created if it is zero.
+	Since time.Time
+	// Location is the time zone of the JIRA user, which JQL dates are interpreted in. Defaults to UTC.
+	Location *time.Location
+	// KnownKeys are the keys of the issues synchronized so far. Those which no longer exist are returned as deleted.
+	KnownKeys []string
+	// Fields are the fields to return, all fields are returned if it is empty.
+	Fields []string
+}
+
+// IssueSyncResult holds the changes of the issues since the last synchronization.
+// SyncedAt is the time the synchronization started, to pass as Since to the next one.
+type IssueSyncResult struct {
+	Created  []Issue
+	Updated  []Issue
+	Deleted  []string
+	SyncedAt time.Time
+}
+
+// SyncWithContext returns the issues created and updated since the last synchronization, and the keys of the deleted issues,
+// e.g. to maintain a mirror of JIRA. The issues are searched with "updated >= Since", ordered by the time they were created,
+// so issues updated while the pages are read keep their place and none is skipped. Their new changes are returned again by the next synchronization.
+// JQL dates have a precision of minutes, so issues updated in the minute of Since are returned again.
+// Deleted issues are detected by looking up the KnownKeys, an issue moved to another project is reported as deleted
+// under its old key and as updated under its new key.
+// Without options all issues are returned as created.
 //
-// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
-func (s *IssueService) UpdateAssignee(issueID string, assignee *User) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/assignee", issueID)
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/search-search
+func (s *IssueService) SyncWithContext(ctx context.Context, options *IssueSyncOptions) (*IssueSyncResult, error) {
+	if options == nil {
+		options = &IssueSyncOptions{}
+	}
+	result := &IssueSyncResult{SyncedAt: time.Now()}
 
-	req, err := s.client.NewRequest("PUT", apiEndPoint, assignee)
-	if err != nil {
-		return nil, err
+	var clauses []string
+	if options.JQL != "" {
+		clauses = append(clauses, "("+options.JQL+")")
+	}
+	since := options.Since
+	if !since.IsZero() {
+		location := options.Location
+		if location == nil {
+			location = time.UTC
+		}
+		clauses = append(clauses, fmt.Sprintf("updated >= %q", since.In(location).Format("2006/01/02 15:04")))
 	}
+	// The search is paged by offset, so it is ordered by fields which do not change when an issue is updated
+	jql := strings.TrimSpace(strings.Join(clauses, " AND ") + " ORDER BY created ASC, key ASC")
 
-	resp, err := s.client.Do(req, nil)
+	fields := options.Fields
+	if len(fields) > 0 {
+		fields = append(append([]string(nil), fields...), "created", "updated")
+	}
+	err := s.SearchPagesWithContext(ctx, jql, &SearchOptions{Fields: fields}, func(issue Issue) error {
+		if since.IsZero() || issue.Fields == nil || !time.Time(issue.Fields.Created).Before(since) {
+			result.Created = append(result.Created, issue)
+		} else {
+			result.Updated = append(result.Updated, issue)
+		}
+		return nil
+	})
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return nil, err
 	}
 
-	return resp, err
+	if len(options.KnownKeys) > 0 {
+		_, missing, err := s.GetManyWithContext(ctx, options.KnownKeys, "key")
+		if err != nil {
+			return nil, err
+		}
+		result.Deleted = missing
+	}
+	return result, nil
 }
 
-func (c ChangelogHistory) CreatedTime() (time.Time, error) {
-	var t time.Time
-	// Ignore null
-	if string(c.Created) == "null" {
-		return t, nil
-	}
-	t, err := time.Parse("2006-01-02T15:04:05.999-0700", c.Created)
-	return t, err
+// Sync wraps SyncWithContext using the background context.
+func (s *IssueService) Sync(options *IssueSyncOptions) (*IssueSyncResult, error) {
+	return s.SyncWithContext(context.Background(), options)
 }
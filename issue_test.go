@@ -1,11 +1,15 @@
 package jira
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -104,6 +108,32 @@ func TestIssueService_Update(t *testing.T) {
 	}
 }
 
+func TestIssueService_UpdateWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-9001?notifyUsers=false")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	i := &Issue{
+		Key: "PROJ-9001",
+		Fields: &IssueFields{
+			Description: "example bug report",
+		},
+	}
+	notify := false
+	issue, _, err := testClient.Issue.UpdateWithOptions(i, &UpdateQueryOptions{NotifyUsers: &notify})
+	if issue == nil {
+		t.Error("Expected issue. Issue is nil")
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_UpdateIssue(t *testing.T) {
 	setup()
 	defer teardown()
@@ -127,6 +157,99 @@ func TestIssueService_UpdateIssue(t *testing.T) {
 
 }
 
+func TestIssueService_SetSecurityLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-9001")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"fields":{"security":{"id":"10021"}}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.SetSecurityLevel("PROJ-9001", "10021")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddLabels(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-9001")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"update":{"labels":[{"add":"triaged"},{"add":"backend"}]}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.AddLabels("PROJ-9001", "triaged", "backend")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_RemoveLabels(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-9001")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"update":{"labels":[{"remove":"needs-triage"}]}}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.RemoveLabels("PROJ-9001", "needs-triage")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/PROJ-9001?notifyUsers=false")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"fields":{"summary":"Outage"},"update":{` +
+			`"comment":[{"add":{"body":"Escalated","visibility":{"type":"role","value":"Administrators"}}}],` +
+			`"components":[{"remove":{"name":"Frontend"}}],` +
+			`"fixVersions":[{"add":{"name":"1.1"}},{"remove":{"name":"1.0"}}],` +
+			`"timetracking":[{"edit":{"remainingEstimate":"4h"}}]}}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	update := NewIssueUpdate().
+		SetField("summary", "Outage").
+		Add("fixVersions", FixVersion{Name: "1.1"}).
+		Remove("fixVersions", FixVersion{Name: "1.0"}).
+		Remove("components", Component{Name: "Frontend"}).
+		Edit("timetracking", map[string]string{"remainingEstimate": "4h"}).
+		AddComment(&Comment{Body: "Escalated", Visibility: CommentVisibility{Type: "role", Value: "Administrators"}})
+	notify := false
+	_, err := testClient.Issue.Edit("PROJ-9001", update, &UpdateQueryOptions{NotifyUsers: &notify})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_AddComment(t *testing.T) {
 	setup()
 	defer teardown()
@@ -154,610 +277,1134 @@ func TestIssueService_AddComment(t *testing.T) {
 	}
 }
 
-func TestIssueService_UpdateComment(t *testing.T) {
+func TestIssueService_AddCommentV3(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "PUT")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
+	testMux.HandleFunc("/rest/api/3/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/issue/10000/comment")
+
+		var payload struct {
+			Body json.RawMessage `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}`
+		if string(payload.Body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, payload.Body)
+		}
 
 		w.WriteHeader(http.StatusCreated)
-		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10001","id":"10001","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}`)
+		fmt.Fprint(w, `{"id":"10000","body":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}}`)
 	})
 
-	c := &Comment{
-		ID:   "10001",
-		Body: "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.",
-		Visibility: CommentVisibility{
-			Type:  "role",
-			Value: "Administrators",
-		},
+	c := &Comment{BodyADF: NewADFDocumentFromText("Lorem ipsum")}
+	comment, _, err := testClient.Issue.AddCommentV3("10000", c)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	comment, _, err := testClient.Issue.UpdateComment("10000", c)
 	if comment == nil {
-		t.Error("Expected Comment. Comment is nil")
+		t.Fatal("Expected Comment. Comment is nil")
 	}
-	if err != nil {
-		t.Errorf("Error given: %s", err)
+	if comment.Body != "Lorem ipsum" || comment.BodyADF == nil {
+		t.Errorf("Expected ADF body Lorem ipsum. Got %q", comment.Body)
 	}
 }
 
-func TestIssueService_DeleteComment(t *testing.T) {
+func TestIssueService_UpdateV3(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "DELETE")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
+	testMux.HandleFunc("/rest/api/3/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/issue/EX-1")
+
+		var payload struct {
+			Fields struct {
+				Description json.RawMessage `json:"description"`
+			} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Main order flow broken"}]}]}`
+		if string(payload.Fields.Description) != expected {
+			t.Errorf("Expected description %s. Got %s", expected, payload.Fields.Description)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Fields struct {
+				Description json.RawMessage `json:"description"`
+			} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if string(payload.Fields.Description) != `"Main order flow broken"` {
+			t.Errorf("Expected the plain text description for the REST API v2. Got %s", payload.Fields.Description)
+		}
 
 		w.WriteHeader(http.StatusNoContent)
-		fmt.Fprint(w, `{}`)
 	})
 
-	err := testClient.Issue.DeleteComment("10000", "10001")
-	if err != nil {
+	issue := &Issue{
+		Key: "EX-1",
+		Fields: &IssueFields{
+			Description:    "Main order flow broken",
+			DescriptionADF: NewADFDocumentFromText("Main order flow broken"),
+		},
+	}
+	if _, _, err := testClient.Issue.UpdateV3(issue); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, _, err := testClient.Issue.Update(issue); err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_AddWorklogRecord(t *testing.T) {
+func TestIssueService_GetV3(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog")
+	testMux.HandleFunc("/rest/api/3/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/issue/10002")
 
-		w.WriteHeader(http.StatusCreated)
-		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2018-02-14T22:14:46.003+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2018-02-14T22:14:46.003+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}`)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"summary":"Outage","description":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Main order flow broken"}]}]},"comment":{"comments":[{"id":"10001","body":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Fixed"}]}]}}]}}}`)
 	})
-	r := &WorklogRecord{
-		TimeSpent: "1h",
+
+	issue, _, err := testClient.Issue.GetV3("10002", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
 	}
-	record, _, err := testClient.Issue.AddWorklogRecord("10000", r)
-	if record == nil {
-		t.Error("Expected Record. Record is nil")
+	if issue.Fields.Description != "Main order flow broken" || issue.Fields.DescriptionADF == nil {
+		t.Errorf("Expected ADF description. Got %q", issue.Fields.Description)
 	}
-	if err != nil {
-		t.Errorf("Error given: %s", err)
+	if len(issue.Fields.Comments.Comments) != 1 || issue.Fields.Comments.Comments[0].Body != "Fixed" {
+		t.Errorf("Expected ADF comment Fixed. Got %+v", issue.Fields.Comments)
 	}
 }
 
-func TestIssueService_AddLink(t *testing.T) {
+func TestIssueService_UpdateComment(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issueLink")
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
 
-		w.WriteHeader(http.StatusOK)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10001","id":"10001","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}`)
 	})
 
-	il := &IssueLink{
-		Type: IssueLinkType{
-			Name: "Duplicate",
-		},
-		InwardIssue: &Issue{
-			Key: "HSP-1",
-		},
-		OutwardIssue: &Issue{
-			Key: "MKY-1",
-		},
-		Comment: &Comment{
-			Body: "Linked related issue!",
-			Visibility: CommentVisibility{
-				Type:  "group",
-				Value: "jira-software-users",
-			},
+	c := &Comment{
+		ID:   "10001",
+		Body: "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.",
+		Visibility: CommentVisibility{
+			Type:  "role",
+			Value: "Administrators",
 		},
 	}
-	resp, err := testClient.Issue.AddLink(il)
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
-	}
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	comment, _, err := testClient.Issue.UpdateComment("10000", c)
+	if comment == nil {
+		t.Error("Expected Comment. Comment is nil")
 	}
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_Get_Fields(t *testing.T) {
+func TestIssueService_UpdateCommentV3(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
+	testMux.HandleFunc("/rest/api/3/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/issue/10000/comment/10001")
 
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+		var payload struct {
+			Body json.RawMessage `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}`
+		if string(payload.Body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, payload.Body)
+		}
+
+		fmt.Fprint(w, `{"id":"10001","body":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}}`)
 	})
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Body json.RawMessage `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		if string(payload.Body) != `"Lorem ipsum"` {
+			t.Errorf("Expected the plain text body for the REST API v2. Got %s", payload.Body)
+		}
 
-	issue, _, err := testClient.Issue.Get("10002", nil)
-	if issue == nil {
-		t.Error("Expected issue. Issue is nil")
-	}
-	if !reflect.DeepEqual(issue.Fields.Labels, []string{"test"}) {
-		t.Error("Expected labels for the returned issue")
-	}
+		fmt.Fprint(w, `{"id":"10001","body":"Lorem ipsum"}`)
+	})
 
-	if len(issue.Fields.Comments.Comments) != 1 {
-		t.Errorf("Expected one comment, %v found", len(issue.Fields.Comments.Comments))
+	c := &Comment{ID: "10001", Body: "Lorem ipsum", BodyADF: NewADFDocumentFromText("Lorem ipsum")}
+	comment, _, err := testClient.Issue.UpdateCommentV3("10000", c)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	if issue.Fields.Epic == nil {
-		t.Error("Epic expected but not found")
+	if comment == nil || comment.BodyADF == nil {
+		t.Errorf("Expected ADF comment. Got %+v", comment)
 	}
 
-	if err != nil {
+	if _, _, err := testClient.Issue.UpdateComment("10000", c); err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_Get_RenderedFields(t *testing.T) {
+func TestIssueService_GetComment(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
 
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{},"renderedFields":{"resolutiondate":"In 1 week","updated":"2 hours ago","comment":{"comments":[{"body":"This <strong>is</strong> HTML"}]}}}`)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10001","id":"10001","body":"Lorem ipsum","visibility":{"type":"group","value":"jira-developers"}}`)
 	})
 
-	issue, _, err := testClient.Issue.Get("10002", nil)
-	if issue == nil {
-		t.Error("Expected issue. Issue is nil")
-	}
-	if issue.RenderedFields.Updated != "2 hours ago" {
-		t.Error("Expected updated to equla '2 hours ago' for rendered field")
-	}
-
-	if len(issue.RenderedFields.Comments.Comments) != 1 {
-		t.Errorf("Expected one comment, %v found", len(issue.RenderedFields.Comments.Comments))
-	}
-	comment := issue.RenderedFields.Comments.Comments[0]
-	if comment.Body != "This <strong>is</strong> HTML" {
-		t.Errorf("Wrong comment body returned in RenderedField. Got %s", comment.Body)
-	}
-
+	comment, _, err := testClient.Issue.GetComment("10000", "10001")
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if comment == nil || comment.Visibility.Type != CommentVisibilityTypeGroup {
+		t.Errorf("Expected comment restricted to a group. Got %+v", comment)
+	}
 }
 
-func TestIssueService_DownloadAttachment(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_ListComments(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/secure/attachment/10000/")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment?maxResults=2&orderBy=-created&startAt=2")
 
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(testAttachment))
+		fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"comments":[{"id":"10003","body":"Lorem ipsum"}]}`)
 	})
 
-	resp, err := testClient.Issue.DownloadAttachment("10000")
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
-	}
-	defer resp.Body.Close()
-
-	attachment, err := ioutil.ReadAll(resp.Body)
+	comments, resp, err := testClient.Issue.ListComments("10000", &CommentListOptions{StartAt: 2, MaxResults: 2, OrderBy: "-created"})
 	if err != nil {
-		t.Error("Expected attachment text", err)
-	}
-	if string(attachment) != testAttachment {
-		t.Errorf("Expecting an attachment: %s", string(attachment))
+		t.Errorf("Error given: %s", err)
 	}
-
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	if len(comments) != 1 || comments[0].ID != "10003" {
+		t.Errorf("Expected comment 10003. Got %+v", comments)
 	}
-	if err != nil {
-		t.Errorf("Error given: %s", err)
+	if resp.StartAt != 2 || resp.Total != 3 {
+		t.Errorf("Expected page starting at 2 of 3. Got %d of %d", resp.StartAt, resp.Total)
 	}
 }
 
-func TestIssueService_DownloadAttachment_BadStatus(t *testing.T) {
-
+func TestIssueService_DeleteComment(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/secure/attachment/10000/")
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/comment/10001")
 
-		w.WriteHeader(http.StatusForbidden)
+		w.WriteHeader(http.StatusNoContent)
+		fmt.Fprint(w, `{}`)
 	})
 
-	resp, err := testClient.Issue.DownloadAttachment("10000")
-	if resp == nil {
-		t.Error("Expected response. Response is nil")
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusForbidden {
-		t.Errorf("Expected Status code %d. Given %d", http.StatusForbidden, resp.StatusCode)
-	}
-	if err == nil {
-		t.Errorf("Error expected")
+	err := testClient.Issue.DeleteComment("10000", "10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_PostAttachment(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_Create_Cloud(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issue", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		status := http.StatusOK
+		testRequestURL(t, r, "/rest/api/3/issue")
 
-		file, _, err := r.FormFile("file")
-		if err != nil {
-			status = http.StatusNotAcceptable
+		var payload struct {
+			Fields struct {
+				Description json.RawMessage `json:"description"`
+			} `json:"fields"`
 		}
-		if file == nil {
-			status = http.StatusNoContent
-		} else {
-
-			// Read the file into memory
-			data, err := ioutil.ReadAll(file)
-			if err != nil {
-				status = http.StatusInternalServerError
-			}
-			if string(data) != testAttachment {
-				status = http.StatusNotAcceptable
-			}
-
-			w.WriteHeader(status)
-			fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
-			file.Close()
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}`
+		if string(payload.Fields.Description) != expected {
+			t.Errorf("Expected description %s. Got %s", expected, payload.Fields.Description)
 		}
-	})
-
-	reader := strings.NewReader(testAttachment)
-
-	issue, resp, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
 
-	if issue == nil {
-		t.Error("Expected response. Response is nil")
-	}
-
-	if resp.StatusCode != 200 {
-		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
-	}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000","key":"EX-1"}`)
+	})
 
+	issue, _, err := testClient.Issue.Create(&Issue{Fields: &IssueFields{Description: "Lorem ipsum"}})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1. Got %+v", issue)
+	}
 }
 
-func TestIssueService_PostAttachment_NoResponse(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_AddComment_Cloud(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
-	})
-	reader := strings.NewReader(testAttachment)
+		testRequestURL(t, r, "/rest/api/3/issue/10000/comment")
 
-	_, _, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
+		var payload struct {
+			Body json.RawMessage `json:"body"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Lorem ipsum"}]}]}`
+		if string(payload.Body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, payload.Body)
+		}
 
-	if err == nil {
-		t.Errorf("Error expected: %s", err)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10001","body":`+expected+`}`)
+	})
+
+	comment, _, err := testClient.Issue.AddComment("10000", &Comment{Body: "Lorem ipsum"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if comment == nil || comment.Body != "Lorem ipsum" || comment.BodyADF == nil {
+		t.Errorf("Expected ADF comment. Got %+v", comment)
 	}
 }
 
-func TestIssueService_PostAttachment_NoFilename(t *testing.T) {
-	var testAttachment = "Here is an attachment"
-
+func TestIssueService_Edit_Cloud(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issue/PROJ-9001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/issue/PROJ-9001")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"update":{` +
+			`"comment":[{"add":{"body":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Escalated"}]}]}}}],` +
+			`"labels":[{"add":"triaged"}]}}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
 	})
-	reader := strings.NewReader(testAttachment)
-
-	_, _, err := testClient.Issue.PostAttachment("10000", reader, "")
 
-	if err != nil {
-		t.Errorf("Error expected: %s", err)
+	update := NewIssueUpdate().Add("labels", "triaged").AddComment(&Comment{Body: "Escalated"})
+	if _, err := testClient.Issue.Edit("PROJ-9001", update, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if value, _ := json.Marshal(update.Update["comment"]); string(value) != `[{"add":{"body":"Escalated"}}]` {
+		t.Errorf("Expected the edit to be left unchanged. Got %s", value)
 	}
 }
 
-func TestIssueService_PostAttachment_NoAttachment(t *testing.T) {
+func TestIssueService_AddWorklogRecord_Cloud(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
-	})
+		testRequestURL(t, r, "/rest/api/3/issue/10000/worklog")
 
-	_, _, err := testClient.Issue.PostAttachment("10000", nil, "attachment")
+		var payload struct {
+			Comment   json.RawMessage `json:"comment"`
+			TimeSpent string          `json:"timeSpent"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"I did some work here."}]}]}`
+		if string(payload.Comment) != expected || payload.TimeSpent != "1h" {
+			t.Errorf("Expected comment %s. Got %s", expected, payload.Comment)
+		}
 
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"100028","comment":`+expected+`,"timeSpent":"1h"}`)
+	})
+
+	record, _, err := testClient.Issue.AddWorklogRecord("10000", &WorklogRecord{Comment: "I did some work here.", TimeSpent: "1h"})
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if record == nil || record.Comment != "I did some work here." || record.CommentADF == nil {
+		t.Errorf("Expected ADF worklog comment. Got %+v", record)
+	}
 }
 
-func TestIssueService_Search(t *testing.T) {
+func TestIssueService_AddLink_Cloud(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/search?jql=something&startAt=1&maxResults=40&expand=foo")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 40,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
-	})
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/issueLink")
 
-	opt := &SearchOptions{StartAt: 1, MaxResults: 40, Expand: "foo"}
-	_, resp, err := testClient.Issue.Search("something", opt)
+		var payload struct {
+			Type    IssueLinkType `json:"type"`
+			Comment struct {
+				Body json.RawMessage `json:"body"`
+			} `json:"comment"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		expected := `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Linked"}]}]}`
+		if payload.Type.Name != "Duplicate" || string(payload.Comment.Body) != expected {
+			t.Errorf("Expected comment body %s. Got %+v", expected, payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
 
-	if resp == nil {
-		t.Errorf("Response given: %+v", resp)
+	link := &IssueLink{
+		Type:         IssueLinkType{Name: "Duplicate"},
+		InwardIssue:  &Issue{Key: "EX-1"},
+		OutwardIssue: &Issue{Key: "EX-2"},
+		Comment:      &Comment{Body: "Linked"},
 	}
-	if err != nil {
+	if _, err := testClient.Issue.AddLink(link); err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+}
 
-	if resp.StartAt != 1 {
-		t.Errorf("StartAt should populate with 1, %v given", resp.StartAt)
+func TestIssueService_AddWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2018-02-14T22:14:46.003+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2018-02-14T22:14:46.003+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}`)
+	})
+	r := &WorklogRecord{
+		TimeSpent: "1h",
 	}
-	if resp.MaxResults != 40 {
-		t.Errorf("StartAt should populate with 40, %v given", resp.MaxResults)
+	record, _, err := testClient.Issue.AddWorklogRecord("10000", r)
+	if record == nil {
+		t.Error("Expected Record. Record is nil")
 	}
-	if resp.Total != 6 {
-		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_Search_WithoutPaging(t *testing.T) {
+func TestIssueService_AddLink(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/search?jql=something")
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issueLink")
+
 		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, `{"expand": "schema,names","startAt": 0,"maxResults": 50,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
 	})
 
-	_, resp, err := testClient.Issue.Search("something", nil)
-
+	il := &IssueLink{
+		Type: IssueLinkType{
+			Name: "Duplicate",
+		},
+		InwardIssue: &Issue{
+			Key: "HSP-1",
+		},
+		OutwardIssue: &Issue{
+			Key: "MKY-1",
+		},
+		Comment: &Comment{
+			Body: "Linked related issue!",
+			Visibility: CommentVisibility{
+				Type:  "group",
+				Value: "jira-software-users",
+			},
+		},
+	}
+	resp, err := testClient.Issue.AddLink(il)
 	if resp == nil {
-		t.Errorf("Response given: %+v", resp)
+		t.Error("Expected response. Response is nil")
+	}
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
 	}
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
-
-	if resp.StartAt != 0 {
-		t.Errorf("StartAt should populate with 0, %v given", resp.StartAt)
-	}
-	if resp.MaxResults != 50 {
-		t.Errorf("StartAt should populate with 50, %v given", resp.MaxResults)
-	}
-	if resp.Total != 6 {
-		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
-	}
 }
 
-func TestIssueService_SearchPages(t *testing.T) {
+func TestIssueService_Get_Fields(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		if r.URL.String() == "/rest/api/2/search?jql=something&startAt=1&maxResults=2&expand=foo&fields=&validateQuery=warn" {
-			w.WriteHeader(http.StatusOK)
-			fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
-			return
-		} else if r.URL.String() == "/rest/api/2/search?jql=something&startAt=3&maxResults=2&expand=foo&fields=&validateQuery=warn" {
-			w.WriteHeader(http.StatusOK)
-			fmt.Fprint(w, `{"expand": "schema,names","startAt": 3,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
-			return
-		} else if r.URL.String() == "/rest/api/2/search?jql=something&startAt=5&maxResults=2&expand=foo&fields=&validateQuery=warn" {
-			w.WriteHeader(http.StatusOK)
-			fmt.Fprint(w, `{"expand": "schema,names","startAt": 5,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}}]}`)
-			return
-		}
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
 
-		t.Errorf("Unexpected URL: %v", r.URL)
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
 	})
 
-	opt := &SearchOptions{StartAt: 1, MaxResults: 2, Expand: "foo", ValidateQuery: "warn"}
-	issues := make([]Issue, 0)
-	err := testClient.Issue.SearchPages("something", opt, func(issue Issue) error {
-		issues = append(issues, issue)
-		return nil
-	})
+	issue, _, err := testClient.Issue.Get("10002", nil)
+	if issue == nil {
+		t.Error("Expected issue. Issue is nil")
+	}
+	if !reflect.DeepEqual(issue.Fields.Labels, []string{"test"}) {
+		t.Error("Expected labels for the returned issue")
+	}
 
-	if err != nil {
-		t.Errorf("Error given: %s", err)
+	if len(issue.Fields.Comments.Comments) != 1 {
+		t.Errorf("Expected one comment, %v found", len(issue.Fields.Comments.Comments))
+	}
+	if issue.Fields.Epic == nil {
+		t.Error("Epic expected but not found")
 	}
 
-	if len(issues) != 5 {
-		t.Errorf("Expected 5 issues, %v given", len(issues))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_GetCustomFields(t *testing.T) {
+func TestIssueService_Get_RenderedFields(t *testing.T) {
 	setup()
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testRequestURL(t, r, "/rest/api/2/issue/10002")
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"customfield_123":"test","watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"labels":["test"],"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"epic": {"id": 19415,"key": "EPIC-77","self": "https://example.atlassian.net/rest/agile/1.0/epic/19415","name": "Epic Name","summary": "Do it","color": {"key": "color_11"},"done": false},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{},"renderedFields":{"resolutiondate":"In 1 week","updated":"2 hours ago","comment":{"comments":[{"body":"This <strong>is</strong> HTML"}]}}}`)
 	})
 
-	issue, _, err := testClient.Issue.GetCustomFields("10002")
-	if err != nil {
-		t.Errorf("Error given: %s", err)
-	}
+	issue, _, err := testClient.Issue.Get("10002", nil)
 	if issue == nil {
-		t.Error("Expected Customfields")
+		t.Error("Expected issue. Issue is nil")
 	}
-	cf := issue["customfield_123"]
-	if cf != "test" {
-		t.Error("Expected \"test\" for custom field")
+	if issue.RenderedFields.Updated != "2 hours ago" {
+		t.Error("Expected updated to equla '2 hours ago' for rendered field")
+	}
+
+	if len(issue.RenderedFields.Comments.Comments) != 1 {
+		t.Errorf("Expected one comment, %v found", len(issue.RenderedFields.Comments.Comments))
+	}
+	comment := issue.RenderedFields.Comments.Comments[0]
+	if comment.Body != "This <strong>is</strong> HTML" {
+		t.Errorf("Wrong comment body returned in RenderedField. Got %s", comment.Body)
+	}
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_GetComplexCustomFields(t *testing.T) {
+func TestIssueService_DownloadAttachment(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
-		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"customfield_123":{"self":"http://www.example.com/jira/rest/api/2/customFieldOption/123","value":"test","id":"123"},"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+		testRequestURL(t, r, "/secure/attachment/10000/")
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testAttachment))
 	})
 
-	issue, _, err := testClient.Issue.GetCustomFields("10002")
+	resp, err := testClient.Issue.DownloadAttachment("10000")
+	if resp == nil {
+		t.Error("Expected response. Response is nil")
+	}
+	defer resp.Body.Close()
+
+	attachment, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		t.Errorf("Error given: %s", err)
+		t.Error("Expected attachment text", err)
 	}
-	if issue == nil {
-		t.Error("Expected Customfields")
+	if string(attachment) != testAttachment {
+		t.Errorf("Expecting an attachment: %s", string(attachment))
 	}
-	cf := issue["customfield_123"]
-	if cf != "test" {
-		t.Error("Expected \"test\" for custom field")
+
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_GetTransitions(t *testing.T) {
+func TestIssueService_DownloadAttachment_BadStatus(t *testing.T) {
+
 	setup()
 	defer teardown()
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/secure/attachment/10000/")
 
-	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+		w.WriteHeader(http.StatusForbidden)
+	})
 
-	raw, err := ioutil.ReadFile("./mocks/transitions.json")
-	if err != nil {
-		t.Error(err.Error())
+	resp, err := testClient.Issue.DownloadAttachment("10000")
+	if resp == nil {
+		t.Error("Expected response. Response is nil")
 	}
+	defer resp.Body.Close()
 
-	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, testAPIEndpoint)
-		fmt.Fprint(w, string(raw))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected Status code %d. Given %d", http.StatusForbidden, resp.StatusCode)
+	}
+	if err == nil {
+		t.Errorf("Error expected")
+	}
+}
+
+func TestIssueService_PostAttachment(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		status := http.StatusOK
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			status = http.StatusNotAcceptable
+		}
+		if file == nil {
+			status = http.StatusNoContent
+		} else {
+
+			// Read the file into memory
+			data, err := ioutil.ReadAll(file)
+			if err != nil {
+				status = http.StatusInternalServerError
+			}
+			if string(data) != testAttachment {
+				status = http.StatusNotAcceptable
+			}
+
+			w.WriteHeader(status)
+			fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+			file.Close()
+		}
 	})
 
-	transitions, _, err := testClient.Issue.GetTransitions("123")
+	reader := strings.NewReader(testAttachment)
 
-	if err != nil {
-		t.Errorf("Got error: %v", err)
-	}
+	issue, resp, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
 
-	if transitions == nil {
-		t.Error("Expected transition list. Got nil.")
+	if issue == nil {
+		t.Error("Expected response. Response is nil")
 	}
 
-	if len(transitions) != 2 {
-		t.Errorf("Expected 2 transitions. Got %d", len(transitions))
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected Status code 200. Given %d", resp.StatusCode)
 	}
 
-	if transitions[0].Fields["summary"].Required != false {
-		t.Errorf("First transition summary field should not be required")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueService_DoTransition(t *testing.T) {
+func TestIssueService_DownloadAttachmentTo(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
 	setup()
 	defer teardown()
+	testMux.HandleFunc("/secure/attachment/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/secure/attachment/10000/")
 
-	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(testAttachment))
+	})
 
-	transitionID := "22"
+	var buf bytes.Buffer
+	resp, err := testClient.Issue.DownloadAttachmentTo("10000", &buf)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected Status code 200. Given %+v", resp)
+	}
+	if buf.String() != testAttachment {
+		t.Errorf("Expecting an attachment: %s", buf.String())
+	}
+}
 
-	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+func TestIssueService_PostAttachmentStream(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, testAPIEndpoint)
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
 
-		decoder := json.NewDecoder(r.Body)
-		var payload CreateTransitionPayload
-		err := decoder.Decode(&payload)
+		if r.Header.Get("X-Atlassian-Token") != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token header nocheck. Got %s", r.Header.Get("X-Atlassian-Token"))
+		}
+		file, header, err := r.FormFile("file")
 		if err != nil {
-			t.Errorf("Got error: %v", err)
+			t.Fatalf("Error given: %s", err)
 		}
-
-		if payload.Transition.ID != transitionID {
-			t.Errorf("Expected %s to be in payload, got %s instead", transitionID, payload.Transition.ID)
+		defer file.Close()
+		data, _ := ioutil.ReadAll(file)
+		if header.Filename != "build.log" || string(data) != testAttachment {
+			t.Errorf("Expected build.log with the attachment. Got %s: %s", header.Filename, data)
 		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"build.log","size":21}]`)
 	})
-	_, err := testClient.Issue.DoTransition("123", transitionID)
 
+	attachments, _, err := testClient.Issue.PostAttachmentStream("10000", strings.NewReader(testAttachment), "build.log")
 	if err != nil {
-		t.Errorf("Got error: %v", err)
+		t.Errorf("Error given: %s", err)
+	}
+	if attachments == nil || len(*attachments) != 1 {
+		t.Errorf("Expected one attachment. Got %+v", attachments)
 	}
 }
 
-func TestIssueService_DoTransitionWithPayload(t *testing.T) {
+func TestIssueService_PostAttachment_NoResponse(t *testing.T) {
+	var testAttachment = "Here is an attachment"
+
 	setup()
 	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+	})
+	reader := strings.NewReader(testAttachment)
 
-	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
-
-	transitionID := "22"
+	_, _, err := testClient.Issue.PostAttachment("10000", reader, "attachment")
 
-	customPayload := map[string]interface{}{
-		"update": map[string]interface{}{
-			"comment": []map[string]interface{}{
-				{
-					"add": map[string]string{
-						"body": "Hello World",
-					},
-				},
-			},
-		},
-		"transition": TransitionPayload{
-			ID: transitionID,
-		},
+	if err == nil {
+		t.Errorf("Error expected: %s", err)
 	}
+}
 
-	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "POST")
-		testRequestURL(t, r, testAPIEndpoint)
-
-		decoder := json.NewDecoder(r.Body)
-		payload := map[string]interface{}{}
-		err := decoder.Decode(&payload)
-		if err != nil {
-			t.Errorf("Got error: %v", err)
-		}
+func TestIssueService_PostAttachment_NoFilename(t *testing.T) {
+	var testAttachment = "Here is an attachment"
 
-		contains := func(key string) bool {
-			_, ok := payload[key]
-			return ok
-		}
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
+	})
+	reader := strings.NewReader(testAttachment)
 
-		if !contains("update") || !contains("transition") {
-			t.Fatalf("Excpected update, transition to be in payload, got %s instead", payload)
-		}
+	_, _, err := testClient.Issue.PostAttachment("10000", reader, "")
 
-		transition, ok := payload["transition"].(map[string]interface{})
-		if !ok {
-			t.Fatalf("Excpected transition to be in payload, got %s instead", payload["transition"])
-		}
+	if err != nil {
+		t.Errorf("Error expected: %s", err)
+	}
+}
 
-		if transition["id"].(string) != transitionID {
-			t.Errorf("Expected %s to be in payload, got %s instead", transitionID, transition["id"])
-		}
+func TestIssueService_PostAttachment_NoAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/attachments")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"self":"http://jira/jira/rest/api/2/attachment/228924","id":"228924","filename":"example.jpg","author":{"self":"http://jira/jira/rest/api/2/user?username=test","name":"test","emailAddress":"test@test.com","avatarUrls":{"16x16":"http://jira/jira/secure/useravatar?size=small&avatarId=10082","48x48":"http://jira/jira/secure/useravatar?avatarId=10082"},"displayName":"Tester","active":true},"created":"2016-05-24T00:25:17.000-0700","size":32280,"mimeType":"image/jpeg","content":"http://jira/jira/secure/attachment/228924/example.jpg","thumbnail":"http://jira/jira/secure/thumbnail/228924/_thumb_228924.png"}]`)
 	})
-	_, err := testClient.Issue.DoTransitionWithPayload("123", customPayload)
+
+	_, _, err := testClient.Issue.PostAttachment("10000", nil, "attachment")
 
 	if err != nil {
-		t.Errorf("Got error: %v", err)
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestIssueFields_TestMarshalJSON_PopulateUnknownsSuccess(t *testing.T) {
-	data := `{
+func TestIssueService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=something&startAt=1&maxResults=40&expand=foo")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 40,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+	})
+
+	opt := &SearchOptions{StartAt: 1, MaxResults: 40, Expand: "foo"}
+	_, resp, err := testClient.Issue.Search("something", opt)
+
+	if resp == nil {
+		t.Errorf("Response given: %+v", resp)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if resp.StartAt != 1 {
+		t.Errorf("StartAt should populate with 1, %v given", resp.StartAt)
+	}
+	if resp.MaxResults != 40 {
+		t.Errorf("StartAt should populate with 40, %v given", resp.MaxResults)
+	}
+	if resp.Total != 6 {
+		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+	}
+}
+
+func TestIssueService_Search_WithoutPaging(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=something")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"expand": "schema,names","startAt": 0,"maxResults": 50,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+	})
+
+	_, resp, err := testClient.Issue.Search("something", nil)
+
+	if resp == nil {
+		t.Errorf("Response given: %+v", resp)
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if resp.StartAt != 0 {
+		t.Errorf("StartAt should populate with 0, %v given", resp.StartAt)
+	}
+	if resp.MaxResults != 50 {
+		t.Errorf("StartAt should populate with 50, %v given", resp.MaxResults)
+	}
+	if resp.Total != 6 {
+		t.Errorf("StartAt should populate with 6, %v given", resp.Total)
+	}
+}
+
+func TestIssueService_Find(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+EX&startAt=1&maxResults=40&fields=summary%2Cstatus&expand=changelog")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 40,"total": 6,"issues": [{"id": "10230","key": "BULK-62","fields": {"summary": "First"}},{"id": "10004","key": "BULK-47","fields": {"summary": "Second"}}]}`)
+	})
+
+	issues, resp, err := testClient.Issue.Find("project = EX", WithStartAt(1), WithMaxResults(40), WithFields("summary", "status"), WithExpand("changelog"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues, %d given", len(issues))
+	}
+	if resp.StartAt != 1 || resp.MaxResults != 40 || resp.Total != 6 {
+		t.Errorf("Paging values not populated, %d/%d/%d given", resp.StartAt, resp.MaxResults, resp.Total)
+	}
+}
+
+func TestIssueService_SearchInto(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=project+%3D+OPS")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[
+			{"id":"10001","key":"OPS-1","fields":{"summary":"First","customfield_10002":3,"customfield_10003":{"value":"Billing"}}},
+			{"id":"10002","key":"OPS-2","fields":{"summary":"Second","customfield_10002":null}}]}`)
+	})
+
+	var issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary     string  `json:"summary"`
+			StoryPoints float64 `json:"customfield_10002"`
+			Team        *Option `json:"customfield_10003"`
+		} `json:"fields"`
+	}
+	resp, err := testClient.Issue.SearchInto("project = OPS", nil, &issues)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues. Got %d", len(issues))
+	}
+	if issues[0].Key != "OPS-1" || issues[0].Fields.StoryPoints != 3 || issues[0].Fields.Team.Value != "Billing" {
+		t.Errorf("Expected OPS-1 with 3 story points of team Billing. Got %+v", issues[0])
+	}
+	if issues[1].Fields.Summary != "Second" || issues[1].Fields.Team != nil {
+		t.Errorf("Expected OPS-2 without team. Got %+v", issues[1])
+	}
+	if resp.Total != 2 || resp.MaxResults != 50 {
+		t.Errorf("Expected the paging of the response. Got total %d and max results %d", resp.Total, resp.MaxResults)
+	}
+}
+
+func TestIssueService_GetInto(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/OPS-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/OPS-1?fields=summary%2Ccustomfield_10002")
+		fmt.Fprint(w, `{"id":"10001","key":"OPS-1","fields":{"summary":"First","customfield_10002":3}}`)
+	})
+
+	var issue struct {
+		Fields struct {
+			Summary     string  `json:"summary"`
+			StoryPoints float64 `json:"customfield_10002"`
+		} `json:"fields"`
+	}
+	_, err := testClient.Issue.GetInto("OPS-1", &GetQueryOptions{Fields: "summary,customfield_10002"}, &issue)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "First" || issue.Fields.StoryPoints != 3 {
+		t.Errorf("Expected First with 3 story points. Got %+v", issue)
+	}
+}
+
+func TestIssueService_SearchPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.String() == "/rest/api/2/search?jql=something&startAt=1&maxResults=2&expand=foo&fields=&validateQuery=warn" {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"expand": "schema,names","startAt": 1,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+			return
+		} else if r.URL.String() == "/rest/api/2/search?jql=something&startAt=3&maxResults=2&expand=foo&fields=&validateQuery=warn" {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"expand": "schema,names","startAt": 3,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}},{"expand": "html","id": "10004","self": "http://kelpie9:8081/rest/api/2/issue/BULK-47","key": "BULK-47","fields": {"summary": "Cheese v1 2.0 issue","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/3","id": "3","description": "A task that needs to be done.","iconUrl": "http://kelpie9:8081/images/icons/task.gif","name": "Task","subtask": false}}}]}`)
+			return
+		} else if r.URL.String() == "/rest/api/2/search?jql=something&startAt=5&maxResults=2&expand=foo&fields=&validateQuery=warn" {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"expand": "schema,names","startAt": 5,"maxResults": 2,"total": 6,"issues": [{"expand": "html","id": "10230","self": "http://kelpie9:8081/rest/api/2/issue/BULK-62","key": "BULK-62","fields": {"summary": "testing","timetracking": null,"issuetype": {"self": "http://kelpie9:8081/rest/api/2/issuetype/5","id": "5","description": "The sub-task of the issue","iconUrl": "http://kelpie9:8081/images/icons/issue_subtask.gif","name": "Sub-task","subtask": true},"customfield_10071": null}}]}`)
+			return
+		}
+
+		t.Errorf("Unexpected URL: %v", r.URL)
+	})
+
+	opt := &SearchOptions{StartAt: 1, MaxResults: 2, Expand: "foo", ValidateQuery: "warn"}
+	issues := make([]Issue, 0)
+	err := testClient.Issue.SearchPages("something", opt, func(issue Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if len(issues) != 5 {
+		t.Errorf("Expected 5 issues, %v given", len(issues))
+	}
+}
+
+func TestIssueService_GetCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"customfield_123":"test","watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+	})
+
+	issue, _, err := testClient.Issue.GetCustomFields("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil {
+		t.Error("Expected Customfields")
+	}
+	cf := issue["customfield_123"]
+	if cf != "test" {
+		t.Error("Expected \"test\" for custom field")
+	}
+}
+
+func TestIssueService_GetComplexCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
+		fmt.Fprint(w, `{"expand":"renderedFields,names,schema,transitions,operations,editmeta,changelog,versionedRepresentations","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","fields":{"customfield_123":{"self":"http://www.example.com/jira/rest/api/2/customFieldOption/123","value":"test","id":"123"},"watcher":{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]},"attachment":[{"self":"http://www.example.com/jira/rest/api/2.0/attachments/10000","filename":"picture.jpg","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred","24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred","32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.461+0000","size":23123,"mimeType":"image/jpeg","content":"http://www.example.com/jira/attachments/10000","thumbnail":"http://www.example.com/jira/secure/thumbnail/10000"}],"sub-tasks":[{"id":"10000","type":{"id":"10000","name":"","inward":"Parent","outward":"Sub-task"},"outwardIssue":{"id":"10003","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/EX-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"description":"example bug report","project":{"self":"http://www.example.com/jira/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example","avatarUrls":{"48x48":"http://www.example.com/jira/secure/projectavatar?size=large&pid=10000","24x24":"http://www.example.com/jira/secure/projectavatar?size=small&pid=10000","16x16":"http://www.example.com/jira/secure/projectavatar?size=xsmall&pid=10000","32x32":"http://www.example.com/jira/secure/projectavatar?size=medium&pid=10000"},"projectCategory":{"self":"http://www.example.com/jira/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}},"comment":{"comments":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id":"10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"body":"Lorem ipsum dolor sit amet, consectetur adipiscing elit. Pellentesque eget venenatis elit. Duis eu justo eget augue iaculis fermentum. Sed semper quam laoreet nisi egestas at posuere augue semper.","updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","visibility":{"type":"role","value":"Administrators"}}]},"issuelinks":[{"id":"10001","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"outwardIssue":{"id":"10004L","key":"PRJ-2","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-2","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}},{"id":"10002","type":{"id":"10000","name":"Dependent","inward":"depends on","outward":"is depended by"},"inwardIssue":{"id":"10004","key":"PRJ-3","self":"http://www.example.com/jira/rest/api/2/issue/PRJ-3","fields":{"status":{"iconUrl":"http://www.example.com/jira//images/icons/statuses/open.png","name":"Open"}}}}],"worklog":{"worklogs":[{"self":"http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"comment":"I did some work here.","updated":"2016-03-16T04:22:37.471+0000","visibility":{"type":"group","value":"jira-developers"},"started":"2016-03-16T04:22:37.471+0000","timeSpent":"3h 20m","timeSpentSeconds":12000,"id":"100028","issueId":"10002"}]},"updated":"2016-04-06T02:36:53.594-0700","duedate":"2018-01-19","timetracking":{"originalEstimate":"10m","remainingEstimate":"3m","timeSpent":"6m","originalEstimateSeconds":600,"remainingEstimateSeconds":200,"timeSpentSeconds":400}},"names":{"watcher":"watcher","attachment":"attachment","sub-tasks":"sub-tasks","description":"description","project":"project","comment":"comment","issuelinks":"issuelinks","worklog":"worklog","updated":"updated","timetracking":"timetracking"},"schema":{}}`)
+	})
+
+	issue, _, err := testClient.Issue.GetCustomFields("10002")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil {
+		t.Error("Expected Customfields")
+	}
+	cf := issue["customfield_123"]
+	if cf != "test" {
+		t.Error("Expected \"test\" for custom field")
+	}
+}
+
+func TestIssueService_GetTransitions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	raw, err := ioutil.ReadFile("./mocks/transitions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	transitions, _, err := testClient.Issue.GetTransitions("123")
+
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+
+	if transitions == nil {
+		t.Error("Expected transition list. Got nil.")
+	}
+
+	if len(transitions) != 2 {
+		t.Errorf("Expected 2 transitions. Got %d", len(transitions))
+	}
+
+	if transitions[0].Fields["summary"].Required != false {
+		t.Errorf("First transition summary field should not be required")
+	}
+}
+
+func TestIssueService_DoTransition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	transitionID := "22"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		decoder := json.NewDecoder(r.Body)
+		var payload CreateTransitionPayload
+		err := decoder.Decode(&payload)
+		if err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+
+		if payload.Transition.ID != transitionID {
+			t.Errorf("Expected %s to be in payload, got %s instead", transitionID, payload.Transition.ID)
+		}
+	})
+	_, err := testClient.Issue.DoTransition("123", transitionID)
+
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestIssueService_DoTransitionWithFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			Transition TransitionPayload `json:"transition"`
+			Fields     struct {
+				Resolution Resolution `json:"resolution"`
+				Assignee   User       `json:"assignee"`
+			} `json:"fields"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Transition.ID != "22" {
+			t.Errorf("Expected transition 22 to be in payload, got %s instead", payload.Transition.ID)
+		}
+		if payload.Fields.Resolution.Name != "Fixed" || payload.Fields.Assignee.Name != "fred" {
+			t.Errorf("Expected resolution and assignee to be in payload, got %+v instead", payload.Fields)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.DoTransitionWithFields("123", "22", map[string]interface{}{
+		"resolution": Resolution{Name: "Fixed"},
+		"assignee":   User{Name: "fred"},
+	})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestIssueService_DoTransitionWithPayload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/123/transitions"
+
+	transitionID := "22"
+
+	customPayload := map[string]interface{}{
+		"update": map[string]interface{}{
+			"comment": []map[string]interface{}{
+				{
+					"add": map[string]string{
+						"body": "Hello World",
+					},
+				},
+			},
+		},
+		"transition": TransitionPayload{
+			ID: transitionID,
+		},
+	}
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		decoder := json.NewDecoder(r.Body)
+		payload := map[string]interface{}{}
+		err := decoder.Decode(&payload)
+		if err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+
+		contains := func(key string) bool {
+			_, ok := payload[key]
+			return ok
+		}
+
+		if !contains("update") || !contains("transition") {
+			t.Fatalf("Excpected update, transition to be in payload, got %s instead", payload)
+		}
+
+		transition, ok := payload["transition"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Excpected transition to be in payload, got %s instead", payload["transition"])
+		}
+
+		if transition["id"].(string) != transitionID {
+			t.Errorf("Expected %s to be in payload, got %s instead", transitionID, transition["id"])
+		}
+	})
+	_, err := testClient.Issue.DoTransitionWithPayload("123", customPayload)
+
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestIssueFields_TestMarshalJSON_PopulateUnknownsSuccess(t *testing.T) {
+	data := `{
 			"customfield_123":"test",
 			"description":"example bug report",
 			"project":{
@@ -821,561 +1468,1363 @@ func TestIssueFields_TestMarshalJSON_PopulateUnknownsSuccess(t *testing.T) {
 				}
 			]
 
-   }`
+   }`
+
+	i := new(IssueFields)
+	err := json.Unmarshal([]byte(data), i)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	if len(i.Unknowns) != 1 {
+		t.Errorf("Expected 1 unknown field to be present, received %d", len(i.Unknowns))
+	}
+	if i.Description != "example bug report" {
+		t.Errorf("Expected description to be \"%s\", received \"%s\"", "example bug report", i.Description)
+	}
+
+}
+
+func TestIssueFields_MarshalJSON_OmitsEmptyFields(t *testing.T) {
+	i := &IssueFields{
+		Description: "blahblah",
+		Type: IssueType{
+			Name: "Story",
+		},
+		Labels: []string{"aws-docker"},
+	}
+
+	rawdata, err := json.Marshal(i)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	// convert json to map and see if unset keys are there
+	issuef := tcontainer.NewMarshalMap()
+	err = json.Unmarshal(rawdata, &issuef)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	_, err = issuef.Int("issuetype/avatarId")
+	if err == nil {
+		t.Error("Expected non nil error, received nil")
+	}
+
+	// verify that the field that should be there, is.
+	name, err := issuef.String("issuetype/name")
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	if name != "Story" {
+		t.Errorf("Expected Story, received %s", name)
+	}
+
+}
+
+func TestIssueFields_MarshalJSON_Success(t *testing.T) {
+	i := &IssueFields{
+		Description: "example bug report",
+		Unknowns: tcontainer.MarshalMap{
+			"customfield_123": "test",
+		},
+		Project: Project{
+			Self: "http://www.example.com/jira/rest/api/2/project/EX",
+			ID:   "10000",
+			Key:  "EX",
+		},
+	}
+
+	bytes, err := json.Marshal(i)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	received := new(IssueFields)
+	// the order of json might be different. so unmarshal it again and compare objects
+	err = json.Unmarshal(bytes, received)
+	if err != nil {
+		t.Errorf("Expected nil err, received %s", err)
+	}
+
+	if !reflect.DeepEqual(i, received) {
+		t.Errorf("Received object different from expected. Expected %+v, received %+v", i, received)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_Success(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["summary"] = map[string]interface{}{
+		"name": "Summary",
+		"schema": map[string]interface{}{
+			"type": "string",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+	expectedSummary := "Issue Summary"
+	fieldConfig := map[string]string{
+		"Summary": "Issue Summary",
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	gotSummary, found := issue.Fields.Unknowns["summary"]
+	if !found {
+		t.Errorf("Expected summary to be set in issue. Not set.")
+	}
+
+	if gotSummary != expectedSummary {
+		t.Errorf("Expected %s received %s", expectedSummary, gotSummary)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_ArrayValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["component"] = map[string]interface{}{
+		"name": "Component/s",
+		"schema": map[string]interface{}{
+			"type":  "array",
+			"items": "component",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedComponent := "Jira automation"
+	fieldConfig := map[string]string{
+		"Component/s": expectedComponent,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	c, isArray := issue.Fields.Unknowns["component"].([]Component)
+	if isArray == false {
+		t.Error("Expected array, non array object received")
+	}
+
+	if len(c) != 1 {
+		t.Errorf("Expected received array to be of length 1. Got %d", len(c))
+	}
+
+	gotComponent := c[0].Name
+
+	if err != nil {
+		t.Errorf("Expected err to be nil, received %s", err)
+	}
+
+	if gotComponent != expectedComponent {
+		t.Errorf("Expected %s received %s", expectedComponent, gotComponent)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_DateValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["created"] = map[string]interface{}{
+		"name": "Created",
+		"schema": map[string]interface{}{
+			"type": "date",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedCreated := "19 oct 2012"
+	fieldConfig := map[string]string{
+		"Created": expectedCreated,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	gotCreated, err := issue.Fields.Unknowns.String("created")
+	if err != nil {
+		t.Errorf("Expected err to be nil, received %s", err)
+	}
+
+	if gotCreated != expectedCreated {
+		t.Errorf("Expected %s received %s", expectedCreated, gotCreated)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_UserValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["assignee"] = map[string]interface{}{
+		"name": "Assignee",
+		"schema": map[string]interface{}{
+			"type": "user",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedAssignee := "jdoe"
+	fieldConfig := map[string]string{
+		"Assignee": expectedAssignee,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	a, _ := issue.Fields.Unknowns.Value("assignee")
+	gotAssignee := a.(User).Name
+
+	if gotAssignee != expectedAssignee {
+		t.Errorf("Expected %s received %s", expectedAssignee, gotAssignee)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_ProjectValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["project"] = map[string]interface{}{
+		"name": "Project",
+		"schema": map[string]interface{}{
+			"type": "project",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	setProject := "somewhere"
+	fieldConfig := map[string]string{
+		"Project": setProject,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	a, _ := issue.Fields.Unknowns.Value("project")
+	gotProject := a.(Project).Name
+
+	if gotProject != metaProject.Name {
+		t.Errorf("Expected %s received %s", metaProject.Name, gotProject)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_PriorityValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["priority"] = map[string]interface{}{
+		"name": "Priority",
+		"schema": map[string]interface{}{
+			"type": "priority",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedPriority := "Normal"
+	fieldConfig := map[string]string{
+		"Priority": expectedPriority,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	a, _ := issue.Fields.Unknowns.Value("priority")
+	gotPriority := a.(Priority).Name
+
+	if gotPriority != expectedPriority {
+		t.Errorf("Expected %s received %s", expectedPriority, gotPriority)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_SelectList(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["someitem"] = map[string]interface{}{
+		"name": "A Select Item",
+		"schema": map[string]interface{}{
+			"type": "option",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedVal := "Value"
+	fieldConfig := map[string]string{
+		"A Select Item": expectedVal,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	a, _ := issue.Fields.Unknowns.Value("someitem")
+	gotVal := a.(Option).Value
+
+	if gotVal != expectedVal {
+		t.Errorf("Expected %s received %s", expectedVal, gotVal)
+	}
+}
+
+func TestInitIssueWithMetaAndFields_IssuetypeValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["issuetype"] = map[string]interface{}{
+		"name": "Issue type",
+		"schema": map[string]interface{}{
+			"type": "issuetype",
+		},
+	}
+
+	metaIssueType := MetaIssueType{
+		Fields: fields,
+	}
+
+	expectedIssuetype := "Bug"
+	fieldConfig := map[string]string{
+		"Issue type": expectedIssuetype,
+	}
+
+	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err != nil {
+		t.Errorf("Expected nil error, received %s", err)
+	}
+
+	a, _ := issue.Fields.Unknowns.Value("issuetype")
+	gotIssuetype := a.(IssueType).Name
+
+	if gotIssuetype != expectedIssuetype {
+		t.Errorf("Expected %s received %s", expectedIssuetype, gotIssuetype)
+	}
+}
+
+func TestInitIssueWithmetaAndFields_FailureWithUnknownValueType(t *testing.T) {
+	metaProject := MetaProject{
+		Name: "Engineering - Dept",
+		Id:   "ENG",
+	}
+
+	fields := tcontainer.NewMarshalMap()
+	fields["issuetype"] = map[string]interface{}{
+		"name": "Issue type",
+		"schema": map[string]interface{}{
+			"type": "randomType",
+		},
+	}
 
-	i := new(IssueFields)
-	err := json.Unmarshal([]byte(data), i)
-	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+	metaIssueType := MetaIssueType{
+		Fields: fields,
 	}
 
-	if len(i.Unknowns) != 1 {
-		t.Errorf("Expected 1 unknown field to be present, received %d", len(i.Unknowns))
+	fieldConfig := map[string]string{
+		"Issue tyoe": "sometype",
 	}
-	if i.Description != "example bug report" {
-		t.Errorf("Expected description to be \"%s\", received \"%s\"", "example bug report", i.Description)
+	_, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+	if err == nil {
+		t.Error("Expected non nil error, received nil")
 	}
 
 }
 
-func TestIssueFields_MarshalJSON_OmitsEmptyFields(t *testing.T) {
-	i := &IssueFields{
-		Description: "blahblah",
-		Type: IssueType{
-			Name: "Story",
-		},
-		Labels: []string{"aws-docker"},
-	}
+func TestIssueService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002?deleteSubtasks=true")
 
-	rawdata, err := json.Marshal(i)
+		w.WriteHeader(http.StatusNoContent)
+		fmt.Fprint(w, `{}`)
+	})
+
+	resp, err := testClient.Issue.Delete("10002")
+	if resp.StatusCode != 204 {
+		t.Error("Expected issue not deleted.")
+	}
 	if err != nil {
-		t.Errorf("Expected nil err, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	// convert json to map and see if unset keys are there
-	issuef := tcontainer.NewMarshalMap()
-	err = json.Unmarshal(rawdata, &issuef)
+func TestIssueService_AddWorklogRecordWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog?adjustEstimate=new&newEstimate=2d")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"timeSpent":"1h","timeSpentSeconds":3600,"id":"100028","issueId":"10002"}`)
+	})
+
+	record, _, err := testClient.Issue.AddWorklogRecordWithOptions("10000", &WorklogRecord{TimeSpent: "1h"}, &WorklogOptions{AdjustEstimate: AdjustEstimateNew, NewEstimate: "2d"})
 	if err != nil {
-		t.Errorf("Expected nil err, received %s", err)
+		t.Errorf("Error given: %s", err)
+	}
+	if record == nil || record.ID != "100028" {
+		t.Errorf("Expected worklog 100028. Got %+v", record)
 	}
+}
 
-	_, err = issuef.Int("issuetype/avatarId")
-	if err == nil {
-		t.Error("Expected non nil error, received nil")
+func TestIssueService_UpdateWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028")
+
+		fmt.Fprint(w, `{"timeSpent":"2h","timeSpentSeconds":7200,"id":"100028","issueId":"10002"}`)
+	})
+
+	record, _, err := testClient.Issue.UpdateWorklogRecord("10000", &WorklogRecord{ID: "100028", TimeSpent: "2h"}, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+	if record == nil || record.TimeSpentSeconds != 7200 {
+		t.Errorf("Expected 2h worklog. Got %+v", record)
+	}
+}
 
-	// verify that the field that should be there, is.
-	name, err := issuef.String("issuetype/name")
+func TestIssueService_DeleteWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10000/worklog/100028?adjustEstimate=manual&increaseBy=1h")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.DeleteWorklogRecord("10000", "100028", &WorklogOptions{AdjustEstimate: AdjustEstimateManual, IncreaseBy: "1h"})
 	if err != nil {
-		t.Errorf("Expected nil err, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	if name != "Story" {
-		t.Errorf("Expected Story, received %s", name)
+func TestIssueService_GetWorklogs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/worklog")
+
+		fmt.Fprint(w, `{"startAt": 1,"maxResults": 40,"total": 1,"worklogs": [{"id": "3","self": "http://kelpie9:8081/rest/api/2/issue/10002/worklog/3","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","comment":"","started":"2016-03-16T04:22:37.356+0000","timeSpent": "1h","timeSpentSeconds": 3600,"issueId":"10002"}]}`)
+	})
+
+	worklog, _, err := testClient.Issue.GetWorklogs("10002")
+	if worklog == nil {
+		t.Error("Expected worklog. Worklog is nil")
 	}
 
-}
+	if len(worklog.Worklogs) != 1 {
+		t.Error("Expected 1 worklog")
+	}
 
-func TestIssueFields_MarshalJSON_Success(t *testing.T) {
-	i := &IssueFields{
-		Description: "example bug report",
-		Unknowns: tcontainer.MarshalMap{
-			"customfield_123": "test",
-		},
-		Project: Project{
-			Self: "http://www.example.com/jira/rest/api/2/project/EX",
-			ID:   "10000",
-			Key:  "EX",
-		},
+	if worklog.Worklogs[0].Author.Name != "fred" {
+		t.Error("Expected worklog author to be fred")
 	}
 
-	bytes, err := json.Marshal(i)
 	if err != nil {
-		t.Errorf("Expected nil err, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	received := new(IssueFields)
-	// the order of json might be different. so unmarshal it again and compare objects
-	err = json.Unmarshal(bytes, received)
+func TestIssueService_GetWatchers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]}`)
+	})
+
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user?username=fred")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred",
+        "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
+        "24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred",
+        "32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":true,"timeZone":"Australia/Sydney","groups":{"size":3,"items":[
+        {"name":"jira-user","self":"http://www.example.com/jira/rest/api/2/group?groupname=jira-user"},{"name":"jira-admin",
+        "self":"http://www.example.com/jira/rest/api/2/group?groupname=jira-admin"},{"name":"important","self":"http://www.example.com/jira/rest/api/2/group?groupname=important"
+        }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}`)
+	})
+
+	watchers, _, err := testClient.Issue.GetWatchers("10002")
 	if err != nil {
-		t.Errorf("Expected nil err, received %s", err)
+		t.Errorf("Error given: %s", err)
+		return
 	}
-
-	if !reflect.DeepEqual(i, received) {
-		t.Errorf("Received object different from expected. Expected %+v, received %+v", i, received)
+	if watchers == nil {
+		t.Error("Expected watchers. Watchers is nil")
+		return
+	}
+	if len(*watchers) != 1 {
+		t.Errorf("Expected 1 watcher, got: %d", len(*watchers))
+		return
+	}
+	if (*watchers)[0].Name != "fred" {
+		t.Error("Expected watcher name fred")
 	}
 }
 
-func TestInitIssueWithMetaAndFields_Success(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_UpdateAssignee(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/assignee")
 
-	fields := tcontainer.NewMarshalMap()
-	fields["summary"] = map[string]interface{}{
-		"name": "Summary",
-		"schema": map[string]interface{}{
-			"type": "string",
-		},
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := testClient.Issue.UpdateAssignee("10002", &User{
+		Name: "test-username",
+	})
+
+	if resp.StatusCode != 204 {
+		t.Error("Expected issue not re-assigned.")
+	}
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+func TestIssueService_Rank(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/agile/1.0/issue/rank")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"issues":["PR-1","PR-2"],"rankBeforeIssue":"PR-3"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	entries, _, err := testClient.Issue.Rank(&IssueRankOptions{Issues: []string{"PR-1", "PR-2"}, RankBeforeIssue: "PR-3"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-	expectedSummary := "Issue Summary"
-	fieldConfig := map[string]string{
-		"Summary": "Issue Summary",
+	if entries != nil {
+		t.Errorf("Expected no entries if all issues were ranked. Got %+v", entries)
 	}
+}
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+func TestIssueService_Rank_PartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"entries":[{"issueId":10000,"issueKey":"PR-1","status":200},{"issueId":10001,"issueKey":"PR-2","status":400,"errors":["Issue is not on the board"]}]}`)
+	})
+
+	entries, _, err := testClient.Issue.Rank(&IssueRankOptions{Issues: []string{"PR-1", "PR-2"}, RankAfterIssue: "PR-3"})
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
+	if len(entries) != 2 || entries[1].Status != 400 || len(entries[1].Errors) != 1 {
+		t.Errorf("Expected the failure of PR-2. Got %+v", entries)
+	}
+}
 
-	gotSummary, found := issue.Fields.Unknowns["summary"]
-	if !found {
-		t.Errorf("Expected summary to be set in issue. Not set.")
+func TestIssueService_Notify(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/notify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/notify")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"subject":"Escalation","textBody":"Please have a look","to":{"assignee":true,"watchers":true,` +
+			`"users":[{"accountId":"5b10a2844c20165700ede21g"}],"groups":[{"name":"oncall"}]},` +
+			`"restrict":{"permissions":[{"key":"BROWSE"}]}}` + "\n"
+		if string(body) != expected {
+			t.Errorf("Expected body %s. Got %s", expected, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	notification := &IssueNotification{
+		Subject:  "Escalation",
+		TextBody: "Please have a look",
+		To: &NotificationRecipients{
+			Assignee: true,
+			Watchers: true,
+			Users:    []NotificationUser{{AccountID: "5b10a2844c20165700ede21g"}},
+			Groups:   []UserGroup{{Name: "oncall"}},
+		},
+		Restrict: &NotificationRestriction{Permissions: []Permission{{Key: "BROWSE"}}},
+	}
+	if _, err := testClient.Issue.Notify("10002", notification); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	if gotSummary != expectedSummary {
-		t.Errorf("Expected %s received %s", expectedSummary, gotSummary)
+func TestIssueService_Assign(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	var body string
+	testMux.HandleFunc("/rest/api/3/issue/10002/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/issue/10002/assignee")
+
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		assign   func() (*Response, error)
+		expected string
+	}{
+		{func() (*Response, error) { return testClient.Issue.Assign("10002", "5b10a2844c20165700ede21g") }, `{"accountId":"5b10a2844c20165700ede21g"}`},
+		{func() (*Response, error) { return testClient.Issue.AssignAutomatically("10002") }, `{"accountId":"-1"}`},
+		{func() (*Response, error) { return testClient.Issue.Unassign("10002") }, `{"accountId":null}`},
+	}
+	for _, test := range tests {
+		if _, err := test.assign(); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if body != test.expected+"\n" {
+			t.Errorf("Expected body %s. Got %s", test.expected, body)
+		}
+	}
+}
+
+func TestIssueService_Assign_Server(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testMux.HandleFunc("/rest/api/2/issue/10002/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"fred"}`+"\n" {
+			t.Errorf("Expected the username as body. Got %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.Assign("10002", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_ArrayValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
 
-	fields := tcontainer.NewMarshalMap()
-	fields["component"] = map[string]interface{}{
-		"name": "Component/s",
-		"schema": map[string]interface{}{
-			"type":  "array",
-			"items": "component",
-		},
-	}
+func TestIssueService_Get_Fields_Changelog(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002")
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
-	}
+		fmt.Fprint(w, `{"expand":"changelog","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","changelog":{"startAt": 0,"maxResults": 1, "total": 1, "histories": [{"id": "10002", "author": {"self": "http://www.example.com/jira/rest/api/2/user?username=fred", "name": "fred", "key": "fred", "emailAddress": "fred@example.com", "avatarUrls": {"48x48": "http://www.example.com/secure/useravatar?ownerId=fred&avatarId=33072", "24x24": "http://www.example.com/secure/useravatar?size=small&ownerId=fred&avatarId=33072", "16x16": "http://www.example.com/secure/useravatar?size=xsmall&ownerId=fred&avatarId=33072", "32x32": "http://www.example.com/secure/useravatar?size=medium&ownerId=fred&avatarId=33072"},"displayName":"Fred","active": true,"timeZone":"Australia/Sydney"},"created":"2018-06-20T16:50:35.000+0300","items":[{"field":"Rank","fieldtype":"custom","from":"","fromString":"","to":"","toString":"Ranked higher"}]}]}}`)
+	})
 
-	expectedComponent := "Jira automation"
-	fieldConfig := map[string]string{
-		"Component/s": expectedComponent,
+	issue, _, _ := testClient.Issue.Get("10002", &GetQueryOptions{Expand:"changelog"})
+	if issue == nil {
+		t.Error("Expected issue. Issue is nil")
 	}
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
-	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+	if len(issue.Changelog.Histories) != 1 {
+		t.Errorf("Expected one history item, %v found", len(issue.Changelog.Histories))
 	}
 
-	c, isArray := issue.Fields.Unknowns["component"].([]Component)
-	if isArray == false {
-		t.Error("Expected array, non array object received")
+	if issue.Changelog.Histories[0].Created != "2018-06-20T16:50:35.000+0300" {
+		t.Errorf("Expected created time of history item 2018-06-20T16:50:35.000+0300, %v got", issue.Changelog.Histories[0].Created)
 	}
 
-	if len(c) != 1 {
-		t.Errorf("Expected received array to be of length 1. Got %d", len(c))
+	tm, _ := time.Parse("2006-01-02T15:04:05.999-0700", "2018-06-20T16:50:35.000+0300")
+
+	if ct, _ := issue.Changelog.Histories[0].CreatedTime(); !tm.Equal(ct) {
+		t.Errorf("Expected CreatedTime func return %v time, %v got", tm, ct)
 	}
+}
 
-	gotComponent := c[0].Name
+func TestIssueService_GetWatches(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","active":true}]}`)
+	})
 
+	watches, _, err := testClient.Issue.GetWatches("10002")
 	if err != nil {
-		t.Errorf("Expected err to be nil, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
-
-	if gotComponent != expectedComponent {
-		t.Errorf("Expected %s received %s", expectedComponent, gotComponent)
+	if watches == nil || watches.WatchCount != 1 || watches.Watchers[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected one watcher with account id. Got %+v", watches)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_DateValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_AddWatcherByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
 
-	fields := tcontainer.NewMarshalMap()
-	fields["created"] = map[string]interface{}{
-		"name": "Created",
-		"schema": map[string]interface{}{
-			"type": "date",
-		},
-	}
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `"5b10a2844c20165700ede21g"`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if _, err := testClient.Issue.AddWatcherByAccountID("10002", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	expectedCreated := "19 oct 2012"
-	fieldConfig := map[string]string{
-		"Created": expectedCreated,
-	}
+func TestIssueService_RemoveWatcher(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers?username=fred")
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
-	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
-	}
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	gotCreated, err := issue.Fields.Unknowns.String("created")
-	if err != nil {
-		t.Errorf("Expected err to be nil, received %s", err)
+	if _, err := testClient.Issue.RemoveWatcher("10002", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	if gotCreated != expectedCreated {
-		t.Errorf("Expected %s received %s", expectedCreated, gotCreated)
+func TestIssueService_RemoveWatcher_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/3/issue/10002/watchers?accountId=5b10a2844c20165700ede21g")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.RemoveWatcher("10002", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_UserValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_RemoveWatcherByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers?accountId=5b10a2844c20165700ede21g")
 
-	fields := tcontainer.NewMarshalMap()
-	fields["assignee"] = map[string]interface{}{
-		"name": "Assignee",
-		"schema": map[string]interface{}{
-			"type": "user",
-		},
-	}
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if _, err := testClient.Issue.RemoveWatcherByAccountID("10002", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	expectedAssignee := "jdoe"
-	fieldConfig := map[string]string{
-		"Assignee": expectedAssignee,
-	}
+func TestIssueService_GetVotes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/votes","votes":2,"hasVoted":true,"voters":[{"name":"fred"},{"name":"mia"}]}`)
+	})
+
+	votes, _, err := testClient.Issue.GetVotes("10002")
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
+	}
+	if votes == nil || votes.Votes != 2 || !votes.HasVoted || len(votes.Voters) != 2 {
+		t.Errorf("Expected 2 votes including ours. Got %+v", votes)
 	}
+}
 
-	a, _ := issue.Fields.Unknowns.Value("assignee")
-	gotAssignee := a.(User).Name
+func TestIssueService_Vote(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
 
-	if gotAssignee != expectedAssignee {
-		t.Errorf("Expected %s received %s", expectedAssignee, gotAssignee)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.Vote("10002"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_ProjectValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_Unvote(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002/votes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/10002/votes")
 
-	fields := tcontainer.NewMarshalMap()
-	fields["project"] = map[string]interface{}{
-		"name": "Project",
-		"schema": map[string]interface{}{
-			"type": "project",
-		},
-	}
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if _, err := testClient.Issue.Unvote("10002"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	setProject := "somewhere"
-	fieldConfig := map[string]string{
-		"Project": setProject,
-	}
+func TestIssueService_GetRemoteLinks(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/remotelink")
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+		fmt.Fprint(w, `[{"id":10000,"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/remotelink/10000","globalId":"system=http://www.mycompany.com/support&id=1",
+			"application":{"type":"com.acme.tracker","name":"My Acme Tracker"},"relationship":"causes",
+			"object":{"url":"http://www.mycompany.com/support?id=1","title":"TSTSUP-111","summary":"Crazy customer support issue",
+			"icon":{"url16x16":"http://www.mycompany.com/support/ticket.png","title":"Support Ticket"},"status":{"resolved":true}}}]`)
+	})
+
+	remoteLinks, _, err := testClient.Issue.GetRemoteLinks("EX-1")
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
+	}
+	if len(remoteLinks) != 1 {
+		t.Fatalf("Expected 1 remote link. Got %d", len(remoteLinks))
 	}
+	if link := remoteLinks[0]; link.ID != 10000 || link.Application.Name != "My Acme Tracker" || !link.Object.Status.Resolved {
+		t.Errorf("Unexpected remote link: %+v", link)
+	}
+}
 
-	a, _ := issue.Fields.Unknowns.Value("project")
-	gotProject := a.(Project).Name
+func TestIssueService_GetRemoteLinkByGlobalID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if globalID := r.URL.Query().Get("globalId"); globalID != "system=ci&id=42" {
+			t.Errorf("Expected globalId system=ci&id=42. Got %s", globalID)
+		}
 
-	if gotProject != metaProject.Name {
-		t.Errorf("Expected %s received %s", metaProject.Name, gotProject)
+		fmt.Fprint(w, `{"id":10001,"globalId":"system=ci&id=42","object":{"url":"https://ci.example.com/build/42","title":"Build 42"}}`)
+	})
+
+	remoteLink, _, err := testClient.Issue.GetRemoteLinkByGlobalID("EX-1", "system=ci&id=42")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if remoteLink == nil || remoteLink.ID != 10001 {
+		t.Errorf("Expected remote link 10001. Got %+v", remoteLink)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_PriorityValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_AddRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/remotelink")
 
-	fields := tcontainer.NewMarshalMap()
-	fields["priority"] = map[string]interface{}{
-		"name": "Priority",
-		"schema": map[string]interface{}{
-			"type": "priority",
-		},
-	}
+		remoteLink := new(RemoteLink)
+		json.NewDecoder(r.Body).Decode(remoteLink)
+		if remoteLink.GlobalID != "system=ci&id=42" || remoteLink.Object.URL != "https://ci.example.com/build/42" {
+			t.Errorf("Unexpected remote link: %+v", remoteLink)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10001,"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/remotelink/10001"}`)
+	})
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	remoteLink, _, err := testClient.Issue.AddRemoteLink("EX-1", &RemoteLink{
+		GlobalID: "system=ci&id=42",
+		Object:   &RemoteLinkObject{URL: "https://ci.example.com/build/42", Title: "Build 42"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-
-	expectedPriority := "Normal"
-	fieldConfig := map[string]string{
-		"Priority": expectedPriority,
+	if remoteLink == nil || remoteLink.ID != 10001 {
+		t.Errorf("Expected remote link 10001. Got %+v", remoteLink)
 	}
+}
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+func TestIssueService_UpdateRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/remotelink/10001")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.UpdateRemoteLink("EX-1", 10001, &RemoteLink{
+		Object: &RemoteLinkObject{URL: "https://ci.example.com/build/42", Title: "Build 42", Status: &RemoteLinkStatus{Resolved: true}},
+	})
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	a, _ := issue.Fields.Unknowns.Value("priority")
-	gotPriority := a.(Priority).Name
+func TestIssueService_DeleteRemoteLink(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/remotelink/10001")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	if gotPriority != expectedPriority {
-		t.Errorf("Expected %s received %s", expectedPriority, gotPriority)
+	if _, err := testClient.Issue.DeleteRemoteLink("EX-1", 10001); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_SelectList(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_DeleteRemoteLinkByGlobalID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if globalID := r.URL.Query().Get("globalId"); globalID != "system=ci&id=42" {
+			t.Errorf("Expected globalId system=ci&id=42. Got %s", globalID)
+		}
 
-	fields := tcontainer.NewMarshalMap()
-	fields["someitem"] = map[string]interface{}{
-		"name": "A Select Item",
-		"schema": map[string]interface{}{
-			"type": "option",
-		},
-	}
+		w.WriteHeader(http.StatusNoContent)
+	})
 
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if _, err := testClient.Issue.DeleteRemoteLinkByGlobalID("EX-1", "system=ci&id=42"); err != nil {
+		t.Errorf("Error given: %s", err)
 	}
+}
 
-	expectedVal := "Value"
-	fieldConfig := map[string]string{
-		"A Select Item": expectedVal,
-	}
+func TestIssueService_GetChangelogPage(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/changelog?maxResults=1&startAt=1")
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10002","author":{"name":"fred"},"created":"2018-06-20T16:50:35.000+0300",
+			"items":[{"field":"status","fieldtype":"jira","from":"1","fromString":"Open","to":"3","toString":"In Progress"}]}]}`)
+	})
+
+	histories, resp, err := testClient.Issue.GetChangelogPage("EX-1", &ChangelogListOptions{StartAt: 1, MaxResults: 1})
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
-
-	a, _ := issue.Fields.Unknowns.Value("someitem")
-	gotVal := a.(Option).Value
-
-	if gotVal != expectedVal {
-		t.Errorf("Expected %s received %s", expectedVal, gotVal)
+	if len(histories) != 1 || histories[0].Items[0].ToString != "In Progress" {
+		t.Errorf("Expected one status change. Got %+v", histories)
+	}
+	if resp.StartAt != 1 || resp.Total != 2 {
+		t.Errorf("Expected page starting at 1 of 2. Got %d of %d", resp.StartAt, resp.Total)
 	}
 }
 
-func TestInitIssueWithMetaAndFields_IssuetypeValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_GetChangelog(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"values":[{"id":"10001"},{"id":"10002"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"values":[{"id":"10003"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
 
-	fields := tcontainer.NewMarshalMap()
-	fields["issuetype"] = map[string]interface{}{
-		"name": "Issue type",
-		"schema": map[string]interface{}{
-			"type": "issuetype",
-		},
+	histories, err := testClient.Issue.GetChangelog("EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if len(histories) != 3 || histories[2].Id != "10003" {
+		t.Errorf("Expected 3 history entries. Got %+v", histories)
 	}
+}
 
-	expectedIssuetype := "Bug"
-	fieldConfig := map[string]string{
-		"Issue type": expectedIssuetype,
-	}
+func TestIssueService_GetChangelog_Expand(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?expand=changelog")
 
-	issue, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+		fmt.Fprint(w, `{"key":"EX-1","changelog":{"startAt":0,"maxResults":1,"total":1,"histories":[{"id":"10001","items":[{"field":"summary"}]}]}}`)
+	})
+
+	histories, err := testClient.Issue.GetChangelog("EX-1")
 	if err != nil {
-		t.Errorf("Expected nil error, received %s", err)
+		t.Errorf("Error given: %s", err)
 	}
-
-	a, _ := issue.Fields.Unknowns.Value("issuetype")
-	gotIssuetype := a.(IssueType).Name
-
-	if gotIssuetype != expectedIssuetype {
-		t.Errorf("Expected %s received %s", expectedIssuetype, gotIssuetype)
+	if len(histories) != 1 || histories[0].Items[0].Field != "summary" {
+		t.Errorf("Expected one summary change. Got %+v", histories)
 	}
 }
 
-func TestInitIssueWithmetaAndFields_FailureWithUnknownValueType(t *testing.T) {
-	metaProject := MetaProject{
-		Name: "Engineering - Dept",
-		Id:   "ENG",
-	}
+func TestIssueService_BulkCreate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/issue/bulk")
+
+		payload := new(struct {
+			IssueUpdates []*Issue `json:"issueUpdates"`
+		})
+		json.NewDecoder(r.Body).Decode(payload)
+		if len(payload.IssueUpdates) != 2 || payload.IssueUpdates[0].Fields.Summary != "First" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issues":[{"id":"10000","key":"EX-1","self":"http://www.example.com/jira/rest/api/2/issue/10000"}],
+			"errors":[{"status":400,"elementErrors":{"errors":{"summary":"You must specify a summary of the issue."}},"failedElementNumber":1}]}`)
+	})
 
-	fields := tcontainer.NewMarshalMap()
-	fields["issuetype"] = map[string]interface{}{
-		"name": "Issue type",
-		"schema": map[string]interface{}{
-			"type": "randomType",
-		},
+	result, _, err := testClient.Issue.BulkCreate([]*Issue{
+		{Fields: &IssueFields{Summary: "First"}},
+		{Fields: &IssueFields{}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-
-	metaIssueType := MetaIssueType{
-		Fields: fields,
+	if result == nil || len(result.Issues) != 1 || result.Issues[0].Key != "EX-1" {
+		t.Fatalf("Expected issue EX-1 to be created. Got %+v", result)
 	}
-
-	fieldConfig := map[string]string{
-		"Issue tyoe": "sometype",
+	if len(result.Errors) != 1 || result.Errors[0].FailedElementNumber != 1 || result.Errors[0].ElementErrors.Errors["summary"] == "" {
+		t.Errorf("Expected the second issue to fail. Got %+v", result.Errors)
 	}
-	_, err := InitIssueWithMetaAndFields(&metaProject, &metaIssueType, fieldConfig)
+}
+
+func TestIssueService_BulkCreate_AllFailed(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"issues":[],"errors":[{"status":400,"elementErrors":{"errors":{"project":"project is required"}},"failedElementNumber":0}]}`)
+	})
+
+	result, resp, err := testClient.Issue.BulkCreate([]*Issue{{Fields: &IssueFields{Summary: "First"}}})
 	if err == nil {
-		t.Error("Expected non nil error, received nil")
+		t.Error("Expected an error. Got none")
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400. Got %d", resp.StatusCode)
+	}
+	if result == nil || len(result.Errors) != 1 || result.Errors[0].ElementErrors.Errors["project"] == "" {
+		t.Errorf("Expected the error of the issue. Got %+v", result)
 	}
-
 }
 
-func TestIssueService_Delete(t *testing.T) {
+func TestIssueService_BulkCreateAll(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "DELETE")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		requests++
 
-		w.WriteHeader(http.StatusNoContent)
-		fmt.Fprint(w, `{}`)
+		payload := new(issueBulkCreateRequest)
+		json.NewDecoder(r.Body).Decode(payload)
+		if len(payload.IssueUpdates) > 50 {
+			t.Errorf("Expected at most 50 issues per request, %d given", len(payload.IssueUpdates))
+		}
+		issues := []string{}
+		for i := range payload.IssueUpdates {
+			issues = append(issues, fmt.Sprintf(`{"key":"EX-%d-%d"}`, requests, i))
+		}
+		w.WriteHeader(http.StatusCreated)
+		if requests == 2 {
+			fmt.Fprintf(w, `{"issues":[%s],"errors":[{"status":400,"failedElementNumber":3}]}`, strings.Join(issues[1:], ","))
+			return
+		}
+		fmt.Fprintf(w, `{"issues":[%s],"errors":[]}`, strings.Join(issues, ","))
 	})
 
-	resp, err := testClient.Issue.Delete("10002")
-	if resp.StatusCode != 204 {
-		t.Error("Expected issue not deleted.")
+	issues := make([]*Issue, 120)
+	for i := range issues {
+		issues[i] = &Issue{Fields: &IssueFields{Summary: fmt.Sprintf("Issue %d", i)}}
 	}
+	result, err := testClient.Issue.BulkCreateAll(issues)
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, %d sent", requests)
+	}
+	if len(result.Issues) != 119 {
+		t.Errorf("Expected 119 created issues. Got %d", len(result.Issues))
+	}
+	if len(result.Errors) != 1 || result.Errors[0].FailedElementNumber != 53 {
+		t.Errorf("Expected the issue at index 53 to fail. Got %+v", result.Errors)
+	}
 }
 
-func TestIssueService_GetWorklogs(t *testing.T) {
+func TestIssueService_GetPickerSuggestions(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002/worklog", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/issue/picker", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002/worklog")
-
-		fmt.Fprint(w, `{"startAt": 1,"maxResults": 40,"total": 1,"worklogs": [{"id": "3","self": "http://kelpie9:8081/rest/api/2/issue/10002/worklog/3","author":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"updateAuthor":{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false},"created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000","comment":"","started":"2016-03-16T04:22:37.356+0000","timeSpent": "1h","timeSpentSeconds": 3600,"issueId":"10002"}]}`)
+		testRequestURL(t, r, "/rest/api/2/issue/picker?currentIssueKey=EX-1&currentJQL=project%3DEX&query=login")
+		fmt.Fprint(w, `{"sections":[
+			{"label":"History Search","sub":"Showing 2 of 2","id":"hs","issues":[
+				{"key":"EX-2","keyHtml":"EX-2","summary":"Fix <b>login</b>","summaryText":"Fix login","id":10001},
+				{"key":"EX-3","keyHtml":"EX-3","summary":"<b>Login</b> page","summaryText":"Login page","id":10002}]},
+			{"label":"Current Search","sub":"Showing 1 of 1","id":"cs","issues":[
+				{"key":"EX-3","keyHtml":"EX-3","summary":"<b>Login</b> page","summaryText":"Login page","id":10002}]}]}`)
 	})
 
-	worklog, _, err := testClient.Issue.GetWorklogs("10002")
-	if worklog == nil {
-		t.Error("Expected worklog. Worklog is nil")
-	}
-
-	if len(worklog.Worklogs) != 1 {
-		t.Error("Expected 1 worklog")
+	result, _, err := testClient.Issue.GetPickerSuggestions(&IssuePickerOptions{
+		Query:           "login",
+		CurrentJQL:      "project=EX",
+		CurrentIssueKey: "EX-1",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
 	}
-
-	if worklog.Worklogs[0].Author.Name != "fred" {
-		t.Error("Expected worklog author to be fred")
+	if result == nil || len(result.Sections) != 2 || result.Sections[0].ID != "hs" {
+		t.Fatalf("Expected 2 sections. Got %+v", result)
 	}
 
-	if err != nil {
-		t.Errorf("Error given: %s", err)
+	suggestions := result.Suggestions()
+	if len(suggestions) != 2 || suggestions[0].Key != "EX-2" || suggestions[1].SummaryText != "Login page" {
+		t.Errorf("Expected the suggestions EX-2 and EX-3. Got %+v", suggestions)
 	}
 }
 
-func TestIssueService_GetWatchers(t *testing.T) {
+func TestIssueService_SearchStream(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002/watchers", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002/watchers")
-
-		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":false,"watchCount":1,"watchers":[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","name":"fred","displayName":"Fred F. User","active":false}]}`)
+		testRequestURL(t, r, "/rest/api/2/search?jql=type+%3D+Bug&startAt=0&maxResults=3")
+		fmt.Fprint(w, `{"expand":"schema,names","startAt":0,"maxResults":3,"total":7,"names":{"summary":"Summary"},
+			"issues":[{"key":"EX-1","fields":{"summary":"first"}},{"key":"EX-2"},{"key":"EX-3"}]}`)
 	})
 
-	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/user?username=fred")
-
-		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred",
-        "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
-        "24x24":"http://www.example.com/jira/secure/useravatar?size=small&ownerId=fred","16x16":"http://www.example.com/jira/secure/useravatar?size=xsmall&ownerId=fred",
-        "32x32":"http://www.example.com/jira/secure/useravatar?size=medium&ownerId=fred"},"displayName":"Fred F. User","active":true,"timeZone":"Australia/Sydney","groups":{"size":3,"items":[
-        {"name":"jira-user","self":"http://www.example.com/jira/rest/api/2/group?groupname=jira-user"},{"name":"jira-admin",
-        "self":"http://www.example.com/jira/rest/api/2/group?groupname=jira-admin"},{"name":"important","self":"http://www.example.com/jira/rest/api/2/group?groupname=important"
-        }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}`)
+	var keys []string
+	count, resp, err := testClient.Issue.SearchStream("type = Bug", &SearchOptions{MaxResults: 3}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
 	})
-
-	watchers, _, err := testClient.Issue.GetWatchers("10002")
 	if err != nil {
 		t.Errorf("Error given: %s", err)
-		return
 	}
-	if watchers == nil {
-		t.Error("Expected watchers. Watchers is nil")
-		return
+	if count != 3 || fmt.Sprint(keys) != "[EX-1 EX-2 EX-3]" {
+		t.Errorf("Expected the issues EX-1, EX-2 and EX-3. Got %d %v", count, keys)
 	}
-	if len(*watchers) != 1 {
-		t.Errorf("Expected 1 watcher, got: %d", len(*watchers))
-		return
+	if resp.Total != 7 || resp.MaxResults != 3 {
+		t.Errorf("Expected the paging of the page. Got total %d, maxResults %d", resp.Total, resp.MaxResults)
 	}
-	if (*watchers)[0].Name != "fred" {
-		t.Error("Expected watcher name fred")
+
+	stop := errors.New("stop")
+	count, _, err = testClient.Issue.SearchStream("type = Bug", &SearchOptions{MaxResults: 3}, func(issue Issue) error {
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Errorf("Expected the stream to stop at the first issue. Got %d, %v", count, err)
 	}
 }
 
-func TestIssueService_UpdateAssignee(t *testing.T) {
+func TestIssueService_GetMany(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002/assignee", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "PUT")
-		testRequestURL(t, r, "/rest/api/2/issue/10002/assignee")
-
-		w.WriteHeader(http.StatusNoContent)
-	})
-
-	resp, err := testClient.Issue.UpdateAssignee("10002", &User{
-		Name: "test-username",
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/search?jql=key+in+%28%22EX-1%22%2C%22EX-2%22%2C%22EX-3%22%29&startAt=0&maxResults=3&expand=&fields=summary,status&validateQuery=warn")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":3,"total":2,"issues":[{"key":"EX-1","fields":{"summary":"first"}},{"key":"EX-3","fields":{"summary":"third"}}],
+			"warningMessages":["An issue with key 'EX-2' does not exist for field 'key'."]}`)
 	})
 
-	if resp.StatusCode != 204 {
-		t.Error("Expected issue not re-assigned.")
-	}
+	issues, missing, err := testClient.Issue.GetMany([]string{"EX-1", "ex-2", "EX-3", "EX-1"}, "summary", "status")
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
+	if len(issues) != 2 || issues["EX-1"].Fields.Summary != "first" || issues["EX-3"].Fields.Summary != "third" {
+		t.Errorf("Expected the issues EX-1 and EX-3. Got %+v", issues)
+	}
+	if fmt.Sprint(missing) != "[EX-2]" {
+		t.Errorf("Expected EX-2 to be missing. Got %v", missing)
+	}
 }
 
-
-func TestIssueService_Get_Fields_Changelog(t *testing.T) {
+func TestIssueService_Sync(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/issue/10002")
-
-		fmt.Fprint(w, `{"expand":"changelog","id":"10002","self":"http://www.example.com/jira/rest/api/2/issue/10002","key":"EX-1","changelog":{"startAt": 0,"maxResults": 1, "total": 1, "histories": [{"id": "10002", "author": {"self": "http://www.example.com/jira/rest/api/2/user?username=fred", "name": "fred", "key": "fred", "emailAddress": "fred@example.com", "avatarUrls": {"48x48": "http://www.example.com/secure/useravatar?ownerId=fred&avatarId=33072", "24x24": "http://www.example.com/secure/useravatar?size=small&ownerId=fred&avatarId=33072", "16x16": "http://www.example.com/secure/useravatar?size=xsmall&ownerId=fred&avatarId=33072", "32x32": "http://www.example.com/secure/useravatar?size=medium&ownerId=fred&avatarId=33072"},"displayName":"Fred","active": true,"timeZone":"Australia/Sydney"},"created":"2018-06-20T16:50:35.000+0300","items":[{"field":"Rank","fieldtype":"custom","from":"","fromString":"","to":"","toString":"Ranked higher"}]}]}}`)
+		switch jql := r.URL.Query().Get("jql"); jql {
+		case `(project = EX) AND updated >= "2021/03/01 10:30" ORDER BY created ASC, key ASC`:
+			if fields := r.URL.Query().Get("fields"); fields != "summary,created,updated" {
+				t.Errorf("Expected the fields summary, created and updated. Got %s", fields)
+			}
+			fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[
+				{"key":"EX-1","fields":{"summary":"old","created":"2021-01-01T10:00:00.000+0000","updated":"2021-03-01T11:00:00.000+0000"}},
+				{"key":"EX-9","fields":{"summary":"new","created":"2021-03-01T12:00:00.000+0000","updated":"2021-03-01T12:00:00.000+0000"}}]}`)
+		case `key in ("EX-1","EX-2")`:
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":1,"issues":[{"key":"EX-1"}]}`)
+		default:
+			t.Errorf("Unexpected JQL %s", jql)
+		}
 	})
 
-	issue, _, _ := testClient.Issue.Get("10002", &GetQueryOptions{Expand:"changelog"})
-	if issue == nil {
-		t.Error("Expected issue. Issue is nil")
+	result, err := testClient.Issue.Sync(&IssueSyncOptions{
+		JQL:       "project = EX",
+		Since:     time.Date(2021, 3, 1, 10, 30, 45, 0, time.UTC),
+		KnownKeys: []string{"EX-1", "EX-2"},
+		Fields:    []string{"summary"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
 	}
+	if len(result.Created) != 1 || result.Created[0].Key != "EX-9" {
+		t.Errorf("Expected EX-9 to be created. Got %+v", result.Created)
+	}
+	if len(result.Updated) != 1 || result.Updated[0].Key != "EX-1" {
+		t.Errorf("Expected EX-1 to be updated. Got %+v", result.Updated)
+	}
+	if fmt.Sprint(result.Deleted) != "[EX-2]" {
+		t.Errorf("Expected EX-2 to be deleted. Got %v", result.Deleted)
+	}
+	if result.SyncedAt.IsZero() {
+		t.Error("Expected the time of the synchronization")
+	}
+}
 
-	if len(issue.Changelog.Histories) != 1 {
-		t.Errorf("Expected one history item, %v found", len(issue.Changelog.Histories))
+func TestIssueService_Sync_WithoutOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); jql != "ORDER BY created ASC, key ASC" {
+			t.Errorf("Unexpected JQL %s", jql)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"EX-1"}]}`)
+	})
+
+	result, err := testClient.Issue.Sync(nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
 	}
+	if len(result.Created) != 1 || result.Created[0].Key != "EX-1" {
+		t.Errorf("Expected EX-1 to be created. Got %+v", result.Created)
+	}
+}
 
-	if issue.Changelog.Histories[0].Created != "2018-06-20T16:50:35.000+0300" {
-		t.Errorf("Expected created time of history item 2018-06-20T16:50:35.000+0300, %v got", issue.Changelog.Histories[0].Created)
+func TestIssueService_Sync_UpdatedWhilePaging(t *testing.T) {
+	setup()
+	defer teardown()
+	type record struct {
+		key, created, updated string
 	}
+	records := []*record{
+		{"EX-1", "2021-03-01T10:00:00.000+0000", "2021-03-01T10:00:00.000+0000"},
+		{"EX-2", "2021-03-01T10:01:00.000+0000", "2021-03-01T10:01:00.000+0000"},
+		{"EX-3", "2021-03-01T10:02:00.000+0000", "2021-03-01T10:02:00.000+0000"},
+		{"EX-4", "2021-03-01T10:03:00.000+0000", "2021-03-01T10:03:00.000+0000"},
+	}
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		sorted := append([]*record(nil), records...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if strings.HasSuffix(jql, "ORDER BY updated ASC") {
+				return sorted[i].updated < sorted[j].updated
+			}
+			return sorted[i].created < sorted[j].created
+		})
 
-	tm, _ := time.Parse("2006-01-02T15:04:05.999-0700", "2018-06-20T16:50:35.000+0300")
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		var issues []string
+		for i := startAt; i < startAt+2 && i < len(sorted); i++ {
+			issues = append(issues, fmt.Sprintf(`{"key":%q,"fields":{"created":%q,"updated":%q}}`, sorted[i].key, sorted[i].created, sorted[i].updated))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":2,"total":%d,"issues":[%s]}`, startAt, len(sorted), strings.Join(issues, ","))
 
-	if ct, _ := issue.Changelog.Histories[0].CreatedTime(); !tm.Equal(ct) {
-		t.Errorf("Expected CreatedTime func return %v time, %v got", tm, ct)
+		// EX-1 is updated after the first page was read
+		records[0].updated = "2021-03-01T12:00:00.000+0000"
+	})
+
+	result, err := testClient.Issue.Sync(nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var keys []string
+	for _, issue := range result.Created {
+		keys = append(keys, issue.Key)
+	}
+	if fmt.Sprint(keys) != "[EX-1 EX-2 EX-3 EX-4]" {
+		t.Errorf("Expected all issues to be returned once. Got %v", keys)
 	}
 }
@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueLinkService handles links between issues for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLink
+type IssueLinkService struct {
+	client *Client
+}
+
+// CreateWithContext creates a link between the issues issueLink.InwardIssue and issueLink.OutwardIssue.
+// The link type is given by name, e.g. "Blocks", with issueLink.Type.Name.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLink-linkIssues
+func (s *IssueLinkService) CreateWithContext(ctx context.Context, issueLink *IssueLink) (*Response, error) {
+	apiEndpoint := "rest/api/2/issueLink"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, issueLink)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *IssueLinkService) Create(issueLink *IssueLink) (*Response, error) {
+	return s.CreateWithContext(context.Background(), issueLink)
+}
+
+// GetWithContext returns the issue link for a given link id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLink-getIssueLink
+func (s *IssueLinkService) GetWithContext(ctx context.Context, linkID string) (*IssueLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueLink := new(IssueLink)
+	resp, err := s.client.Do(req, issueLink)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return issueLink, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *IssueLinkService) Get(linkID string) (*IssueLink, *Response, error) {
+	return s.GetWithContext(context.Background(), linkID)
+}
+
+// DeleteWithContext deletes the issue link for a given link id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLink-deleteIssueLink
+func (s *IssueLinkService) DeleteWithContext(ctx context.Context, linkID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", linkID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *IssueLinkService) Delete(linkID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), linkID)
+}
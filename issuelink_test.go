@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueLinkService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLink"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		link := new(IssueLink)
+		json.NewDecoder(r.Body).Decode(link)
+		if link.Type.Name != "Blocks" || link.InwardIssue.Key != "EX-1" || link.OutwardIssue.Key != "EX-2" {
+			t.Errorf("Unexpected issue link: %+v", link)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.IssueLink.Create(&IssueLink{
+		Type:         IssueLinkType{Name: "Blocks"},
+		InwardIssue:  &Issue{Key: "EX-1"},
+		OutwardIssue: &Issue{Key: "EX-2"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueLinkService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLink/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10001","type":{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"},
+			"inwardIssue":{"id":"10004","key":"EX-1"},"outwardIssue":{"id":"10005","key":"EX-2"}}`)
+	})
+
+	link, _, err := testClient.IssueLink.Get("10001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if link == nil || link.Type.Name != "Blocks" || link.OutwardIssue.Key != "EX-2" {
+		t.Errorf("Expected Blocks link to EX-2. Got %+v", link)
+	}
+}
+
+func TestIssueLinkService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLink/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.IssueLink.Delete("10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueLinkTypeService handles the types of links between issues for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType
+type IssueLinkTypeService struct {
+	client *Client
+}
+
+// issueLinkTypeList is the list of issue link types returned by JIRA
+type issueLinkTypeList struct {
+	IssueLinkTypes []IssueLinkType `json:"issueLinkTypes" structs:"issueLinkTypes"`
+}
+
+// GetListWithContext returns all issue link types.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType-getIssueLinkTypes
+func (s *IssueLinkTypeService) GetListWithContext(ctx context.Context) ([]IssueLinkType, *Response, error) {
+	apiEndpoint := "rest/api/2/issueLinkType"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(issueLinkTypeList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return list.IssueLinkTypes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *IssueLinkTypeService) GetList() ([]IssueLinkType, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns the issue link type for a given id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType-getIssueLinkType
+func (s *IssueLinkTypeService) GetWithContext(ctx context.Context, linkTypeID string) (*IssueLinkType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	linkType := new(IssueLinkType)
+	resp, err := s.client.Do(req, linkType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return linkType, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *IssueLinkTypeService) Get(linkTypeID string) (*IssueLinkType, *Response, error) {
+	return s.GetWithContext(context.Background(), linkTypeID)
+}
+
+// CreateWithContext creates an issue link type, e.g. "Blocks" with the inward description "is blocked by".
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType-createIssueLinkType
+func (s *IssueLinkTypeService) CreateWithContext(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	apiEndpoint := "rest/api/2/issueLinkType"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, linkType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdLinkType := new(IssueLinkType)
+	resp, err := s.client.Do(req, createdLinkType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return createdLinkType, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *IssueLinkTypeService) Create(linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	return s.CreateWithContext(context.Background(), linkType)
+}
+
+// UpdateWithContext updates the issue link type for a given id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType-updateIssueLinkType
+func (s *IssueLinkTypeService) UpdateWithContext(ctx context.Context, linkTypeID string, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, linkType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedLinkType := new(IssueLinkType)
+	resp, err := s.client.Do(req, updatedLinkType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return updatedLinkType, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *IssueLinkTypeService) Update(linkTypeID string, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
+	return s.UpdateWithContext(context.Background(), linkTypeID, linkType)
+}
+
+// DeleteWithContext deletes the issue link type for a given id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issueLinkType-deleteIssueLinkType
+func (s *IssueLinkTypeService) DeleteWithContext(ctx context.Context, linkTypeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *IssueLinkTypeService) Delete(linkTypeID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), linkTypeID)
+}
@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueLinkTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLinkType"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"},
+			{"id":"10001","name":"Duplicate","inward":"is duplicated by","outward":"duplicates"}]}`)
+	})
+
+	linkTypes, _, err := testClient.IssueLinkType.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(linkTypes) != 2 || linkTypes[0].Inward != "is blocked by" {
+		t.Errorf("Expected 2 link types. Got %+v", linkTypes)
+	}
+}
+
+func TestIssueLinkTypeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLinkType/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Get("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType == nil || linkType.Name != "Blocks" {
+		t.Errorf("Expected link type Blocks. Got %+v", linkType)
+	}
+}
+
+func TestIssueLinkTypeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLinkType"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		linkType := new(IssueLinkType)
+		json.NewDecoder(r.Body).Decode(linkType)
+		if linkType.Name != "Depends" || linkType.Inward != "is depended on by" {
+			t.Errorf("Unexpected link type: %+v", linkType)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10002","name":"Depends","inward":"is depended on by","outward":"depends on"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Create(&IssueLinkType{Name: "Depends", Inward: "is depended on by", Outward: "depends on"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType == nil || linkType.ID != "10002" {
+		t.Errorf("Expected link type with id 10002. Got %+v", linkType)
+	}
+}
+
+func TestIssueLinkTypeService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLinkType/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10002","name":"Requires","inward":"is required by","outward":"requires"}`)
+	})
+
+	linkType, _, err := testClient.IssueLinkType.Update("10002", &IssueLinkType{Name: "Requires", Inward: "is required by", Outward: "requires"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if linkType == nil || linkType.Name != "Requires" {
+		t.Errorf("Expected link type Requires. Got %+v", linkType)
+	}
+}
+
+func TestIssueLinkTypeService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issueLinkType/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.IssueLinkType.Delete("10002"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
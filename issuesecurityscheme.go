@@ -0,0 +1,215 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueSecuritySchemeService handles issue security schemes and their security levels for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuesecurityschemes
+type IssueSecuritySchemeService struct {
+	client *Client
+}
+
+// IssueSecurityScheme represents an issue security scheme, the security levels which can be set on the issues of the projects using it
+type IssueSecurityScheme struct {
+	Self                   string          `json:"self,omitempty" structs:"self,omitempty"`
+	ID                     int             `json:"id,omitempty" structs:"id,omitempty"`
+	Name                   string          `json:"name,omitempty" structs:"name,omitempty"`
+	Description            string          `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultSecurityLevelID int             `json:"defaultSecurityLevelId,omitempty" structs:"defaultSecurityLevelId,omitempty"`
+	Levels                 []SecurityLevel `json:"levels,omitempty" structs:"levels,omitempty"`
+}
+
+// SecurityLevel represents an issue security level.
+// Only the members of a security level can see the issues it is set on.
+type SecurityLevel struct {
+	Self        string `json:"self,omitempty" structs:"self,omitempty"`
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// SecurityLevelMember represents a member of an issue security level, e.g. a group or a project role.
+// The Holder is described the same way as the holder of a permission grant.
+type SecurityLevelMember struct {
+	ID                   int               `json:"id,omitempty" structs:"id,omitempty"`
+	IssueSecurityLevelID int               `json:"issueSecurityLevelId,omitempty" structs:"issueSecurityLevelId,omitempty"`
+	Holder               *PermissionHolder `json:"holder,omitempty" structs:"holder,omitempty"`
+}
+
+// SecurityLevelMemberListOptions specifies the optional parameters to the IssueSecuritySchemeService.GetLevelMembers method
+type SecurityLevelMemberListOptions struct {
+	// IssueSecurityLevelIDs restricts the result to the members of the security levels with these ids
+	IssueSecurityLevelIDs []string `url:"issueSecurityLevelId,omitempty"`
+	// Expand is a comma separated list of additional information to include, e.g. "all"
+	Expand string `url:"expand,omitempty"`
+	// StartAt is the index of the first member to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of members to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// issueSecuritySchemeList is the list of issue security schemes returned by JIRA
+type issueSecuritySchemeList struct {
+	IssueSecuritySchemes []IssueSecurityScheme `json:"issueSecuritySchemes"`
+}
+
+// securityLevelList is the list of security levels returned by JIRA
+type securityLevelList struct {
+	Levels []SecurityLevel `json:"levels"`
+}
+
+// securityLevelMemberListResult is a page of security level members
+type securityLevelMemberListResult struct {
+	StartAt    int                   `json:"startAt"`
+	MaxResults int                   `json:"maxResults"`
+	Total      int                   `json:"total"`
+	IsLast     bool                  `json:"isLast"`
+	Members    []SecurityLevelMember `json:"values"`
+}
+
+// GetListWithContext gets all issue security schemes from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuesecurityschemes-getIssueSecuritySchemes
+func (s *IssueSecuritySchemeService) GetListWithContext(ctx context.Context) ([]IssueSecurityScheme, *Response, error) {
+	apiEndpoint := "rest/api/2/issuesecurityschemes"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(issueSecuritySchemeList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.IssueSecuritySchemes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *IssueSecuritySchemeService) GetList() ([]IssueSecurityScheme, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns the issue security scheme for a given issue security scheme id, including its levels.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuesecurityschemes-getIssueSecurityScheme
+func (s *IssueSecuritySchemeService) GetWithContext(ctx context.Context, schemeID int) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuesecurityschemes/%d", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *IssueSecuritySchemeService) Get(schemeID int) (*IssueSecurityScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID)
+}
+
+// GetForProjectWithContext returns the issue security scheme used by the project for a given project id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectKeyOrId}/issuesecuritylevelscheme-getIssueSecurityScheme
+func (s *IssueSecuritySchemeService) GetForProjectWithContext(ctx context.Context, projectIDOrKey string) (*IssueSecurityScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/issuesecuritylevelscheme", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetForProject wraps GetForProjectWithContext using the background context.
+func (s *IssueSecuritySchemeService) GetForProject(projectIDOrKey string) (*IssueSecurityScheme, *Response, error) {
+	return s.GetForProjectWithContext(context.Background(), projectIDOrKey)
+}
+
+// GetLevelWithContext returns the issue security level for a given security level id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/securitylevel-getIssuesecuritylevel
+func (s *IssueSecuritySchemeService) GetLevelWithContext(ctx context.Context, levelID string) (*SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/securitylevel/%s", levelID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(SecurityLevel)
+	resp, err := s.client.Do(req, level)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return level, resp, nil
+}
+
+// GetLevel wraps GetLevelWithContext using the background context.
+func (s *IssueSecuritySchemeService) GetLevel(levelID string) (*SecurityLevel, *Response, error) {
+	return s.GetLevelWithContext(context.Background(), levelID)
+}
+
+// GetProjectLevelsWithContext returns the security levels the current user can set on the issues of the project for a given project id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectKeyOrId}/securitylevel-getSecurityLevelsForProject
+func (s *IssueSecuritySchemeService) GetProjectLevelsWithContext(ctx context.Context, projectIDOrKey string) ([]SecurityLevel, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/securitylevel", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(securityLevelList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Levels, resp, nil
+}
+
+// GetProjectLevels wraps GetProjectLevelsWithContext using the background context.
+func (s *IssueSecuritySchemeService) GetProjectLevels(projectIDOrKey string) ([]SecurityLevel, *Response, error) {
+	return s.GetProjectLevelsWithContext(context.Background(), projectIDOrKey)
+}
+
+// GetLevelMembersWithContext returns a page of the members of the security levels of the issue security scheme for a given issue security scheme id.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// Security level members are only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-issuesecurityschemes-issueSecuritySchemeId-members-get
+func (s *IssueSecuritySchemeService) GetLevelMembersWithContext(ctx context.Context, schemeID int, options *SecurityLevelMemberListOptions) ([]SecurityLevelMember, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("%s/issuesecurityschemes/%d/members", restAPIBase, schemeID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(securityLevelMemberListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Members, resp, nil
+}
+
+// GetLevelMembers wraps GetLevelMembersWithContext using the background context.
+func (s *IssueSecuritySchemeService) GetLevelMembers(schemeID int, options *SecurityLevelMemberListOptions) ([]SecurityLevelMember, *Response, error) {
+	return s.GetLevelMembersWithContext(context.Background(), schemeID, options)
+}
@@ -0,0 +1,132 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueSecuritySchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"issueSecuritySchemes":[{"id":10000,"name":"Default Issue Security Scheme","defaultSecurityLevelId":10021}]}`)
+	})
+
+	schemes, _, err := testClient.IssueSecurityScheme.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || schemes[0].DefaultSecurityLevelID != 10021 {
+		t.Errorf("Expected 1 scheme. Got %+v", schemes)
+	}
+}
+
+func TestIssueSecuritySchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuesecurityschemes/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Default Issue Security Scheme","levels":[
+			{"id":"10021","name":"Confidential","description":"Only the incident team"}]}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.Get(10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || len(scheme.Levels) != 1 || scheme.Levels[0].Name != "Confidential" {
+		t.Errorf("Expected scheme with level Confidential. Got %+v", scheme)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetForProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/issuesecuritylevelscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Default Issue Security Scheme"}`)
+	})
+
+	scheme, _, err := testClient.IssueSecurityScheme.GetForProject("ABC")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 10000 {
+		t.Errorf("Expected scheme 10000. Got %+v", scheme)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevel(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/securitylevel/10021"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10021","name":"Confidential"}`)
+	})
+
+	level, _, err := testClient.IssueSecurityScheme.GetLevel("10021")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if level == nil || level.Name != "Confidential" {
+		t.Errorf("Expected level Confidential. Got %+v", level)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetProjectLevels(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/securitylevel"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"levels":[{"id":"10021","name":"Confidential"},{"id":"10022","name":"Public"}]}`)
+	})
+
+	levels, _, err := testClient.IssueSecurityScheme.GetProjectLevels("ABC")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(levels) != 2 || levels[1].ID != "10022" {
+		t.Errorf("Expected 2 levels. Got %+v", levels)
+	}
+}
+
+func TestIssueSecuritySchemeService_GetLevelMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issuesecurityschemes/10000/members"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?issueSecurityLevelId=10021")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[
+			{"id":10000,"issueSecurityLevelId":10021,"holder":{"type":"group","parameter":"incident-team"}}]}`)
+	})
+
+	members, resp, err := testClient.IssueSecurityScheme.GetLevelMembers(10000, &SecurityLevelMemberListOptions{IssueSecurityLevelIDs: []string{"10021"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(members) != 1 || members[0].Holder.Parameter != "incident-team" {
+		t.Errorf("Expected 1 member. Got %+v", members)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Expected total 1. Got %d", resp.Total)
+	}
+}
@@ -0,0 +1,295 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueTypeService handles issue types and issue type schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype
+type IssueTypeService struct {
+	client *Client
+}
+
+// These constants are the kinds of issue types which can be created
+const (
+	IssueTypeTypeStandard = "standard"
+	IssueTypeTypeSubtask  = "subtask"
+)
+
+// IssueTypeOptions are passed to the IssueTypeService.Create function to create a new issue type.
+// They are passed to IssueTypeService.Update as well, where only the fields which are set are changed.
+// Type is one of the IssueTypeType constants and can only be set on creation.
+type IssueTypeOptions struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Type        string `json:"type,omitempty" structs:"type,omitempty"`
+	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+}
+
+// IssueTypeScheme represents an issue type scheme, the issue types available in the projects using it.
+type IssueTypeScheme struct {
+	ID                 string `json:"id,omitempty" structs:"id,omitempty"`
+	Name               string `json:"name,omitempty" structs:"name,omitempty"`
+	Description        string `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultIssueTypeID string `json:"defaultIssueTypeId,omitempty" structs:"defaultIssueTypeId,omitempty"`
+	IsDefault          bool   `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+}
+
+// IssueTypeSchemeOptions are passed to the IssueTypeService.CreateScheme function to create a new issue type scheme.
+type IssueTypeSchemeOptions struct {
+	Name               string   `json:"name,omitempty" structs:"name,omitempty"`
+	Description        string   `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultIssueTypeID string   `json:"defaultIssueTypeId,omitempty" structs:"defaultIssueTypeId,omitempty"`
+	IssueTypeIDs       []string `json:"issueTypeIds,omitempty" structs:"issueTypeIds,omitempty"`
+}
+
+// IssueTypeSchemeListOptions specifies the optional parameters to the IssueTypeService.GetSchemes method
+type IssueTypeSchemeListOptions struct {
+	// IDs restricts the result to the issue type schemes with these ids
+	IDs []string `url:"id,omitempty"`
+	// StartAt is the index of the first issue type scheme to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of issue type schemes to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// issueTypeSchemeListResult is a page of issue type schemes
+type issueTypeSchemeListResult struct {
+	StartAt    int               `json:"startAt"`
+	MaxResults int               `json:"maxResults"`
+	Total      int               `json:"total"`
+	IsLast     bool              `json:"isLast"`
+	Schemes    []IssueTypeScheme `json:"values"`
+}
+
+// issueTypeSchemeCreateResult is returned by JIRA when an issue type scheme was created
+type issueTypeSchemeCreateResult struct {
+	IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+}
+
+// issueTypeSchemeProject assigns an issue type scheme to a project
+type issueTypeSchemeProject struct {
+	IssueTypeSchemeID string `json:"issueTypeSchemeId" structs:"issueTypeSchemeId"`
+	ProjectID         string `json:"projectId" structs:"projectId"`
+}
+
+// issueTypeIDList is a list of issue type ids sent to JIRA
+type issueTypeIDList struct {
+	IssueTypeIDs []string `json:"issueTypeIds" structs:"issueTypeIds"`
+}
+
+// GetListWithContext gets all issue types from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype-getIssueAllTypes
+func (s *IssueTypeService) GetListWithContext(ctx context.Context) ([]IssueType, *Response, error) {
+	apiEndpoint := "rest/api/2/issuetype"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueTypeList := []IssueType{}
+	resp, err := s.client.Do(req, &issueTypeList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueTypeList, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *IssueTypeService) GetList() ([]IssueType, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the issue type for a given issue type id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype-getIssueType
+func (s *IssueTypeService) GetWithContext(ctx context.Context, issueTypeID string) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(req, issueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueType, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *IssueTypeService) Get(issueTypeID string) (*IssueType, *Response, error) {
+	return s.GetWithContext(context.Background(), issueTypeID)
+}
+
+// CreateWithContext creates a new issue type
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype-createIssueType
+func (s *IssueTypeService) CreateWithContext(ctx context.Context, options *IssueTypeOptions) (*IssueType, *Response, error) {
+	apiEndpoint := "rest/api/2/issuetype"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(req, issueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueType, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *IssueTypeService) Create(options *IssueTypeOptions) (*IssueType, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext updates the issue type for a given issue type id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype-updateIssueType
+func (s *IssueTypeService) UpdateWithContext(ctx context.Context, issueTypeID string, options *IssueTypeOptions) (*IssueType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(IssueType)
+	resp, err := s.client.Do(req, issueType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issueType, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *IssueTypeService) Update(issueTypeID string, options *IssueTypeOptions) (*IssueType, *Response, error) {
+	return s.UpdateWithContext(context.Background(), issueTypeID, options)
+}
+
+// DeleteWithContext deletes the issue type for a given issue type id.
+// If alternativeIssueTypeID is not empty, the issues of the deleted type are moved to the issue type with that id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issuetype-deleteIssueType
+func (s *IssueTypeService) DeleteWithContext(ctx context.Context, issueTypeID, alternativeIssueTypeID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issuetype/%s", issueTypeID)
+	if alternativeIssueTypeID != "" {
+		apiEndpoint += "?alternativeIssueTypeId=" + alternativeIssueTypeID
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *IssueTypeService) Delete(issueTypeID, alternativeIssueTypeID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), issueTypeID, alternativeIssueTypeID)
+}
+
+// GetSchemesWithContext returns a page of the issue type schemes.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// Issue type schemes are only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-issuetypescheme-get
+func (s *IssueTypeService) GetSchemesWithContext(ctx context.Context, options *IssueTypeSchemeListOptions) ([]IssueTypeScheme, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/issuetypescheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(issueTypeSchemeListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Schemes, resp, nil
+}
+
+// GetSchemes wraps GetSchemesWithContext using the background context.
+func (s *IssueTypeService) GetSchemes(options *IssueTypeSchemeListOptions) ([]IssueTypeScheme, *Response, error) {
+	return s.GetSchemesWithContext(context.Background(), options)
+}
+
+// CreateSchemeWithContext creates an issue type scheme and returns its id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-issuetypescheme-post
+func (s *IssueTypeService) CreateSchemeWithContext(ctx context.Context, options *IssueTypeSchemeOptions) (string, *Response, error) {
+	apiEndpoint := restAPIBase + "/issuetypescheme"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := new(issueTypeSchemeCreateResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	return result.IssueTypeSchemeID, resp, nil
+}
+
+// CreateScheme wraps CreateSchemeWithContext using the background context.
+func (s *IssueTypeService) CreateScheme(options *IssueTypeSchemeOptions) (string, *Response, error) {
+	return s.CreateSchemeWithContext(context.Background(), options)
+}
+
+// AssignSchemeToProjectWithContext makes the project with the given id use the issue type scheme with the given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-issuetypescheme-project-put
+func (s *IssueTypeService) AssignSchemeToProjectWithContext(ctx context.Context, schemeID, projectID string) (*Response, error) {
+	apiEndpoint := restAPIBase + "/issuetypescheme/project"
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &issueTypeSchemeProject{IssueTypeSchemeID: schemeID, ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AssignSchemeToProject wraps AssignSchemeToProjectWithContext using the background context.
+func (s *IssueTypeService) AssignSchemeToProject(schemeID, projectID string) (*Response, error) {
+	return s.AssignSchemeToProjectWithContext(context.Background(), schemeID, projectID)
+}
+
+// AddIssueTypesToSchemeWithContext adds the issue types with the given ids to the issue type scheme with the given id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-issuetypescheme-issueTypeSchemeId-issuetype-put
+func (s *IssueTypeService) AddIssueTypesToSchemeWithContext(ctx context.Context, schemeID string, issueTypeIDs []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/issuetypescheme/%s/issuetype", restAPIBase, schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &issueTypeIDList{IssueTypeIDs: issueTypeIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddIssueTypesToScheme wraps AddIssueTypesToSchemeWithContext using the background context.
+func (s *IssueTypeService) AddIssueTypesToScheme(schemeID string, issueTypeIDs []string) (*Response, error) {
+	return s.AddIssueTypesToSchemeWithContext(context.Background(), schemeID, issueTypeIDs)
+}
@@ -0,0 +1,214 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestIssueTypeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"1","name":"Bug","subtask":false},{"id":"5","name":"Sub-task","subtask":true}]`)
+	})
+
+	issueTypes, _, err := testClient.IssueType.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 2 || !issueTypes[1].Subtask {
+		t.Errorf("Expected 2 issue types. Got %+v", issueTypes)
+	}
+}
+
+func TestIssueTypeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"1","name":"Bug","description":"A problem."}`)
+	})
+
+	issueType, _, err := testClient.IssueType.Get("1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issueType == nil || issueType.Name != "Bug" {
+		t.Errorf("Expected issue type Bug. Got %+v", issueType)
+	}
+}
+
+func TestIssueTypeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		options := new(IssueTypeOptions)
+		json.NewDecoder(r.Body).Decode(options)
+		if options.Name != "Incident" || options.Type != IssueTypeTypeStandard {
+			t.Errorf("Unexpected options %+v", options)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10100","name":"Incident","subtask":false}`)
+	})
+
+	issueType, _, err := testClient.IssueType.Create(&IssueTypeOptions{Name: "Incident", Type: IssueTypeTypeStandard})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issueType == nil || issueType.ID != "10100" {
+		t.Errorf("Expected issue type 10100. Got %+v", issueType)
+	}
+}
+
+func TestIssueTypeService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"description":"Service outage"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10100","name":"Incident","description":"Service outage"}`)
+	})
+
+	issueType, _, err := testClient.IssueType.Update("10100", &IssueTypeOptions{Description: "Service outage"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issueType == nil || issueType.Description != "Service outage" {
+		t.Errorf("Expected updated description. Got %+v", issueType)
+	}
+}
+
+func TestIssueTypeService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issuetype/10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?alternativeIssueTypeId=1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.IssueType.Delete("10100", "1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueTypeService_GetSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issuetypescheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?id=10000&id=10001&maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[
+			{"id":"10000","name":"Default Issue Type Scheme","isDefault":true},
+			{"id":"10001","name":"Standard","defaultIssueTypeId":"1"}]}`)
+	})
+
+	schemes, resp, err := testClient.IssueType.GetSchemes(&IssueTypeSchemeListOptions{IDs: []string{"10000", "10001"}, MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemes) != 2 || !schemes[0].IsDefault || schemes[1].DefaultIssueTypeID != "1" {
+		t.Errorf("Expected 2 schemes. Got %+v", schemes)
+	}
+	if resp.Total != 3 || resp.MaxResults != 2 {
+		t.Errorf("Expected paging values. Got %+v", resp)
+	}
+}
+
+func TestIssueTypeService_CreateScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issuetypescheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		options := new(IssueTypeSchemeOptions)
+		json.NewDecoder(r.Body).Decode(options)
+		if options.Name != "Standard" || len(options.IssueTypeIDs) != 2 {
+			t.Errorf("Unexpected options %+v", options)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issueTypeSchemeId":"10002"}`)
+	})
+
+	schemeID, _, err := testClient.IssueType.CreateScheme(&IssueTypeSchemeOptions{Name: "Standard", IssueTypeIDs: []string{"1", "3"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if schemeID != "10002" {
+		t.Errorf("Expected scheme id 10002. Got %s", schemeID)
+	}
+}
+
+func TestIssueTypeService_AssignSchemeToProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issuetypescheme/project"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"issueTypeSchemeId":"10002","projectId":"10000"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.IssueType.AssignSchemeToProject("10002", "10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueTypeService_AddIssueTypesToScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issuetypescheme/10002/issuetype"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"issueTypeIds":["10100"]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.IssueType.AddIssueTypesToScheme("10002", []string{"10100"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
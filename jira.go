@@ -2,6 +2,7 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -30,32 +32,94 @@ type Client struct {
 	// Session storage if the user authentificate with a Session cookie
 	session *Session
 
+	// RetryPolicy controls if and how failed requests are retried.
+	// Requests are not retried if it is nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter throttles all requests sent by the client, including retries.
+	// Requests are not throttled if it is nil.
+	RateLimiter RateLimiter
+
+	// Cache stores the responses of GET requests and revalidates them with conditional requests.
+	// Responses are not cached if it is nil.
+	Cache ResponseCache
+
+	// Middlewares wrap every request sent by the client, including retries, in the order given.
+	Middlewares []Middleware
+
+	// Dialect selects the variant of the REST API the services use: the version of the REST API,
+	// whether users are given by name or by account id and whether rich text is wiki markup or ADF.
+	// If it is DialectAuto, the client requests the server info of the JIRA instance before the first call
+	// which depends on the dialect and falls back to DialectCloud if that fails, see DetectDialect.
+	// Set it, e.g. with WithDialect, to avoid the additional request.
+	Dialect Dialect
+
+	detectedDialect Dialect
+	dialectErr      error
+	dialectMu       sync.Mutex
+
+	// userAgent and header are set on all requests, apiVersion overrides the REST API version of the dialect.
+	userAgent  string
+	header     http.Header
+	apiVersion int
+
 	// Services used for talking to different parts of the JIRA API.
-	Authentication *AuthenticationService
-	Issue          *IssueService
-	Project        *ProjectService
-	Board          *BoardService
-	Sprint         *SprintService
-	User           *UserService
-	Group          *GroupService
-	Version        *VersionService
-	Priority       *PriorityService
-	Field          *FieldService
-	Component      *ComponentService
-	Resolution     *ResolutionService
-	StatusCategory *StatusCategoryService
-}
-
-// NewClient returns a new JIRA API client.
-// If a nil httpClient is provided, http.DefaultClient will be used.
+	Authentication      *AuthenticationService
+	Issue               *IssueService
+	Project             *ProjectService
+	Board               *BoardService
+	Sprint              *SprintService
+	User                *UserService
+	Group               *GroupService
+	Version             *VersionService
+	Priority            *PriorityService
+	Field               *FieldService
+	Component           *ComponentService
+	Resolution          *ResolutionService
+	StatusCategory      *StatusCategoryService
+	Status              *StatusService
+	Epic                *EpicService
+	Worklog             *WorklogService
+	Webhook             *WebhookService
+	Filter              *FilterService
+	Dashboard           *DashboardService
+	IssueLink           *IssueLinkService
+	IssueLinkType       *IssueLinkTypeService
+	IssueType           *IssueTypeService
+	Workflow            *WorkflowService
+	PermissionScheme    *PermissionSchemeService
+	Permission          *PermissionService
+	ProjectRole         *ProjectRoleService
+	NotificationScheme  *NotificationSchemeService
+	IssueSecurityScheme *IssueSecuritySchemeService
+	Screen              *ScreenService
+	ServerInfo          *ServerInfoService
+	JQL                 *JQLService
+	Label               *LabelService
+	Backlog             *BacklogService
+	ServiceDesk         *ServiceDeskService
+	Assets              *AssetsService
+	Audit               *AuditService
+	ApplicationRole     *ApplicationRoleService
+	Avatar              *AvatarService
+
+	// Meta caches the fields, statuses, priorities and issue types of the JIRA instance.
+	Meta *MetadataCache
+}
+
+// NewClient returns a new JIRA API client configured by the given options.
+// If no HTTP client is provided with WithHTTPClient, http.DefaultClient will be used.
 // To use API methods which require authentication you can follow the preferred solution and
 // provide an http.Client that will perform the authentication for you with OAuth and HTTP Basic (such as that provided by the golang.org/x/oauth2 library).
 // As an alternative you can use Session Cookie based authentication provided by this package as well.
 // See https://docs.atlassian.com/jira/REST/latest/#authentication
 // baseURL is the HTTP endpoint of your JIRA instance and should always be specified with a trailing slash.
-func NewClient(httpClient *http.Client, baseURL string) (*Client, error) {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
+	options := &clientOptions{header: http.Header{}}
+	for _, opt := range opts {
+		if err := opt(options); err != nil {
+			return nil, err
+		}
 	}
 
 	// ensure the baseURL contains a trailing slash so that all paths are preserved in later calls
@@ -67,10 +131,22 @@ func NewClient(httpClient *http.Client, baseURL string) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if contextPath := strings.Trim(options.contextPath, "/"); contextPath != "" {
+		parsedBaseURL = parsedBaseURL.ResolveReference(&url.URL{Path: contextPath + "/"})
+	}
 
 	c := &Client{
-		client:  httpClient,
-		baseURL: parsedBaseURL,
+		client:     options.client(),
+		baseURL:    parsedBaseURL,
+		userAgent:  options.userAgent,
+		header:     options.header,
+		apiVersion: options.apiVersion,
+
+		RetryPolicy: options.retryPolicy,
+		RateLimiter: options.rateLimiter,
+		Middlewares: options.middlewares,
+		Cache:       options.cache,
+		Dialect:     options.dialect,
 	}
 	c.Authentication = &AuthenticationService{client: c}
 	c.Issue = &IssueService{client: c}
@@ -85,24 +161,61 @@ func NewClient(httpClient *http.Client, baseURL string) (*Client, error) {
 	c.Component = &ComponentService{client: c}
 	c.Resolution = &ResolutionService{client: c}
 	c.StatusCategory = &StatusCategoryService{client: c}
+	c.Status = &StatusService{client: c}
+	c.Epic = &EpicService{client: c}
+	c.Worklog = &WorklogService{client: c}
+	c.Webhook = &WebhookService{client: c}
+	c.Filter = &FilterService{client: c}
+	c.Dashboard = &DashboardService{client: c}
+	c.IssueLink = &IssueLinkService{client: c}
+	c.IssueLinkType = &IssueLinkTypeService{client: c}
+	c.IssueType = &IssueTypeService{client: c}
+	c.Workflow = &WorkflowService{client: c}
+	c.PermissionScheme = &PermissionSchemeService{client: c}
+	c.Permission = &PermissionService{client: c}
+	c.ProjectRole = &ProjectRoleService{client: c}
+	c.NotificationScheme = &NotificationSchemeService{client: c}
+	c.IssueSecurityScheme = &IssueSecuritySchemeService{client: c}
+	c.Screen = &ScreenService{client: c}
+	c.ServerInfo = &ServerInfoService{client: c}
+	c.JQL = &JQLService{client: c}
+	c.Label = &LabelService{client: c}
+	c.Backlog = &BacklogService{client: c}
+	c.ServiceDesk = &ServiceDeskService{client: c}
+	c.Assets = &AssetsService{client: c}
+	c.Audit = &AuditService{client: c}
+	c.ApplicationRole = &ApplicationRoleService{client: c}
+	c.Avatar = &AvatarService{client: c}
+	c.Meta = &MetadataCache{client: c, TTL: DefaultMetadataTTL}
 
 	return c, nil
 }
 
-// NewRawRequest creates an API request.
-// A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
-// Allows using an optional native io.Reader for sourcing the request body.
-func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Request, error) {
+// resolveURL resolves urlStr relative to the baseURL of the Client, including the context path JIRA is served at,
+// e.g. /jira for https://example.com/jira. Paths with a leading slash, like /rest/api/2/user, are relative to it too.
+func (c *Client) resolveURL(urlStr string) (*url.URL, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
-	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
+	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash.
+	// The escaped path is trimmed as well, to keep escaped slashes in path segments.
 	rel.Path = strings.TrimLeft(rel.Path, "/")
+	rel.RawPath = strings.TrimLeft(rel.RawPath, "/")
 
-	u := c.baseURL.ResolveReference(rel)
+	return c.baseURL.ResolveReference(rel), nil
+}
+
+// NewRawRequestWithContext creates an API request.
+// A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
+// Allows using an optional native io.Reader for sourcing the request body.
+func (c *Client) NewRawRequestWithContext(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
+	u, err := c.resolveURL(urlStr)
+	if err != nil {
+		return nil, err
+	}
 
-	req, err := http.NewRequest(method, u.String(), body)
+	req, err := newRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -123,22 +236,24 @@ func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Req
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
 	}
+	c.setHeaders(req)
 
 	return req, nil
 }
 
-// NewRequest creates an API request.
+// NewRawRequest wraps NewRawRequestWithContext using the background context.
+func (c *Client) NewRawRequest(method, urlStr string, body io.Reader) (*http.Request, error) {
+	return c.NewRawRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by body is JSON encoded and included as the request body.
-func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := c.resolveURL(urlStr)
 	if err != nil {
 		return nil, err
 	}
-	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
-
-	u := c.baseURL.ResolveReference(rel)
 
 	var buf io.ReadWriter
 	if body != nil {
@@ -149,7 +264,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := newRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -170,10 +285,32 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
 	}
+	c.setHeaders(req)
 
 	return req, nil
 }
 
+// setHeaders sets the User-Agent and the custom headers the client was created with on req,
+// and applies the RequestOptions attached to the context of req.
+func (c *Client) setHeaders(req *http.Request) {
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for key, values := range c.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	for _, opt := range requestOptionsFromContext(req.Context()) {
+		opt(req)
+	}
+}
+
+// NewRequest wraps NewRequestWithContext using the background context.
+func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s.  opt
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opt interface{}) (string, error) {
@@ -196,20 +333,16 @@ func addOptions(s string, opt interface{}) (string, error) {
 	return u.String(), nil
 }
 
-// NewMultiPartRequest creates an API request including a multi-part file.
+// NewMultiPartRequestWithContext creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by buf is a multipart form.
-func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
+func (c *Client) NewMultiPartRequestWithContext(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+	u, err := c.resolveURL(urlStr)
 	if err != nil {
 		return nil, err
 	}
-	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
-
-	u := c.baseURL.ResolveReference(rel)
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := newRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -231,14 +364,21 @@ func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (
 			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
 		}
 	}
+	c.setHeaders(req)
 
 	return req, nil
 }
 
+// NewMultiPartRequest wraps NewMultiPartRequestWithContext using the background context.
+func (c *Client) NewMultiPartRequest(method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+	return c.NewMultiPartRequestWithContext(context.Background(), method, urlStr, buf)
+}
+
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
+// If v is nil, the body of the response is left unread, the caller has to read and close it, e.g. to stream a large response.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	httpResp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -260,6 +400,45 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	return resp, err
 }
 
+// DoStream sends an API request like Do, but calls decode with a JSON decoder reading the API response,
+// so large responses can be processed while they are read instead of being decoded at once.
+// The body of the response is closed when decode returns.
+func (c *Client) DoStream(req *http.Request, decode func(dec *json.Decoder) error) (*Response, error) {
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	return resp, decode(json.NewDecoder(resp.Body))
+}
+
+// send sends req with the underlying HTTP client, revalidating the response cached for it
+// and retrying it according to the RetryPolicy.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.Cache != nil && req.Method == "GET" {
+		return c.sendCached(req, c.sendRetrying)
+	}
+	return c.sendRetrying(req)
+}
+
+// sendRetrying sends req with the underlying HTTP client, retrying it according to the RetryPolicy.
+func (c *Client) sendRetrying(req *http.Request) (*http.Response, error) {
+	if c.RetryPolicy == nil {
+		return c.sendOnce(req)
+	}
+	return c.RetryPolicy.do(req, c.sendOnce)
+}
+
+// sendOnce sends req with the underlying HTTP client once the RateLimiter allows it.
+func (c *Client) sendOnce(req *http.Request) (*http.Response, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return chain(c.client.Do, c.Middlewares)(req)
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
 // The caller is responsible to analyze the response body.
@@ -303,14 +482,135 @@ func (r *Response) populatePageValues(v interface{}) {
 		r.StartAt = value.StartAt
 		r.MaxResults = value.MaxResults
 		r.Total = value.Total
+	case *searchIntoResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
 	case *groupMembersResult:
 		r.StartAt = value.StartAt
 		r.MaxResults = value.MaxResults
 		r.Total = value.Total
+	case *commentsResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *webhookListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *dashboardListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *groupBulkResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *userBulkResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *changelogResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *createMetaIssueTypesResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *createMetaFieldsResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *fieldContextListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *fieldContextOptionListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *issueTypeSchemeListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *workflowSearchResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *workflowSchemeListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *notificationSchemeListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *securityLevelMemberListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *screenListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *labelListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *assetsObjectsResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
+	case *auditRecordsResult:
+		r.StartAt = value.Offset
+		r.MaxResults = value.Limit
+		r.Total = value.Total
 	}
 	return
 }
 
+// defaultMaxResults is the page size used by the paging helpers when the caller did not ask for one.
+// It matches the default page size of most JIRA endpoints.
+const defaultMaxResults = 50
+
+// pageFetcher requests a single page of a paginated resource, starting at startAt and holding at most maxResults items.
+// It returns the response along with the number of items that were on the page.
+type pageFetcher func(startAt, maxResults int) (*Response, int, error)
+
+// fetchPages calls fetch for consecutive pages until the resource is exhausted or fetch returns an error.
+// Endpoints which report a total are done once it is reached.
+// Endpoints which only report the page size are done with the first page shorter than the page size JIRA used,
+// which may be less than maxResults as JIRA caps it for some resources.
+// Endpoints without either (e.g. the user search returning a plain list) are done with the first empty page.
+func fetchPages(startAt, maxResults int, fetch pageFetcher) error {
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+
+	for {
+		resp, n, err := fetch(startAt, maxResults)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		startAt += n
+		if resp == nil {
+			continue
+		}
+		if resp.Total > 0 {
+			if startAt >= resp.Total {
+				return nil
+			}
+		} else if resp.MaxResults > 0 && n < resp.MaxResults {
+			return nil
+		}
+	}
+}
+
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
 // using HTTP Basic Authentication with the provided username and password.
 type BasicAuthTransport struct {
@@ -347,6 +647,36 @@ func (t *BasicAuthTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// BearerAuthTransport is an http.RoundTripper that authenticates all requests
+// with a bearer token, e.g. an OAuth 2.0 access token or a personal access token of JIRA Data Center.
+type BearerAuthTransport struct {
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface. It adds the token in the Authorization header.
+func (t *BearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	req2.Header.Set("Authorization", "Bearer "+t.Token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated with the bearer token
+func (t *BearerAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *BearerAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
 // CookieAuthTransport is an http.RoundTripper that authenticates all requests
 // using Jira's cookie-based authentication.
 //
@@ -443,6 +773,16 @@ func (t *CookieAuthTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// newRequestWithContext creates a new http.Request bound to ctx.
+// Cancelling ctx aborts the request while it is in flight.
+func newRequestWithContext(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
 // cloneRequest returns a clone of the provided *http.Request.
 // The clone is a shallow copy of the struct and its Header map.
 func cloneRequest(r *http.Request) *http.Request {
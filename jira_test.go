@@ -2,6 +2,8 @@ package jira
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -38,7 +40,9 @@ func setup() {
 	testServer = httptest.NewServer(testMux)
 
 	// jira client configured to use test server
-	testClient, _ = NewClient(nil, testServer.URL)
+	testClient, _ = NewClient(testServer.URL)
+	// The tests of JIRA Cloud and of the dialect detection set their own dialect
+	testClient.Dialect = DialectServer
 }
 
 // teardown closes the test HTTP server.
@@ -59,7 +63,7 @@ func testRequestURL(t *testing.T, r *http.Request, want string) {
 }
 
 func TestNewClient_WrongUrl(t *testing.T) {
-	c, err := NewClient(nil, "://issues.apache.org/jira/")
+	c, err := NewClient("://issues.apache.org/jira/")
 
 	if err == nil {
 		t.Error("Expected an error. Got none")
@@ -72,7 +76,7 @@ func TestNewClient_WrongUrl(t *testing.T) {
 func TestNewClient_WithHttpClient(t *testing.T) {
 	httpClient := http.DefaultClient
 	httpClient.Timeout = 10 * time.Minute
-	c, err := NewClient(httpClient, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL, WithHTTPClient(httpClient))
 
 	if err != nil {
 		t.Errorf("Got an error: %s", err)
@@ -86,7 +90,7 @@ func TestNewClient_WithHttpClient(t *testing.T) {
 }
 
 func TestNewClient_WithServices(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 
 	if err != nil {
 		t.Errorf("Got an error: %s", err)
@@ -124,6 +128,84 @@ func TestNewClient_WithServices(t *testing.T) {
 	if c.StatusCategory == nil {
 		t.Error("No StatusCategoryService provided")
 	}
+	if c.Status == nil {
+		t.Error("No StatusService provided")
+	}
+	if c.Epic == nil {
+		t.Error("No EpicService provided")
+	}
+	if c.Worklog == nil {
+		t.Error("No WorklogService provided")
+	}
+	if c.Webhook == nil {
+		t.Error("No WebhookService provided")
+	}
+	if c.Filter == nil {
+		t.Error("No FilterService provided")
+	}
+	if c.Dashboard == nil {
+		t.Error("No DashboardService provided")
+	}
+	if c.IssueLink == nil {
+		t.Error("No IssueLinkService provided")
+	}
+	if c.IssueLinkType == nil {
+		t.Error("No IssueLinkTypeService provided")
+	}
+	if c.IssueType == nil {
+		t.Error("No IssueTypeService provided")
+	}
+	if c.Workflow == nil {
+		t.Error("No WorkflowService provided")
+	}
+	if c.PermissionScheme == nil {
+		t.Error("No PermissionSchemeService provided")
+	}
+	if c.Permission == nil {
+		t.Error("No PermissionService provided")
+	}
+	if c.ProjectRole == nil {
+		t.Error("No ProjectRoleService provided")
+	}
+	if c.NotificationScheme == nil {
+		t.Error("No NotificationSchemeService provided")
+	}
+	if c.IssueSecurityScheme == nil {
+		t.Error("No IssueSecuritySchemeService provided")
+	}
+	if c.Screen == nil {
+		t.Error("No ScreenService provided")
+	}
+	if c.ServerInfo == nil {
+		t.Error("No ServerInfoService provided")
+	}
+	if c.JQL == nil {
+		t.Error("No JQLService provided")
+	}
+	if c.Label == nil {
+		t.Error("No LabelService provided")
+	}
+	if c.Backlog == nil {
+		t.Error("No BacklogService provided")
+	}
+	if c.ServiceDesk == nil {
+		t.Error("No ServiceDeskService provided")
+	}
+	if c.Assets == nil {
+		t.Error("No AssetsService provided")
+	}
+	if c.Audit == nil {
+		t.Error("No AuditService provided")
+	}
+	if c.ApplicationRole == nil {
+		t.Error("No ApplicationRoleService provided")
+	}
+	if c.Avatar == nil {
+		t.Error("No AvatarService provided")
+	}
+	if c.Meta == nil {
+		t.Error("No MetadataCache provided")
+	}
 }
 
 func TestCheckResponse(t *testing.T) {
@@ -142,7 +224,7 @@ func TestCheckResponse(t *testing.T) {
 }
 
 func TestClient_NewRequest(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -163,8 +245,40 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequestWithContext(t *testing.T) {
+	c, err := NewClient(testJIRAInstanceURL)
+	if err != nil {
+		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := c.NewRequestWithContext(ctx, "GET", "rest/api/2/issue/", nil)
+	if got := req.Context(); got != ctx {
+		t.Errorf("NewRequestWithContext() context is %v, want %v", got, ctx)
+	}
+}
+
+func TestClient_Do_CancelledContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not have been sent with a cancelled context")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := testClient.Issue.GetWithContext(ctx, "10002", nil)
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
 func TestClient_NewRawRequest(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -197,7 +311,7 @@ func testURLParseError(t *testing.T, err error) {
 }
 
 func TestClient_NewRequest_BadURL(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -206,7 +320,7 @@ func TestClient_NewRequest_BadURL(t *testing.T) {
 }
 
 func TestClient_NewRequest_SessionCookies(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -235,7 +349,7 @@ func TestClient_NewRequest_SessionCookies(t *testing.T) {
 }
 
 func TestClient_NewRequest_BasicAuth(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -261,7 +375,7 @@ func TestClient_NewRequest_BasicAuth(t *testing.T) {
 // since there is no difference between an HTTP request body that is an empty string versus one that is not set at all.
 // However in certain cases, intermediate systems may treat these differently resulting in subtle errors.
 func TestClient_NewRequest_EmptyBody(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -275,7 +389,7 @@ func TestClient_NewRequest_EmptyBody(t *testing.T) {
 }
 
 func TestClient_NewMultiPartRequest(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -308,7 +422,7 @@ func TestClient_NewMultiPartRequest(t *testing.T) {
 }
 
 func TestClient_NewMultiPartRequest_BasicAuth(t *testing.T) {
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
 	}
@@ -427,7 +541,7 @@ func TestClient_GetBaseURL_WithURL(t *testing.T) {
 		t.Errorf("URL parsing -> Got an error: %s", err)
 	}
 
-	c, err := NewClient(nil, testJIRAInstanceURL)
+	c, err := NewClient(testJIRAInstanceURL)
 	if err != nil {
 		t.Errorf("Client creation -> Got an error: %s", err)
 	}
@@ -443,7 +557,7 @@ func TestClient_GetBaseURL_WithURL(t *testing.T) {
 // REMOVED : This actually calls a live URL.  It's not a unit test.
 // I'm also not really sure what it's testing.
 // func TestClient_Do_PagingInfoEmptyByDefault(t *testing.T) {
-// 	c, _ := NewClient(nil, testJIRAInstanceURL)
+// 	c, _ := NewClient(testJIRAInstanceURL)
 // 	req, _ := c.NewRequest("GET", "/", nil)
 // 	t.Errorf("%v\n", req)
 // 	type foo struct {
@@ -488,7 +602,7 @@ func TestBasicAuthTransport(t *testing.T) {
 		Password: password,
 	}
 
-	basicAuthClient, _ := NewClient(tp.Client(), testServer.URL)
+	basicAuthClient, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()))
 	req, _ := basicAuthClient.NewRequest("GET", ".", nil)
 	basicAuthClient.Do(req, nil)
 }
@@ -539,7 +653,7 @@ func TestCookieAuthTransport_SessionObject_Exists(t *testing.T) {
 		SessionObject: []*http.Cookie{testCookie},
 	}
 
-	basicAuthClient, _ := NewClient(tp.Client(), testServer.URL)
+	basicAuthClient, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()))
 	req, _ := basicAuthClient.NewRequest("GET", ".", nil)
 	basicAuthClient.Do(req, nil)
 }
@@ -575,7 +689,7 @@ func TestCookieAuthTransport_SessionObject_ExistsWithEmptyCookie(t *testing.T) {
 		SessionObject: []*http.Cookie{emptyCookie, testCookie},
 	}
 
-	basicAuthClient, _ := NewClient(tp.Client(), testServer.URL)
+	basicAuthClient, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()))
 	req, _ := basicAuthClient.NewRequest("GET", ".", nil)
 	basicAuthClient.Do(req, nil)
 }
@@ -616,7 +730,37 @@ func TestCookieAuthTransport_SessionObject_DoesNotExist(t *testing.T) {
 		AuthURL:  ts.URL,
 	}
 
-	basicAuthClient, _ := NewClient(tp.Client(), testServer.URL)
+	basicAuthClient, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()))
 	req, _ := basicAuthClient.NewRequest("GET", ".", nil)
 	basicAuthClient.Do(req, nil)
 }
+
+func TestClient_DoStream(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"summary"},{"id":"status"}]`)
+	})
+
+	req, _ := testClient.NewRequest("GET", "rest/api/2/field", nil)
+	var ids []string
+	_, err := testClient.DoStream(req, func(dec *json.Decoder) error {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		for dec.More() {
+			var field Field
+			if err := dec.Decode(&field); err != nil {
+				return err
+			}
+			ids = append(ids, field.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if fmt.Sprint(ids) != "[summary status]" {
+		t.Errorf("Expected the fields summary and status. Got %v", ids)
+	}
+}
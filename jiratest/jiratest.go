@@ -0,0 +1,562 @@
+// Package jiratest runs a fake JIRA Server instance in process, to test tools built on the go-jira client
+// without a JIRA instance:
+//
+//	server := jiratest.NewServer()
+//	defer server.Close()
+//	server.AddUser(jira.User{Name: "fred", DisplayName: "Fred F. User"})
+//	server.AddIssue(jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "First"}})
+//
+//	client, err := server.Client()
+//	issues, _, err := client.Issue.Search("project = EX", nil)
+//
+// It emulates the core endpoints of version 2 of the REST API: users, group members, issues and the issue search.
+// The search supports queries of clauses combined with AND, each comparing key, project, status, issuetype or assignee
+// with "=", "!=" or "in". Other queries are rejected with 400 Bad Request.
+//
+// To test against the responses of a real JIRA instance instead, a Recorder records them to golden files once
+// and replays them in the tests. IssueUpdatedEvent and its siblings synthesize the payloads JIRA posts to webhooks.
+package jiratest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// Server is a fake JIRA Server instance seeded with users, groups and issues.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	users   []jira.User
+	groups  map[string][]string
+	issues  map[string]map[string]interface{}
+	keys    []string
+	nextID  int
+	counter map[string]int
+}
+
+// NewServer starts and returns a new Server without users, groups and issues. It has to be closed when done.
+func NewServer() *Server {
+	s := &Server{
+		groups:  map[string][]string{},
+		issues:  map[string]map[string]interface{}{},
+		nextID:  10000,
+		counter: map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/serverInfo", s.serverInfo)
+	mux.HandleFunc("/rest/api/2/user", s.user)
+	mux.HandleFunc("/rest/api/2/user/search", s.userSearch)
+	mux.HandleFunc("/rest/api/2/group/member", s.groupMembers)
+	mux.HandleFunc("/rest/api/2/group/user", s.groupUser)
+	mux.HandleFunc("/rest/api/2/issue", s.createIssue)
+	mux.HandleFunc("/rest/api/2/issue/", s.issue)
+	mux.HandleFunc("/rest/api/2/search", s.search)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Client returns a client of the server with the given options.
+// It talks DialectServer, like the server, unless the options select another dialect.
+func (s *Server) Client(opts ...jira.ClientOption) (*jira.Client, error) {
+	return jira.NewClient(s.URL, append([]jira.ClientOption{jira.WithDialect(jira.DialectServer)}, opts...)...)
+}
+
+// AddUser adds a user, which is found by its name or account id.
+func (s *Server) AddUser(user jira.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if user.Key == "" {
+		user.Key = user.Name
+	}
+	s.users = append(s.users, user)
+}
+
+// AddGroup adds a group with the users of the given names as members.
+func (s *Server) AddGroup(name string, members ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groups[name] = append(s.groups[name], members...)
+}
+
+// GroupMembers returns the names of the members of a group.
+func (s *Server) GroupMembers(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.groups[name]...)
+}
+
+// AddIssue adds an issue and returns its key.
+// An issue without key gets the next key of its project, an issue without id the next id.
+func (s *Server) AddIssue(issue jira.Issue) (string, error) {
+	data, err := json.Marshal(&issue)
+	if err != nil {
+		return "", err
+	}
+	var stored map[string]interface{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storeIssue(stored)
+}
+
+// Issue returns an issue of the server, as it was added or changed by the requests of a client.
+func (s *Server) Issue(key string) (*jira.Issue, bool) {
+	s.mu.Lock()
+	data, err := json.Marshal(s.issues[key])
+	_, ok := s.issues[key]
+	s.mu.Unlock()
+	if !ok || err != nil {
+		return nil, false
+	}
+
+	issue := new(jira.Issue)
+	if err := json.Unmarshal(data, issue); err != nil {
+		return nil, false
+	}
+	return issue, true
+}
+
+// storeIssue assigns the id and key of an issue if it has none and stores it. s.mu has to be held.
+func (s *Server) storeIssue(issue map[string]interface{}) (string, error) {
+	fields, _ := issue["fields"].(map[string]interface{})
+	if fields == nil {
+		fields = map[string]interface{}{}
+		issue["fields"] = fields
+	}
+
+	key, _ := issue["key"].(string)
+	project := lookup(issue, "fields.project.key")
+	if key == "" {
+		if project == "" {
+			return "", fmt.Errorf("The issue has neither a key nor a project")
+		}
+		s.counter[project]++
+		key = fmt.Sprintf("%s-%d", project, s.counter[project])
+	}
+	dash := strings.LastIndex(key, "-")
+	n, err := strconv.Atoi(key[dash+1:])
+	if dash <= 0 || err != nil {
+		return "", fmt.Errorf("The issue key %q is not a project key followed by a number", key)
+	}
+	if project == "" {
+		project = key[:dash]
+		fields["project"] = map[string]interface{}{"key": project}
+	}
+	if n > s.counter[project] {
+		s.counter[project] = n
+	}
+	if id, _ := issue["id"].(string); id == "" {
+		s.nextID++
+		issue["id"] = strconv.Itoa(s.nextID)
+	}
+	issue["key"] = key
+	issue["self"] = s.URL + "/rest/api/2/issue/" + issue["id"].(string)
+
+	if _, ok := s.issues[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.issues[key] = issue
+	return key, nil
+}
+
+func (s *Server) serverInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &jira.ServerInfo{
+		BaseURL:        s.URL,
+		Version:        "8.20.0",
+		VersionNumbers: []int{8, 20, 0},
+		DeploymentType: jira.DeploymentTypeServer,
+		ServerTitle:    "jiratest",
+	})
+}
+
+func (s *Server) user(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "jiratest does not support %s %s", r.Method, r.URL.Path)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query := r.URL.Query()
+	for _, user := range s.users {
+		if (query.Get("username") != "" && user.Name == query.Get("username")) ||
+			(query.Get("accountId") != "" && user.AccountID == query.Get("accountId")) {
+			writeJSON(w, http.StatusOK, user)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "The user named '%s' does not exist", query.Get("username")+query.Get("accountId"))
+}
+
+func (s *Server) userSearch(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	search := strings.ToLower(r.URL.Query().Get("username") + r.URL.Query().Get("query"))
+	users := []jira.User{}
+	for _, user := range s.users {
+		for _, value := range []string{user.Name, user.DisplayName, user.EmailAddress} {
+			if strings.Contains(strings.ToLower(value), search) {
+				users = append(users, user)
+				break
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, page(users, r, len(users)).([]jira.User))
+}
+
+func (s *Server) groupMembers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := r.URL.Query().Get("groupname")
+	names, ok := s.groups[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Specified group does not exist.")
+		return
+	}
+
+	members := []jira.GroupMember{}
+	for _, member := range names {
+		user := jira.User{Name: member}
+		for _, u := range s.users {
+			if u.Name == member {
+				user = u
+			}
+		}
+		members = append(members, jira.GroupMember{
+			Name:         user.Name,
+			Key:          user.Key,
+			EmailAddress: user.EmailAddress,
+			DisplayName:  user.DisplayName,
+			Active:       user.Active,
+			TimeZone:     user.TimeZone,
+		})
+	}
+	startAt, maxResults := paging(r)
+	values := page(members, r, len(members)).([]jira.GroupMember)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(members),
+		"isLast":     startAt+len(values) >= len(members),
+		"values":     values,
+	})
+}
+
+func (s *Server) groupUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := r.URL.Query().Get("groupname")
+	members, ok := s.groups[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Specified group does not exist.")
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		var user struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil || user.Name == "" {
+			writeError(w, http.StatusBadRequest, "The user has to be given by its name")
+			return
+		}
+		for _, member := range members {
+			if member == user.Name {
+				writeError(w, http.StatusBadRequest, "Cannot add user. '%s' is already a member of '%s'", user.Name, name)
+				return
+			}
+		}
+		s.groups[name] = append(members, user.Name)
+		writeJSON(w, http.StatusCreated, map[string]string{"name": name})
+	case "DELETE":
+		username := r.URL.Query().Get("username")
+		for i, member := range members {
+			if member == username {
+				s.groups[name] = append(members[:i:i], members[i+1:]...)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "The user '%s' is not a member of '%s'", username, name)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "jiratest does not support %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeError(w, http.StatusMethodNotAllowed, "jiratest does not support %s %s", r.Method, r.URL.Path)
+		return
+	}
+
+	var issue map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&issue); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid issue: %s", err)
+		return
+	}
+	delete(issue, "key")
+	delete(issue, "id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lookup(issue, "fields.project.key") == "" {
+		writeError(w, http.StatusBadRequest, "project: Specify a valid project ID or key")
+		return
+	}
+	key, err := s.storeIssue(issue)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": issue["id"], "key": key, "self": issue["self"]})
+}
+
+func (s *Server) issue(w http.ResponseWriter, r *http.Request) {
+	idOrKey := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")
+	if strings.Contains(idOrKey, "/") {
+		writeError(w, http.StatusNotFound, "jiratest does not support %s %s", r.Method, r.URL.Path)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := idOrKey
+	for k, issue := range s.issues {
+		if issue["id"] == idOrKey {
+			key = k
+		}
+	}
+	issue, ok := s.issues[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Issue Does Not Exist")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, issue)
+	case "PUT":
+		var update struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid issue: %s", err)
+			return
+		}
+		fields := issue["fields"].(map[string]interface{})
+		for name, value := range update.Fields {
+			fields[name] = value
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE":
+		delete(s.issues, key)
+		for i, k := range s.keys {
+			if k == key {
+				s.keys = append(s.keys[:i:i], s.keys[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "jiratest does not support %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	clauses, err := parseJQL(query.Get("jql"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	validation := query.Get("validateQuery")
+	var warnings []string
+	for _, c := range clauses {
+		if c.field != "key" {
+			continue
+		}
+		for _, key := range c.values {
+			if _, ok := s.issues[strings.ToUpper(key)]; ok {
+				continue
+			}
+			message := fmt.Sprintf("An issue with key '%s' does not exist for field 'key'.", key)
+			if validation == "" || validation == "strict" || validation == "true" {
+				writeError(w, http.StatusBadRequest, "%s", message)
+				return
+			}
+			warnings = append(warnings, message)
+		}
+	}
+
+	issues := []map[string]interface{}{}
+	for _, key := range s.keys {
+		if matches(s.issues[key], clauses) {
+			issues = append(issues, s.issues[key])
+		}
+	}
+	startAt, maxResults := paging(r)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"startAt":         startAt,
+		"maxResults":      maxResults,
+		"total":           len(issues),
+		"issues":          page(issues, r, len(issues)),
+		"warningMessages": warnings,
+	})
+}
+
+// clause is a clause of a JQL query supported by the search
+type clause struct {
+	field  string
+	negate bool
+	values []string
+}
+
+var (
+	jqlOrderBy = regexp.MustCompile(`(?i)\s+order\s+by\s+.*$`)
+	jqlAnd     = regexp.MustCompile(`(?i)\s+and\s+`)
+	jqlClause  = regexp.MustCompile(`(?i)^\(?\s*(key|issuekey|project|status|issuetype|type|assignee)\s*(=|!=|not\s+in|in)\s*(.+?)\s*\)?$`)
+	jqlValue   = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"|'([^']*)'|([^\s,()]+)`)
+)
+
+// parseJQL parses the clauses of a JQL query supported by the search
+func parseJQL(query string) ([]clause, error) {
+	query = strings.TrimSpace(jqlOrderBy.ReplaceAllString(" "+query, ""))
+	if query == "" {
+		return nil, nil
+	}
+
+	var clauses []clause
+	for _, part := range jqlAnd.Split(query, -1) {
+		m := jqlClause.FindStringSubmatch(strings.TrimSpace(part))
+		if m == nil {
+			return nil, fmt.Errorf("jiratest does not support the JQL clause %q", part)
+		}
+		c := clause{field: strings.ToLower(m[1]), negate: strings.HasPrefix(strings.ToLower(m[2]), "not") || m[2] == "!="}
+		switch c.field {
+		case "issuekey":
+			c.field = "key"
+		case "type":
+			c.field = "issuetype"
+		}
+		for _, v := range jqlValue.FindAllStringSubmatch(m[3], -1) {
+			value := v[1] + v[2] + v[3]
+			if v[1] != "" {
+				value, _ = strconv.Unquote(`"` + v[1] + `"`)
+			}
+			c.values = append(c.values, value)
+		}
+		clauses = append(clauses, c)
+	}
+	return clauses, nil
+}
+
+// matches reports whether an issue matches all clauses
+func matches(issue map[string]interface{}, clauses []clause) bool {
+	for _, c := range clauses {
+		var candidates []string
+		switch c.field {
+		case "key":
+			candidates = []string{lookup(issue, "key"), lookup(issue, "id")}
+		case "project":
+			candidates = []string{lookup(issue, "fields.project.key"), lookup(issue, "fields.project.name"), lookup(issue, "fields.project.id")}
+		case "status":
+			candidates = []string{lookup(issue, "fields.status.name"), lookup(issue, "fields.status.id")}
+		case "issuetype":
+			candidates = []string{lookup(issue, "fields.issuetype.name"), lookup(issue, "fields.issuetype.id")}
+		case "assignee":
+			candidates = []string{lookup(issue, "fields.assignee.name"), lookup(issue, "fields.assignee.accountId")}
+		}
+
+		found := false
+		for _, value := range c.values {
+			for _, candidate := range candidates {
+				if candidate != "" && strings.EqualFold(candidate, value) {
+					found = true
+				}
+			}
+		}
+		if found == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the string at the dotted path in a decoded JSON object, or an empty string if there is none
+func lookup(value map[string]interface{}, path string) string {
+	var current interface{} = value
+	for _, name := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		current = object[name]
+	}
+	s, _ := current.(string)
+	return s
+}
+
+// paging returns the startAt and maxResults parameters of a request, defaulting to 0 and 50
+func paging(r *http.Request) (int, int) {
+	startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+	maxResults, err := strconv.Atoi(r.URL.Query().Get("maxResults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 50
+	}
+	if startAt < 0 {
+		startAt = 0
+	}
+	return startAt, maxResults
+}
+
+// page returns the page of the slice values requested by r
+func page(values interface{}, r *http.Request, n int) interface{} {
+	startAt, maxResults := paging(r)
+	if startAt > n {
+		startAt = n
+	}
+	end := startAt + maxResults
+	if end > n {
+		end = n
+	}
+	switch v := values.(type) {
+	case []jira.User:
+		return v[startAt:end]
+	case []jira.GroupMember:
+		return v[startAt:end]
+	case []map[string]interface{}:
+		return v[startAt:end]
+	}
+	return values
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	writeJSON(w, status, map[string]interface{}{
+		"errorMessages": []string{fmt.Sprintf(format, args...)},
+		"errors":        map[string]string{},
+	})
+}
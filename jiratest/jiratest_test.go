@@ -0,0 +1,197 @@
+package jiratest
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func newTestServer(t *testing.T) (*Server, *jira.Client) {
+	server := NewServer()
+	client, err := server.Client()
+	if err != nil {
+		server.Close()
+		t.Fatalf("Error given: %s", err)
+	}
+	return server, client
+}
+
+func TestServer_Users(t *testing.T) {
+	server, client := newTestServer(t)
+	defer server.Close()
+	server.AddUser(jira.User{Name: "fred", DisplayName: "Fred F. User", AccountID: "000-fred"})
+	server.AddUser(jira.User{Name: "wilma", DisplayName: "Wilma Flintstone"})
+
+	user, _, err := client.User.Get("fred")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.DisplayName != "Fred F. User" || user.Key != "fred" {
+		t.Errorf("Expected fred, got %+v", user)
+	}
+
+	user, _, err = client.User.GetByAccountID("000-fred")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Name != "fred" {
+		t.Errorf("Expected fred, got %+v", user)
+	}
+
+	_, resp, err := client.User.Get("barney")
+	if err == nil || resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown user, got %v", err)
+	}
+
+	users, _, err := client.User.Find(jira.WithUsername("flint"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "wilma" {
+		t.Errorf("Expected wilma, got %+v", users)
+	}
+}
+
+func TestServer_Groups(t *testing.T) {
+	server, client := newTestServer(t)
+	defer server.Close()
+	server.AddUser(jira.User{Name: "fred", DisplayName: "Fred F. User"})
+	server.AddGroup("developers", "fred")
+
+	if _, _, err := client.Group.AddUser("developers", "wilma"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	members, err := client.Group.GetAllMembers("developers")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(members) != 2 || members[0].DisplayName != "Fred F. User" || members[1].Name != "wilma" {
+		t.Errorf("Unexpected members %+v", members)
+	}
+
+	if _, err := client.Group.RemoveUser("developers", "fred"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := server.GroupMembers("developers"); !reflect.DeepEqual(got, []string{"wilma"}) {
+		t.Errorf("Expected [wilma], got %v", got)
+	}
+
+	if _, _, err := client.Group.Get("admins"); err == nil {
+		t.Error("Expected an error for an unknown group")
+	}
+}
+
+func TestServer_Issues(t *testing.T) {
+	server, client := newTestServer(t)
+	defer server.Close()
+	key, err := server.AddIssue(jira.Issue{Key: "EX-7", Fields: &jira.IssueFields{Summary: "Seeded"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if key != "EX-7" {
+		t.Errorf("Expected EX-7, got %s", key)
+	}
+	for _, key := range []string{"EX", "-1", "EX-"} {
+		if _, err := server.AddIssue(jira.Issue{Key: key}); err == nil {
+			t.Errorf("Expected an error for the key %q", key)
+		}
+	}
+
+	created, _, err := client.Issue.Create(&jira.Issue{Fields: &jira.IssueFields{
+		Project: jira.Project{Key: "EX"},
+		Type:    jira.IssueType{Name: "Bug"},
+		Summary: "Created",
+	}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created.Key != "EX-8" || created.ID == "" {
+		t.Errorf("Expected the next key EX-8 and an id, got %+v", created)
+	}
+
+	issue, _, err := client.Issue.Get("EX-8", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Created" || issue.Fields.Type.Name != "Bug" {
+		t.Errorf("Unexpected issue %+v", issue.Fields)
+	}
+
+	if _, _, err := client.Issue.Update(&jira.Issue{Key: "EX-8", Fields: &jira.IssueFields{Summary: "Updated"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue, _ := server.Issue("EX-8"); issue == nil || issue.Fields.Summary != "Updated" {
+		t.Errorf("Expected the summary to be updated, got %+v", issue)
+	}
+
+	if _, err := client.Issue.Delete("EX-7"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, resp, err := client.Issue.Get("EX-7", nil); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for a deleted issue, got %v", err)
+	}
+}
+
+func TestServer_Search(t *testing.T) {
+	server, client := newTestServer(t)
+	defer server.Close()
+	for _, fields := range []*jira.IssueFields{
+		{Project: jira.Project{Key: "EX"}, Status: &jira.Status{Name: "Open"}, Summary: "one"},
+		{Project: jira.Project{Key: "EX"}, Status: &jira.Status{Name: "Done"}, Summary: "two"},
+		{Project: jira.Project{Key: "EX"}, Status: &jira.Status{Name: "Open"}, Summary: "three"},
+		{Project: jira.Project{Key: "OT"}, Status: &jira.Status{Name: "Open"}, Summary: "four"},
+	} {
+		if _, err := server.AddIssue(jira.Issue{Fields: fields}); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+
+	tests := []struct {
+		jql  string
+		keys []string
+	}{
+		{"", []string{"EX-1", "EX-2", "EX-3", "OT-1"}},
+		{"project = EX AND status = Open ORDER BY key ASC", []string{"EX-1", "EX-3"}},
+		{`status != "Open"`, []string{"EX-2"}},
+		{"key in (EX-2, OT-1)", []string{"EX-2", "OT-1"}},
+		{"project not in (EX)", []string{"OT-1"}},
+	}
+	for _, test := range tests {
+		issues, _, err := client.Issue.Search(test.jql, nil)
+		if err != nil {
+			t.Errorf("%q: Error given: %s", test.jql, err)
+			continue
+		}
+		var keys []string
+		for _, issue := range issues {
+			keys = append(keys, issue.Key)
+		}
+		if !reflect.DeepEqual(keys, test.keys) {
+			t.Errorf("%q: Expected %v, got %v", test.jql, test.keys, keys)
+		}
+	}
+
+	issues, resp, err := client.Issue.Search("project = EX", &jira.SearchOptions{StartAt: 1, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-2" || resp.Total != 3 {
+		t.Errorf("Expected EX-2 of 3 issues, got %d issues of %d", len(issues), resp.Total)
+	}
+
+	if _, _, err := client.Issue.Search("summary ~ one", nil); err == nil {
+		t.Error("Expected an error for an unsupported query")
+	}
+	if _, _, err := client.Issue.Search("key = EX-99", nil); err == nil {
+		t.Error("Expected an error for an unknown key")
+	}
+	issues, _, err = client.Issue.Search("key in (EX-1, EX-99)", &jira.SearchOptions{ValidateQuery: "warn"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected the unknown key to be skipped, got %d issues", len(issues))
+	}
+}
@@ -0,0 +1,251 @@
+package jiratest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder
+type Mode int
+
+// These constants are the modes of a Recorder
+const (
+	// ModeReplay replays the recorded responses without sending any request
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records the responses to golden files
+	ModeRecord
+)
+
+// RedactedValue replaces the values of redacted headers in golden files
+const RedactedValue = "REDACTED"
+
+// DefaultRedactedHeaders are the headers which are always redacted, because they authenticate the user
+var DefaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// Recorder is an http.RoundTripper which records the responses of a real JIRA instance to golden files
+// and replays them in tests, to test against realistic responses without credentials:
+//
+//	recorder := jiratest.NewRecorder("testdata/issue", jiratest.ModeReplay)
+//	if *record {
+//		recorder.Mode = jiratest.ModeRecord
+//		recorder.Transport = &jira.BasicAuthTransport{Username: user, Password: token}
+//	}
+//	client, _ := jira.NewClient("https://example.atlassian.net", jira.WithHTTPClient(recorder.Client()))
+//
+// Every request is recorded to its own file in Dir, named after its method, path and a hash of its query and body.
+// A request sent several times is recorded once per time it is sent and the recordings are replayed in order.
+// The headers in DefaultRedactedHeaders and RedactHeaders are redacted before they are written.
+type Recorder struct {
+	// Dir is the directory of the golden files
+	Dir string
+
+	// Mode is ModeReplay or ModeRecord
+	Mode Mode
+
+	// RedactHeaders are redacted in addition to DefaultRedactedHeaders
+	RedactHeaders []string
+
+	// RedactBody is called with every recorded request and response body and returns the body to write,
+	// e.g. to remove email addresses
+	RedactBody func(body []byte) []byte
+
+	// Transport is the underlying HTTP transport used to record requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// Interaction is a request and its response, as recorded to a golden file
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request
+type RecordedRequest struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+}
+
+// RecordedResponse is a recorded response
+type RecordedResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	Text       string          `json:"text,omitempty"`
+}
+
+// NewRecorder returns a Recorder of golden files in dir
+func NewRecorder(dir string, mode Mode) *Recorder {
+	return &Recorder{Dir: dir, Mode: mode}
+}
+
+// Client returns an *http.Client which records or replays its requests
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements the RoundTripper interface.
+// In replay mode it returns an error for requests which were not recorded.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	file := filepath.Join(r.Dir, r.fileName(req, body))
+
+	if r.Mode == ModeRecord {
+		return r.record(req, body, file)
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("jiratest: no recorded response for %s %s in %s", req.Method, req.URL.RequestURI(), file)
+	} else if err != nil {
+		return nil, err
+	}
+	var interaction Interaction
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return nil, fmt.Errorf("jiratest: invalid golden file %s: %s", file, err)
+	}
+
+	recorded := interaction.Response
+	respBody := []byte(recorded.Text)
+	if len(recorded.Body) > 0 {
+		respBody = recorded.Body
+	}
+	header := recorded.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// record sends a request and writes it and its response to file
+func (r *Recorder) record(req *http.Request, body []byte, file string) (*http.Response, error) {
+	req2 := req.Clone(req.Context()) // per RoundTripper contract
+	if body != nil {
+		req2.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := r.transport().RoundTrip(req2)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Header: r.redactHeader(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactHeader(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.Text = r.recordBody(body)
+	interaction.Response.Body, interaction.Response.Text = r.recordBody(respBody)
+
+	data, err := json.MarshalIndent(&interaction, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.Dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(file, append(data, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// recordBody returns a body to record as JSON if it is JSON, or as text otherwise
+func (r *Recorder) recordBody(body []byte) (json.RawMessage, string) {
+	if r.RedactBody != nil && len(body) > 0 {
+		body = r.RedactBody(body)
+	}
+	if len(bytes.TrimSpace(body)) > 0 && json.Valid(body) {
+		return body, ""
+	}
+	return nil, string(body)
+}
+
+// redactHeader returns a copy of header with the redacted headers replaced by RedactedValue
+func (r *Recorder) redactHeader(header http.Header) http.Header {
+	redacted := http.Header{}
+	for name, values := range header {
+		redacted[name] = append([]string(nil), values...)
+	}
+	for _, name := range append(append([]string(nil), DefaultRedactedHeaders...), r.RedactHeaders...) {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted[http.CanonicalHeaderKey(name)] = []string{RedactedValue}
+		}
+	}
+	return redacted
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fileName returns the name of the golden file of a request, counting the times the request was sent
+func (r *Recorder) fileName(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.URL.RawQuery))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	name := unsafeFileNameChars.ReplaceAllString(strings.Trim(req.URL.Path, "/"), "_")
+	name = fmt.Sprintf("%s_%s_%s", req.Method, name, hex.EncodeToString(hash.Sum(nil))[:8])
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.calls == nil {
+		r.calls = map[string]int{}
+	}
+	r.calls[name]++
+	if n := r.calls[name]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+	return name + ".json"
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,91 @@
+package jiratest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	if _, err := server.AddIssue(jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "Recorded"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "jiratest")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	recorder := NewRecorder(dir, ModeRecord)
+	recorder.Transport = &jira.BasicAuthTransport{Username: "fred", Password: "secret"}
+	recorder.RedactBody = func(body []byte) []byte {
+		return bytes.Replace(body, []byte("Recorded"), []byte("Redacted"), -1)
+	}
+	client, err := jira.NewClient(server.URL, jira.WithHTTPClient(recorder.Client()), jira.WithHeader("Cookie", "JSESSIONID=secret"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	client.Dialect = jira.DialectServer
+
+	issue, _, err := client.Issue.Get("EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Recorded" {
+		t.Errorf("Expected the response of the server while recording, got %q", issue.Fields.Summary)
+	}
+	if _, _, err := client.Issue.Update(&jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "Changed"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := client.Issue.Get("EX-1", nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 golden files, got %v", files)
+	}
+	for _, file := range files {
+		data, _ := ioutil.ReadFile(file)
+		if strings.Contains(string(data), "secret") {
+			t.Errorf("Expected the cookie to be redacted in %s", file)
+		}
+	}
+	server.Close()
+
+	replayer := NewRecorder(dir, ModeReplay)
+	client, err = jira.NewClient("https://jira.example.com", jira.WithHTTPClient(replayer.Client()))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	client.Dialect = jira.DialectServer
+
+	issue, _, err = client.Issue.Get("EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Redacted" {
+		t.Errorf("Expected the redacted recorded summary, got %q", issue.Fields.Summary)
+	}
+	if _, _, err := client.Issue.Update(&jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "Changed"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	issue, _, err = client.Issue.Get("EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Changed" {
+		t.Errorf("Expected the second recording of the request, got %q", issue.Fields.Summary)
+	}
+
+	if _, _, err := client.Issue.Get("EX-2", nil); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("Expected an error for a request which was not recorded, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package jiratest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// changelogID is the id of the last changelog synthesized by IssueUpdatedEvent
+var changelogID int64 = 10000
+
+// IssueCreatedEvent returns the payload JIRA sends to webhooks when user created issue
+func IssueCreatedEvent(issue *jira.Issue, user *jira.User) *jira.WebhookEvent {
+	return &jira.WebhookEvent{
+		Timestamp:          timestamp(),
+		WebhookEvent:       jira.WebhookEventIssueCreated,
+		IssueEventTypeName: "issue_created",
+		User:               user,
+		Issue:              issue,
+	}
+}
+
+// IssueUpdatedEvent returns the payload JIRA sends to webhooks when user updated the issue old to updated.
+// The changelog lists the changes of the summary, description, type, priority, status, resolution,
+// assignee, reporter and labels, in the format of JIRA.
+func IssueUpdatedEvent(old, updated *jira.Issue, user *jira.User) *jira.WebhookEvent {
+	items := changelogItems(old.Fields, updated.Fields)
+
+	eventType := "issue_updated"
+	if len(items) == 1 && items[0].Field == "assignee" {
+		eventType = "issue_assigned"
+	}
+	for _, item := range items {
+		if item.Field == "status" {
+			eventType = "issue_generic"
+		}
+	}
+
+	return &jira.WebhookEvent{
+		Timestamp:          timestamp(),
+		WebhookEvent:       jira.WebhookEventIssueUpdated,
+		IssueEventTypeName: eventType,
+		User:               user,
+		Issue:              updated,
+		Changelog: &jira.WebhookChangelog{
+			ID:    strconv.FormatInt(atomic.AddInt64(&changelogID, 1), 10),
+			Items: items,
+		},
+	}
+}
+
+// CommentCreatedEvent returns the payload JIRA sends to webhooks when a comment is added to issue
+func CommentCreatedEvent(issue *jira.Issue, comment *jira.Comment) *jira.WebhookEvent {
+	return &jira.WebhookEvent{
+		Timestamp:    timestamp(),
+		WebhookEvent: jira.WebhookEventCommentCreated,
+		Issue:        issue,
+		Comment:      comment,
+	}
+}
+
+// NewWebhookRequest returns a request posting the payload of event to the webhook at target,
+// as JIRA does, e.g. to pass it to the http.Handler of the webhook
+func NewWebhookRequest(target string, event *jira.WebhookEvent) (*http.Request, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("User-Agent", "Atlassian HttpClient")
+	return req, nil
+}
+
+// changelogItems returns the changes from old to updated
+func changelogItems(old, updated *jira.IssueFields) []jira.ChangelogItems {
+	if old == nil {
+		old = &jira.IssueFields{}
+	}
+	if updated == nil {
+		updated = &jira.IssueFields{}
+	}
+
+	var items []jira.ChangelogItems
+	change := func(field string, from, to interface{}, fromString, toString string) {
+		if from == to && fromString == toString {
+			return
+		}
+		items = append(items, jira.ChangelogItems{
+			Field:      field,
+			FieldType:  "jira",
+			From:       from,
+			FromString: fromString,
+			To:         to,
+			ToString:   toString,
+		})
+	}
+
+	change("summary", nil, nil, old.Summary, updated.Summary)
+	change("description", nil, nil, old.Description, updated.Description)
+	change("issuetype", nullable(old.Type.ID), nullable(updated.Type.ID), old.Type.Name, updated.Type.Name)
+
+	var from, to jira.Priority
+	if old.Priority != nil {
+		from = *old.Priority
+	}
+	if updated.Priority != nil {
+		to = *updated.Priority
+	}
+	change("priority", nullable(from.ID), nullable(to.ID), from.Name, to.Name)
+
+	var fromStatus, toStatus jira.Status
+	if old.Status != nil {
+		fromStatus = *old.Status
+	}
+	if updated.Status != nil {
+		toStatus = *updated.Status
+	}
+	change("status", nullable(fromStatus.ID), nullable(toStatus.ID), fromStatus.Name, toStatus.Name)
+
+	var fromResolution, toResolution jira.Resolution
+	if old.Resolution != nil {
+		fromResolution = *old.Resolution
+	}
+	if updated.Resolution != nil {
+		toResolution = *updated.Resolution
+	}
+	change("resolution", nullable(fromResolution.ID), nullable(toResolution.ID), fromResolution.Name, toResolution.Name)
+
+	fromUser, fromName := userChange(old.Assignee)
+	toUser, toName := userChange(updated.Assignee)
+	change("assignee", fromUser, toUser, fromName, toName)
+	fromUser, fromName = userChange(old.Reporter)
+	toUser, toName = userChange(updated.Reporter)
+	change("reporter", fromUser, toUser, fromName, toName)
+
+	change("labels", nil, nil, strings.Join(old.Labels, " "), strings.Join(updated.Labels, " "))
+	return items
+}
+
+// userChange returns the id and name of user as recorded in changelogs
+func userChange(user *jira.User) (interface{}, string) {
+	if user == nil {
+		return nil, ""
+	}
+	if user.AccountID != "" {
+		return user.AccountID, user.DisplayName
+	}
+	return nullable(user.Name), user.DisplayName
+}
+
+// nullable returns nil for an empty string, as JIRA records missing values in changelogs
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// timestamp returns the current time in milliseconds, as found in webhook events
+func timestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,98 @@
+package jiratest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestIssueUpdatedEvent(t *testing.T) {
+	old := &jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{
+		Summary: "Build fails",
+		Status:  &jira.Status{ID: "1", Name: "Open"},
+		Labels:  []string{"ci"},
+	}}
+	updated := &jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{
+		Summary:  "Build fails",
+		Status:   &jira.Status{ID: "3", Name: "In Progress"},
+		Assignee: &jira.User{Name: "fred", DisplayName: "Fred F. User"},
+		Labels:   []string{"ci", "urgent"},
+	}}
+	user := &jira.User{Name: "wilma"}
+
+	var received *jira.WebhookEvent
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := jira.ParseWebhookEvent(r.Body)
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		received = event
+	})
+	req, err := NewWebhookRequest("/webhook", IssueUpdatedEvent(old, updated, user))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received == nil {
+		t.Fatal("Expected the event to be received")
+	}
+	if received.WebhookEvent != jira.WebhookEventIssueUpdated || received.IssueEventTypeName != "issue_generic" {
+		t.Errorf("Expected a generic issue update, got %s (%s)", received.WebhookEvent, received.IssueEventTypeName)
+	}
+	if received.Timestamp == 0 || received.User == nil || received.User.Name != "wilma" || received.Issue.Key != "EX-1" {
+		t.Errorf("Unexpected event %+v", received)
+	}
+
+	items := map[string]jira.ChangelogItems{}
+	for _, item := range received.Changelog.Items {
+		items[item.Field] = item
+	}
+	if len(items) != 3 {
+		t.Errorf("Expected changes of status, assignee and labels, got %+v", received.Changelog.Items)
+	}
+	if item := items["status"]; item.From != "1" || item.FromString != "Open" || item.To != "3" || item.ToString != "In Progress" {
+		t.Errorf("Unexpected status change %+v", item)
+	}
+	if item := items["assignee"]; item.From != nil || item.To != "fred" || item.ToString != "Fred F. User" {
+		t.Errorf("Unexpected assignee change %+v", item)
+	}
+	if item := items["labels"]; item.FromString != "ci" || item.ToString != "ci urgent" {
+		t.Errorf("Unexpected labels change %+v", item)
+	}
+}
+
+func TestIssueUpdatedEvent_Assigned(t *testing.T) {
+	old := &jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "Build fails"}}
+	updated := &jira.Issue{Key: "EX-1", Fields: &jira.IssueFields{Summary: "Build fails", Assignee: &jira.User{AccountID: "000-fred"}}}
+
+	event := IssueUpdatedEvent(old, updated, nil)
+	if event.IssueEventTypeName != "issue_assigned" {
+		t.Errorf("Expected issue_assigned, got %s", event.IssueEventTypeName)
+	}
+	if len(event.Changelog.Items) != 1 || event.Changelog.Items[0].To != "000-fred" {
+		t.Errorf("Expected the account id as new assignee, got %+v", event.Changelog.Items)
+	}
+}
+
+func TestCommentCreatedEvent(t *testing.T) {
+	issue := &jira.Issue{Key: "EX-1"}
+	comment := &jira.Comment{ID: "10000", Body: "Fixed in master", Author: jira.User{Name: "fred"}}
+
+	req, err := NewWebhookRequest("/webhook", CommentCreatedEvent(issue, comment))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	event, err := jira.ParseWebhookEvent(req.Body)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if event.WebhookEvent != jira.WebhookEventCommentCreated || event.Comment == nil || event.Comment.Body != "Fixed in master" {
+		t.Errorf("Expected created comment, got %+v", event)
+	}
+	if event.Issue == nil || event.Issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, got %+v", event.Issue)
+	}
+}
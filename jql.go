@@ -0,0 +1,249 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// JQLService handles parsing JQL queries and the JQL autocomplete data of the JIRA instance / API.
+// The endpoints are only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/
+type JQLService struct {
+	client *Client
+}
+
+// These constants are the validation modes of the JQLService.Parse method
+const (
+	// JQLValidationStrict returns an error for invalid queries and for unknown fields and values
+	JQLValidationStrict = "strict"
+	// JQLValidationWarn returns an error for invalid queries and a warning for unknown fields and values
+	JQLValidationWarn = "warn"
+	// JQLValidationNone only checks the syntax of the queries
+	JQLValidationNone = "none"
+)
+
+// ParsedJQLQuery represents the result of parsing a JQL query.
+// Structure is nil if the query could not be parsed, Errors holds the syntax and validation errors.
+type ParsedJQLQuery struct {
+	Query     string             `json:"query,omitempty" structs:"query,omitempty"`
+	Structure *JQLQueryStructure `json:"structure,omitempty" structs:"structure,omitempty"`
+	Errors    []string           `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings  []string           `json:"warnings,omitempty" structs:"warnings,omitempty"`
+}
+
+// JQLQueryStructure represents the abstract syntax tree of a JQL query.
+type JQLQueryStructure struct {
+	Where   *JQLClause  `json:"where,omitempty" structs:"where,omitempty"`
+	OrderBy *JQLOrderBy `json:"orderBy,omitempty" structs:"orderBy,omitempty"`
+}
+
+// JQLClause represents a clause of a JQL query.
+// A compound clause combines its Clauses with its Operator, e.g. "and".
+// Any other clause compares its Field with its Operand, e.g. by "=".
+type JQLClause struct {
+	Clauses  []*JQLClause `json:"clauses,omitempty" structs:"clauses,omitempty"`
+	Operator string       `json:"operator,omitempty" structs:"operator,omitempty"`
+	Field    *JQLField    `json:"field,omitempty" structs:"field,omitempty"`
+	Operand  *JQLOperand  `json:"operand,omitempty" structs:"operand,omitempty"`
+}
+
+// JQLField represents a field referenced by a JQL query.
+type JQLField struct {
+	Name     string             `json:"name,omitempty" structs:"name,omitempty"`
+	Property []JQLFieldProperty `json:"property,omitempty" structs:"property,omitempty"`
+}
+
+// JQLFieldProperty represents an entity property referenced by a JQL query, e.g. issue.property[config].level.
+type JQLFieldProperty struct {
+	Entity string `json:"entity,omitempty" structs:"entity,omitempty"`
+	Key    string `json:"key,omitempty" structs:"key,omitempty"`
+	Path   string `json:"path,omitempty" structs:"path,omitempty"`
+	Type   string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// JQLOperand represents the right side of a JQL clause: a value, a list of values, a function or a keyword like EMPTY.
+type JQLOperand struct {
+	Value     string        `json:"value,omitempty" structs:"value,omitempty"`
+	Values    []*JQLOperand `json:"values,omitempty" structs:"values,omitempty"`
+	Function  string        `json:"function,omitempty" structs:"function,omitempty"`
+	Arguments []string      `json:"arguments,omitempty" structs:"arguments,omitempty"`
+	Keyword   string        `json:"keyword,omitempty" structs:"keyword,omitempty"`
+}
+
+// JQLOrderBy represents the ORDER BY clause of a JQL query.
+type JQLOrderBy struct {
+	Fields []JQLOrderByField `json:"fields,omitempty" structs:"fields,omitempty"`
+}
+
+// JQLOrderByField represents a field a JQL query is ordered by.
+type JQLOrderByField struct {
+	Field     JQLField `json:"field" structs:"field"`
+	Direction string   `json:"direction,omitempty" structs:"direction,omitempty"`
+}
+
+// jqlParseOptions are the query parameters of the JQLService.Parse method
+type jqlParseOptions struct {
+	Validation string `url:"validation,omitempty"`
+}
+
+// jqlQueries is the request of the JQLService.Parse method
+type jqlQueries struct {
+	Queries []string `json:"queries"`
+}
+
+// parsedJQLQueries is the response of the JQLService.Parse method
+type parsedJQLQueries struct {
+	Queries []ParsedJQLQuery `json:"queries"`
+}
+
+// JQLAutocompleteData represents the fields, functions and reserved words which can be used in JQL queries.
+type JQLAutocompleteData struct {
+	VisibleFieldNames    []JQLFieldReference    `json:"visibleFieldNames,omitempty" structs:"visibleFieldNames,omitempty"`
+	VisibleFunctionNames []JQLFunctionReference `json:"visibleFunctionNames,omitempty" structs:"visibleFunctionNames,omitempty"`
+	JQLReservedWords     []string               `json:"jqlReservedWords,omitempty" structs:"jqlReservedWords,omitempty"`
+}
+
+// JQLFieldReference represents a field which can be used in JQL queries, with the operators it supports.
+type JQLFieldReference struct {
+	Value       string   `json:"value,omitempty" structs:"value,omitempty"`
+	DisplayName string   `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Orderable   string   `json:"orderable,omitempty" structs:"orderable,omitempty"`
+	Searchable  string   `json:"searchable,omitempty" structs:"searchable,omitempty"`
+	Auto        string   `json:"auto,omitempty" structs:"auto,omitempty"`
+	CFID        string   `json:"cfid,omitempty" structs:"cfid,omitempty"`
+	Operators   []string `json:"operators,omitempty" structs:"operators,omitempty"`
+	Types       []string `json:"types,omitempty" structs:"types,omitempty"`
+}
+
+// JQLFunctionReference represents a function which can be used in JQL queries.
+type JQLFunctionReference struct {
+	Value       string   `json:"value,omitempty" structs:"value,omitempty"`
+	DisplayName string   `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	IsList      string   `json:"isList,omitempty" structs:"isList,omitempty"`
+	Types       []string `json:"types,omitempty" structs:"types,omitempty"`
+}
+
+// JQLSuggestionOptions specifies the parameters to the JQLService.GetFieldSuggestions method
+type JQLSuggestionOptions struct {
+	// FieldName is the field to get the suggested values for
+	FieldName string `url:"fieldName,omitempty"`
+	// FieldValue is the beginning of the value the user typed
+	FieldValue string `url:"fieldValue,omitempty"`
+	// PredicateName is the predicate, e.g. "by" for "status CHANGED BY", to get the suggestions for
+	PredicateName string `url:"predicateName,omitempty"`
+	// PredicateValue is the beginning of the predicate value the user typed
+	PredicateValue string `url:"predicateValue,omitempty"`
+}
+
+// JQLSuggestion represents a suggested value for a field in a JQL query.
+type JQLSuggestion struct {
+	Value       string `json:"value,omitempty" structs:"value,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+}
+
+// jqlSuggestions is the response of the JQLService.GetFieldSuggestions method
+type jqlSuggestions struct {
+	Results []JQLSuggestion `json:"results"`
+}
+
+// ParseWithContext parses the given JQL queries and returns their structure and errors.
+// validation is one of the JQLValidation constants, an empty validation uses JQLValidationStrict.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-parse-post
+func (s *JQLService) ParseWithContext(ctx context.Context, validation string, queries ...string) ([]ParsedJQLQuery, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/jql/parse", &jqlParseOptions{Validation: validation})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &jqlQueries{Queries: queries})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(parsedJQLQueries)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Queries, resp, nil
+}
+
+// Parse wraps ParseWithContext using the background context.
+func (s *JQLService) Parse(validation string, queries ...string) ([]ParsedJQLQuery, *Response, error) {
+	return s.ParseWithContext(context.Background(), validation, queries...)
+}
+
+// ValidateWithContext checks the given JQL query with the strict validation before it is used for a search.
+// It returns an error listing the syntax and validation errors of an invalid query.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-parse-post
+func (s *JQLService) ValidateWithContext(ctx context.Context, query string) (*Response, error) {
+	parsed, resp, err := s.ParseWithContext(ctx, JQLValidationStrict, query)
+	if err != nil {
+		return resp, err
+	}
+	if len(parsed) != 1 {
+		return resp, fmt.Errorf("Expected the result of 1 query, got %d", len(parsed))
+	}
+	if len(parsed[0].Errors) > 0 {
+		return resp, fmt.Errorf("Invalid JQL query %q: %v", query, parsed[0].Errors)
+	}
+	return resp, nil
+}
+
+// Validate wraps ValidateWithContext using the background context.
+func (s *JQLService) Validate(query string) (*Response, error) {
+	return s.ValidateWithContext(context.Background(), query)
+}
+
+// GetAutocompleteDataWithContext returns the fields, functions and reserved words which can be used in JQL queries.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-get
+func (s *JQLService) GetAutocompleteDataWithContext(ctx context.Context) (*JQLAutocompleteData, *Response, error) {
+	apiEndpoint := restAPIBase + "/jql/autocompletedata"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(JQLAutocompleteData)
+	resp, err := s.client.Do(req, data)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return data, resp, nil
+}
+
+// GetAutocompleteData wraps GetAutocompleteDataWithContext using the background context.
+func (s *JQLService) GetAutocompleteData() (*JQLAutocompleteData, *Response, error) {
+	return s.GetAutocompleteDataWithContext(context.Background())
+}
+
+// GetFieldSuggestionsWithContext returns the suggested values for a field, e.g. while the user types a JQL query.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-suggestions-get
+func (s *JQLService) GetFieldSuggestionsWithContext(ctx context.Context, options *JQLSuggestionOptions) ([]JQLSuggestion, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/jql/autocompletedata/suggestions", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(jqlSuggestions)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Results, resp, nil
+}
+
+// GetFieldSuggestions wraps GetFieldSuggestionsWithContext using the background context.
+func (s *JQLService) GetFieldSuggestions(options *JQLSuggestionOptions) ([]JQLSuggestion, *Response, error) {
+	return s.GetFieldSuggestionsWithContext(context.Background(), options)
+}
@@ -0,0 +1,282 @@
+// Package jql builds JIRA Query Language (JQL) queries, which are passed e.g. to IssueService.Search.
+//
+// Values are always quoted and escaped, and field names are quoted if they are reserved words
+// or contain special characters, so no query can be broken by the values it is built from:
+//
+//	query := jql.And(
+//		jql.Eq("project", "OPS"),
+//		jql.In("status", "Open", "In Progress"),
+//		jql.Eq("assignee", jql.Func("currentUser")),
+//	).OrderBy("created", jql.Desc)
+//
+// JQL docs: https://confluence.atlassian.com/jiracoreserver/advanced-searching-939937709.html
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Direction is the direction a query is ordered by a field.
+type Direction string
+
+// These constants are the directions a query can be ordered by
+const (
+	Asc  Direction = "ASC"
+	Desc Direction = "DESC"
+)
+
+// Keyword is a JQL keyword which is used as value without quoting, like Empty.
+type Keyword string
+
+// These constants are the keywords which can be used as values
+const (
+	Empty Keyword = "EMPTY"
+	Null  Keyword = "NULL"
+)
+
+// Function is a JQL function like currentUser() or membersOf("jira-users"), which can be used as value.
+type Function struct {
+	Name string
+	Args []string
+}
+
+// Func returns the function with the given name and arguments.
+// The arguments are quoted.
+func Func(name string, args ...string) Function {
+	return Function{Name: name, Args: args}
+}
+
+// String returns the function call in JQL.
+func (f Function) String() string {
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, quote(arg))
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// These constants are the kinds of a Query, which decide whether it has to be put in parentheses
+const (
+	kindEmpty = iota
+	kindClause
+	kindAnd
+	kindOr
+	kindNot
+)
+
+// Query is a JQL query: a single clause like `project = "OPS"`, or clauses combined by And, Or and Not.
+// The zero Query matches all issues.
+type Query struct {
+	expr    string
+	kind    int
+	orderBy []string
+}
+
+// String returns the query in JQL.
+func (q *Query) String() string {
+	if len(q.orderBy) == 0 {
+		return q.expr
+	}
+	order := "ORDER BY " + strings.Join(q.orderBy, ", ")
+	if q.expr == "" {
+		return order
+	}
+	return q.expr + " " + order
+}
+
+// OrderBy returns a copy of the query ordered by field in the direction dir.
+// Calling OrderBy again orders by further fields.
+func (q *Query) OrderBy(field string, dir Direction) *Query {
+	ordered := *q
+	ordered.orderBy = append(append([]string{}, q.orderBy...), Field(field)+" "+string(dir))
+	return &ordered
+}
+
+// Raw returns a query of the given JQL as it is, e.g. a saved filter.
+// The JQL is put in parentheses when it is combined with other queries.
+func Raw(jql string) *Query {
+	if strings.TrimSpace(jql) == "" {
+		return &Query{}
+	}
+	return &Query{expr: jql, kind: kindOr}
+}
+
+// Eq returns the clause field = value.
+func Eq(field string, value interface{}) *Query {
+	return compare(field, "=", value)
+}
+
+// NotEq returns the clause field != value.
+func NotEq(field string, value interface{}) *Query {
+	return compare(field, "!=", value)
+}
+
+// Gt returns the clause field > value.
+func Gt(field string, value interface{}) *Query {
+	return compare(field, ">", value)
+}
+
+// Gte returns the clause field >= value.
+func Gte(field string, value interface{}) *Query {
+	return compare(field, ">=", value)
+}
+
+// Lt returns the clause field < value.
+func Lt(field string, value interface{}) *Query {
+	return compare(field, "<", value)
+}
+
+// Lte returns the clause field <= value.
+func Lte(field string, value interface{}) *Query {
+	return compare(field, "<=", value)
+}
+
+// Contains returns the text search clause field ~ value.
+func Contains(field string, value interface{}) *Query {
+	return compare(field, "~", value)
+}
+
+// NotContains returns the text search clause field !~ value.
+func NotContains(field string, value interface{}) *Query {
+	return compare(field, "!~", value)
+}
+
+// Is returns the clause field IS value, where value is usually Empty or Null.
+func Is(field string, value interface{}) *Query {
+	return compare(field, "IS", value)
+}
+
+// IsNot returns the clause field IS NOT value, where value is usually Empty or Null.
+func IsNot(field string, value interface{}) *Query {
+	return compare(field, "IS NOT", value)
+}
+
+// In returns the clause field IN (values...).
+func In(field string, values ...interface{}) *Query {
+	return compare(field, "IN", list(values))
+}
+
+// NotIn returns the clause field NOT IN (values...).
+func NotIn(field string, values ...interface{}) *Query {
+	return compare(field, "NOT IN", list(values))
+}
+
+// list is the value of the IN and NOT IN operators.
+type list []interface{}
+
+func compare(field, operator string, value interface{}) *Query {
+	return &Query{expr: Field(field) + " " + operator + " " + Value(value), kind: kindClause}
+}
+
+// And returns a query matching the issues which all of the given queries match.
+// Empty queries are left out.
+func And(queries ...*Query) *Query {
+	return combine(kindAnd, " AND ", queries)
+}
+
+// Or returns a query matching the issues which any of the given queries match.
+// Empty queries are left out.
+func Or(queries ...*Query) *Query {
+	return combine(kindOr, " OR ", queries)
+}
+
+// Not returns a query matching the issues which the given query does not match.
+func Not(query *Query) *Query {
+	if query.kind == kindEmpty {
+		return query
+	}
+	return &Query{expr: "NOT " + group(query, kindClause), kind: kindNot}
+}
+
+func combine(kind int, operator string, queries []*Query) *Query {
+	parts := make([]string, 0, len(queries))
+	var single *Query
+	for _, query := range queries {
+		if query == nil || query.kind == kindEmpty {
+			continue
+		}
+		single = query
+		parts = append(parts, group(query, kind))
+	}
+	switch len(parts) {
+	case 0:
+		return &Query{}
+	case 1:
+		return &Query{expr: single.expr, kind: single.kind}
+	}
+	return &Query{expr: strings.Join(parts, operator), kind: kind}
+}
+
+// group puts the query in parentheses if it is combined by another operator than the one of parent.
+func group(query *Query, parent int) string {
+	if query.kind == kindClause || query.kind == kindNot || query.kind == parent {
+		return query.expr
+	}
+	return "(" + query.expr + ")"
+}
+
+// reservedWords are the words which JQL only accepts as field names in quotes.
+// The list contains the JQL keywords and the reserved words of JIRA which are most likely to be used as names.
+var reservedWords = map[string]bool{
+	"and": true, "or": true, "not": true, "empty": true, "null": true, "order": true, "by": true,
+	"asc": true, "desc": true, "in": true, "is": true, "was": true, "changed": true, "after": true,
+	"before": true, "during": true, "on": true, "from": true, "to": true, "select": true, "where": true,
+	"limit": true, "group": true, "having": true, "count": true, "sum": true, "max": true, "min": true,
+	"true": true, "false": true, "if": true, "else": true, "return": true, "index": true, "user": true,
+	"date": true, "string": true, "number": true, "object": true, "delete": true, "update": true,
+	"insert": true, "create": true, "set": true, "start": true, "end": true, "begin": true, "commit": true,
+}
+
+var (
+	plainFieldPattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+	customFieldPattern = regexp.MustCompile(`^cf\[\d+\]$`)
+)
+
+// Field returns the field name in JQL.
+// Names which are reserved words or contain special characters, like custom field names with spaces, are quoted.
+func Field(name string) string {
+	if customFieldPattern.MatchString(name) {
+		return name
+	}
+	if plainFieldPattern.MatchString(name) && !reservedWords[strings.ToLower(name)] {
+		return name
+	}
+	return quote(name)
+}
+
+// Value returns the value in JQL.
+// Strings and everything else which is not a number, Keyword or Function is quoted.
+// A time.Time is formatted as "2006-01-02 15:04".
+func Value(value interface{}) string {
+	switch v := value.(type) {
+	case Keyword:
+		return string(v)
+	case Function:
+		return v.String()
+	case list:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, Value(item))
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	case time.Time:
+		return quote(v.Format("2006-01-02 15:04"))
+	case string:
+		return quote(v)
+	case fmt.Stringer:
+		return quote(v.String())
+	}
+	return quote(fmt.Sprint(value))
+}
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// quote returns s as JQL string in double quotes.
+func quote(s string) string {
+	return `"` + quoteReplacer.Replace(s) + `"`
+}
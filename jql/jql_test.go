@@ -0,0 +1,59 @@
+package jql
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuery_String(t *testing.T) {
+	tests := []struct {
+		query    *Query
+		expected string
+	}{
+		{Eq("project", "OPS"), `project = "OPS"`},
+		{NotEq("priority", 3), `priority != 3`},
+		{Contains("summary", `say "hello" \ bye`), `summary ~ "say \"hello\" \\ bye"`},
+		{In("status", "Open", "In Progress"), `status IN ("Open", "In Progress")`},
+		{NotIn("resolution", Empty, "Done"), `resolution NOT IN (EMPTY, "Done")`},
+		{Is("fixVersion", Empty), `fixVersion IS EMPTY`},
+		{IsNot("assignee", Null), `assignee IS NOT NULL`},
+		{Eq("assignee", Func("currentUser")), `assignee = currentUser()`},
+		{In("reporter", Func("membersOf", "jira-users")), `reporter IN (membersOf("jira-users"))`},
+		{Gte("created", time.Date(2020, time.March, 4, 10, 30, 0, 0, time.UTC)), `created >= "2020-03-04 10:30"`},
+		{Eq("Story Points", 5), `"Story Points" = 5`},
+		{Eq("cf[10001]", "x"), `cf[10001] = "x"`},
+		{Eq("order", "first"), `"order" = "first"`},
+		{Eq("project", "OPS\" OR project = \"SECRET"), `project = "OPS\" OR project = \"SECRET"`},
+		{
+			And(Eq("project", "OPS"), In("status", "Open", "Reopened")).OrderBy("created", Desc),
+			`project = "OPS" AND status IN ("Open", "Reopened") ORDER BY created DESC`,
+		},
+		{
+			And(Eq("project", "OPS"), Or(Eq("priority", "High"), Eq("labels", "urgent"))),
+			`project = "OPS" AND (priority = "High" OR labels = "urgent")`,
+		},
+		{
+			Or(And(Eq("a", 1), Eq("b", 2)), Not(Or(Eq("c", 3), Eq("d", 4)))),
+			`(a = 1 AND b = 2) OR NOT (c = 3 OR d = 4)`,
+		},
+		{And(&Query{}, Eq("project", "OPS"), nil), `project = "OPS"`},
+		{And(Raw("filter = 10000"), Eq("project", "OPS")), `(filter = 10000) AND project = "OPS"`},
+		{And().OrderBy("rank", Asc).OrderBy("key", Desc), `ORDER BY rank ASC, key DESC`},
+	}
+	for _, test := range tests {
+		if got := test.query.String(); got != test.expected {
+			t.Errorf("Expected %s. Got %s", test.expected, got)
+		}
+	}
+}
+
+func TestQuery_OrderBy_Copy(t *testing.T) {
+	query := Eq("project", "OPS")
+	ordered := query.OrderBy("created", Desc)
+	if query.String() != `project = "OPS"` {
+		t.Errorf("Expected OrderBy not to change the query. Got %s", query)
+	}
+	if ordered.String() != `project = "OPS" ORDER BY created DESC` {
+		t.Errorf("Expected ordered query. Got %s", ordered)
+	}
+}
@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestJQLService_Parse(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/jql/parse"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint+"?validation=strict")
+
+		var body map[string][]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body["queries"]) != 2 || body["queries"][0] != `project = "OPS" ORDER BY created DESC` {
+			t.Errorf("Expected the queries. Got %v", body)
+		}
+		fmt.Fprint(w, `{"queries":[
+			{"query":"project = \"OPS\" ORDER BY created DESC","structure":{
+				"where":{"field":{"name":"project"},"operator":"=","operand":{"value":"OPS"}},
+				"orderBy":{"fields":[{"field":{"name":"created"},"direction":"desc"}]}}},
+			{"query":"project = ","errors":["Expecting either a value, list or function but got 'end of query'."]}
+		]}`)
+	})
+
+	parsed, _, err := testClient.JQL.Parse(JQLValidationStrict, `project = "OPS" ORDER BY created DESC`, "project = ")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(parsed) != 2 {
+		t.Fatalf("Expected 2 parsed queries. Got %d", len(parsed))
+	}
+	where := parsed[0].Structure.Where
+	if where.Field.Name != "project" || where.Operator != "=" || where.Operand.Value != "OPS" {
+		t.Errorf("Expected clause project = OPS. Got %+v", where)
+	}
+	if parsed[0].Structure.OrderBy.Fields[0].Direction != "desc" {
+		t.Errorf("Expected order by created desc. Got %+v", parsed[0].Structure.OrderBy)
+	}
+	if parsed[1].Structure != nil || len(parsed[1].Errors) != 1 {
+		t.Errorf("Expected a syntax error. Got %+v", parsed[1])
+	}
+}
+
+func TestJQLService_Validate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/jql/parse", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"queries":[{"query":"statuss = Open","errors":["Field 'statuss' does not exist or you do not have permission to view it."]}]}`)
+	})
+
+	_, err := testClient.JQL.Validate("statuss = Open")
+	if err == nil || !strings.Contains(err.Error(), "statuss") {
+		t.Errorf("Expected validation error. Got %v", err)
+	}
+}
+
+func TestJQLService_GetAutocompleteData(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/jql/autocompletedata"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"visibleFieldNames":[{"value":"summary","displayName":"Summary","orderable":"true","searchable":"true","operators":["~","!~","is","is not"],"types":["java.lang.String"]}],
+			"visibleFunctionNames":[{"value":"currentUser()","displayName":"currentUser()","types":["com.atlassian.jira.user.ApplicationUser"]}],
+			"jqlReservedWords":["empty","and","or"]}`)
+	})
+
+	data, _, err := testClient.JQL.GetAutocompleteData()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(data.VisibleFieldNames) != 1 || data.VisibleFieldNames[0].Value != "summary" || len(data.VisibleFieldNames[0].Operators) != 4 {
+		t.Errorf("Expected field summary. Got %+v", data.VisibleFieldNames)
+	}
+	if len(data.VisibleFunctionNames) != 1 || len(data.JQLReservedWords) != 3 {
+		t.Errorf("Expected functions and reserved words. Got %+v", data)
+	}
+}
+
+func TestJQLService_GetFieldSuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/jql/autocompletedata/suggestions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?fieldName=reporter&fieldValue=fr")
+		fmt.Fprint(w, `{"results":[{"value":"fred","displayName":"<b>Fr</b>ed F. User"}]}`)
+	})
+
+	suggestions, _, err := testClient.JQL.GetFieldSuggestions(&JQLSuggestionOptions{FieldName: "reporter", FieldValue: "fr"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(suggestions) != 1 || suggestions[0].Value != "fred" {
+		t.Errorf("Expected suggestion fred. Got %+v", suggestions)
+	}
+}
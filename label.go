@@ -0,0 +1,57 @@
+package jira
+
+import "context"
+
+// LabelService handles the labels of the JIRA instance / API.
+// Listing the labels is only available on JIRA Cloud.
+// Labels are added to and removed from issues with IssueService.AddLabels and IssueService.RemoveLabels.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-labels/
+type LabelService struct {
+	client *Client
+}
+
+// LabelListOptions specifies the optional parameters to the LabelService.GetList method
+type LabelListOptions struct {
+	// StartAt is the index of the first label to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of labels to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// labelListResult is a page of labels
+type labelListResult struct {
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Labels     []string `json:"values"`
+}
+
+// GetListWithContext returns a page of the labels used by the issues of the JIRA instance.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-labels/#api-rest-api-3-label-get
+func (s *LabelService) GetListWithContext(ctx context.Context, options *LabelListOptions) ([]string, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/label", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(labelListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Labels, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *LabelService) GetList(options *LabelListOptions) ([]string, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
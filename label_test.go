@@ -0,0 +1,30 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestLabelService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/label"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=2&startAt=2")
+		fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":5,"isLast":false,"values":["backend","frontend"]}`)
+	})
+
+	labels, resp, err := testClient.Label.GetList(&LabelListOptions{StartAt: 2, MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(labels) != 2 || labels[0] != "backend" {
+		t.Errorf("Expected labels backend and frontend. Got %v", labels)
+	}
+	if resp.StartAt != 2 || resp.Total != 5 {
+		t.Errorf("Expected the paging of the response. Got start at %d and total %d", resp.StartAt, resp.Total)
+	}
+}
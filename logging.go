@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Logger is the structured logger the requests of a client are logged with.
+// It is implemented by *slog.Logger and logr.Logger.
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+}
+
+// LogOptions configures what LogRequests logs in addition to the method, URL, status and latency of a request.
+type LogOptions struct {
+	// Headers logs the request and response headers. Authentication headers and cookies are scrubbed.
+	Headers bool
+
+	// Bodies logs the request and response bodies.
+	// The body of a request is only logged if it can be read again, which is the case
+	// for all requests created by the NewRequest methods of the Client.
+	Bodies bool
+
+	// MaxBodySize truncates the logged bodies. Defaults to 4096 bytes.
+	MaxBodySize int
+
+	// RedactBody is called with every logged body and returns the body to log,
+	// e.g. to remove personal data. Bodies are logged unchanged if it is nil.
+	RedactBody func(body string) string
+}
+
+// scrubbedHeaders are the headers which are never logged, because they authenticate the user
+var scrubbedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// LogRequests returns a Middleware logging every request with the method, URL, status and latency,
+// and the headers and bodies if the options ask for them. Options may be nil.
+//
+//	client.Middlewares = append(client.Middlewares, jira.LogRequests(slog.Default(), &jira.LogOptions{Bodies: true}))
+func LogRequests(logger Logger, options *LogOptions) Middleware {
+	if options == nil {
+		options = &LogOptions{}
+	}
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			fields := []interface{}{"method", req.Method, "url", req.URL.String()}
+			if options.Headers {
+				fields = append(fields, "requestHeaders", scrubHeaders(req.Header))
+			}
+			if options.Bodies && req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					data, _ := ioutil.ReadAll(body)
+					body.Close()
+					fields = append(fields, "requestBody", options.body(data))
+				}
+			}
+
+			start := time.Now()
+			resp, err := next(req)
+			fields = append(fields, "latency", time.Since(start))
+
+			if err != nil {
+				fields = append(fields, "error", err.Error())
+			}
+			if resp != nil {
+				fields = append(fields, "status", resp.StatusCode)
+				if options.Headers {
+					fields = append(fields, "responseHeaders", scrubHeaders(resp.Header))
+				}
+				if options.Bodies && resp.Body != nil {
+					data, readErr := ioutil.ReadAll(resp.Body)
+					resp.Body.Close()
+					resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+					if readErr == nil {
+						fields = append(fields, "responseBody", options.body(data))
+					}
+				}
+			}
+
+			logger.Info("JIRA API request", fields...)
+			return resp, err
+		}
+	}
+}
+
+// body returns data truncated and redacted for logging
+func (o *LogOptions) body(data []byte) string {
+	maxSize := o.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = 4096
+	}
+
+	logged := string(data)
+	if len(data) > maxSize {
+		logged = string(data[:maxSize]) + "..."
+	}
+	if o.RedactBody != nil {
+		logged = o.RedactBody(logged)
+	}
+	return logged
+}
+
+// scrubHeaders returns a copy of header without the values of the authentication headers and cookies
+func scrubHeaders(header http.Header) http.Header {
+	scrubbed := http.Header{}
+	for key, values := range header {
+		scrubbed[key] = values
+	}
+	for _, key := range scrubbedHeaders {
+		if scrubbed.Get(key) != "" {
+			scrubbed.Set(key, "[REDACTED]")
+		}
+	}
+	return scrubbed
+}
@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	messages []string
+	fields   []map[string]interface{}
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	fields := map[string]interface{}{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	l.messages = append(l.messages, msg)
+	l.fields = append(l.fields, fields)
+}
+
+func TestLogRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "JSESSIONID=secret")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`)
+	})
+
+	logger := &recordingLogger{}
+	c, _ := NewClient(testServer.URL, WithLogger(logger, &LogOptions{
+		Headers:    true,
+		Bodies:     true,
+		RedactBody: func(body string) string { return strings.Replace(body, "secret summary", "***", -1) },
+	}))
+	c.Authentication.SetBasicAuth("fred", "password")
+
+	req, _ := c.NewRequest("POST", "rest/api/2/issue", map[string]string{"summary": "secret summary"})
+	resp, err := c.Do(req, nil)
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+
+	if len(logger.fields) != 1 {
+		t.Fatalf("Expected 1 logged request. Got %d", len(logger.fields))
+	}
+	fields := logger.fields[0]
+	if fields["method"] != "POST" || fields["status"] != http.StatusBadRequest || !strings.HasSuffix(fields["url"].(string), "/rest/api/2/issue") {
+		t.Errorf("Unexpected method, status or url. Got %v", fields)
+	}
+	if _, ok := fields["latency"]; !ok {
+		t.Error("Expected the latency to be logged")
+	}
+	if got := fields["requestBody"]; got != `{"summary":"***"}`+"\n" {
+		t.Errorf("Expected the redacted request body. Got %q", got)
+	}
+	if got := fields["responseBody"].(string); !strings.Contains(got, "You must specify a summary") {
+		t.Errorf("Expected the response body. Got %q", got)
+	}
+	if got := fields["requestHeaders"].(http.Header).Get("Authorization"); got != "[REDACTED]" {
+		t.Errorf("Expected the Authorization header to be scrubbed. Got %q", got)
+	}
+	if got := fields["responseHeaders"].(http.Header).Get("Set-Cookie"); got != "[REDACTED]" {
+		t.Errorf("Expected the Set-Cookie header to be scrubbed. Got %q", got)
+	}
+	if req.Header.Get("Authorization") == "[REDACTED]" {
+		t.Error("Expected the request headers to be unchanged")
+	}
+
+	jerr := NewJiraError(resp, err)
+	if !strings.Contains(jerr.Error(), "You must specify a summary") {
+		t.Errorf("Expected the response body to still be readable. Got %s", jerr)
+	}
+}
+
+func TestLogRequests_TruncatesBodies(t *testing.T) {
+	options := &LogOptions{MaxBodySize: 4}
+	if got := options.body([]byte("0123456789")); got != "0123..." {
+		t.Errorf("Expected a truncated body. Got %q", got)
+	}
+}
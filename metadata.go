@@ -0,0 +1,243 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMetadataTTL is the time the MetadataCache of a new client keeps the metadata before loading it again
+const DefaultMetadataTTL = 15 * time.Minute
+
+// MetadataCache loads the fields, statuses, priorities and issue types of the JIRA instance on first use
+// and keeps them for TTL, e.g. to look up the id of a custom field by its name:
+//
+//	id, err := client.Meta.FieldIDByName("Story Points")
+//
+// It is safe for concurrent use.
+type MetadataCache struct {
+	client *Client
+
+	// TTL is the time the metadata is kept before it is loaded again. Zero keeps it until Invalidate is called.
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*metadataEntry
+}
+
+// metadataEntry is a list of metadata loaded at a point in time
+type metadataEntry struct {
+	value  interface{}
+	loaded time.Time
+}
+
+// These constants are the keys of the metadata kept by the MetadataCache
+const (
+	metadataFields     = "fields"
+	metadataStatuses   = "statuses"
+	metadataPriorities = "priorities"
+	metadataIssueTypes = "issuetypes"
+)
+
+// Invalidate drops all metadata, so it is loaded again on next use.
+func (m *MetadataCache) Invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = nil
+}
+
+// load returns the metadata kept for key, loading it with fetch if it is missing or expired
+func (m *MetadataCache) load(ctx context.Context, key string, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry, ok := m.entries[key]; ok && (m.TTL == 0 || time.Since(entry.loaded) < m.TTL) {
+		return entry.value, nil
+	}
+
+	value, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m.entries == nil {
+		m.entries = map[string]*metadataEntry{}
+	}
+	m.entries[key] = &metadataEntry{value: value, loaded: time.Now()}
+	return value, nil
+}
+
+// FieldsWithContext returns the system and custom fields of the JIRA instance.
+func (m *MetadataCache) FieldsWithContext(ctx context.Context) ([]Field, error) {
+	value, err := m.load(ctx, metadataFields, func(ctx context.Context) (interface{}, error) {
+		fields, _, err := m.client.Field.GetListWithContext(ctx)
+		return fields, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Field), nil
+}
+
+// Fields wraps FieldsWithContext using the background context.
+func (m *MetadataCache) Fields() ([]Field, error) {
+	return m.FieldsWithContext(context.Background())
+}
+
+// FieldIDByNameWithContext returns the id of the field with the given name, e.g. "customfield_10002" for "Story Points".
+// The name is matched case insensitive. It returns an error if no field or more than one field has the name.
+func (m *MetadataCache) FieldIDByNameWithContext(ctx context.Context, name string) (string, error) {
+	fields, err := m.FieldsWithContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			ids = append(ids, field.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("No field with the name %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("More than one field with the name %q: %s", name, strings.Join(ids, ", "))
+	}
+}
+
+// FieldIDByName wraps FieldIDByNameWithContext using the background context.
+func (m *MetadataCache) FieldIDByName(name string) (string, error) {
+	return m.FieldIDByNameWithContext(context.Background(), name)
+}
+
+// FieldNameByIDWithContext returns the name of the field with the given id, e.g. "Story Points" for "customfield_10002".
+func (m *MetadataCache) FieldNameByIDWithContext(ctx context.Context, id string) (string, error) {
+	fields, err := m.FieldsWithContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		if field.ID == id {
+			return field.Name, nil
+		}
+	}
+	return "", fmt.Errorf("No field with the id %q", id)
+}
+
+// FieldNameByID wraps FieldNameByIDWithContext using the background context.
+func (m *MetadataCache) FieldNameByID(id string) (string, error) {
+	return m.FieldNameByIDWithContext(context.Background(), id)
+}
+
+// StatusesWithContext returns the statuses of the JIRA instance.
+func (m *MetadataCache) StatusesWithContext(ctx context.Context) ([]Status, error) {
+	value, err := m.load(ctx, metadataStatuses, func(ctx context.Context) (interface{}, error) {
+		statuses, _, err := m.client.Status.GetListWithContext(ctx)
+		return statuses, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Status), nil
+}
+
+// Statuses wraps StatusesWithContext using the background context.
+func (m *MetadataCache) Statuses() ([]Status, error) {
+	return m.StatusesWithContext(context.Background())
+}
+
+// StatusByNameWithContext returns the status with the given name, matched case insensitive.
+func (m *MetadataCache) StatusByNameWithContext(ctx context.Context, name string) (*Status, error) {
+	statuses, err := m.StatusesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range statuses {
+		if strings.EqualFold(statuses[i].Name, name) {
+			return &statuses[i], nil
+		}
+	}
+	return nil, fmt.Errorf("No status with the name %q", name)
+}
+
+// StatusByName wraps StatusByNameWithContext using the background context.
+func (m *MetadataCache) StatusByName(name string) (*Status, error) {
+	return m.StatusByNameWithContext(context.Background(), name)
+}
+
+// PrioritiesWithContext returns the priorities of the JIRA instance.
+func (m *MetadataCache) PrioritiesWithContext(ctx context.Context) ([]Priority, error) {
+	value, err := m.load(ctx, metadataPriorities, func(ctx context.Context) (interface{}, error) {
+		priorities, _, err := m.client.Priority.GetListWithContext(ctx)
+		return priorities, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]Priority), nil
+}
+
+// Priorities wraps PrioritiesWithContext using the background context.
+func (m *MetadataCache) Priorities() ([]Priority, error) {
+	return m.PrioritiesWithContext(context.Background())
+}
+
+// PriorityByNameWithContext returns the priority with the given name, matched case insensitive.
+func (m *MetadataCache) PriorityByNameWithContext(ctx context.Context, name string) (*Priority, error) {
+	priorities, err := m.PrioritiesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range priorities {
+		if strings.EqualFold(priorities[i].Name, name) {
+			return &priorities[i], nil
+		}
+	}
+	return nil, fmt.Errorf("No priority with the name %q", name)
+}
+
+// PriorityByName wraps PriorityByNameWithContext using the background context.
+func (m *MetadataCache) PriorityByName(name string) (*Priority, error) {
+	return m.PriorityByNameWithContext(context.Background(), name)
+}
+
+// IssueTypesWithContext returns the issue types of the JIRA instance.
+func (m *MetadataCache) IssueTypesWithContext(ctx context.Context) ([]IssueType, error) {
+	value, err := m.load(ctx, metadataIssueTypes, func(ctx context.Context) (interface{}, error) {
+		issueTypes, _, err := m.client.IssueType.GetListWithContext(ctx)
+		return issueTypes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]IssueType), nil
+}
+
+// IssueTypes wraps IssueTypesWithContext using the background context.
+func (m *MetadataCache) IssueTypes() ([]IssueType, error) {
+	return m.IssueTypesWithContext(context.Background())
+}
+
+// IssueTypeByNameWithContext returns the issue type with the given name, matched case insensitive.
+// Several projects can have issue types of the same name, the first of them is returned.
+func (m *MetadataCache) IssueTypeByNameWithContext(ctx context.Context, name string) (*IssueType, error) {
+	issueTypes, err := m.IssueTypesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range issueTypes {
+		if strings.EqualFold(issueTypes[i].Name, name) {
+			return &issueTypes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("No issue type with the name %q", name)
+}
+
+// IssueTypeByName wraps IssueTypeByNameWithContext using the background context.
+func (m *MetadataCache) IssueTypeByName(name string) (*IssueType, error) {
+	return m.IssueTypeByNameWithContext(context.Background(), name)
+}
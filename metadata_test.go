@@ -0,0 +1,99 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMetadataCache_Fields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"customfield_10002","name":"Story Points","custom":true},
+			{"id":"customfield_10003","name":"Team","custom":true},{"id":"customfield_10004","name":"team","custom":true}]`)
+	})
+
+	id, err := testClient.Meta.FieldIDByName("story points")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if id != "customfield_10002" {
+		t.Errorf("Expected customfield_10002. Got %s", id)
+	}
+
+	name, err := testClient.Meta.FieldNameByID("summary")
+	if err != nil || name != "Summary" {
+		t.Errorf("Expected Summary. Got %s, %v", name, err)
+	}
+	if _, err := testClient.Meta.FieldIDByName("Team"); err == nil {
+		t.Error("Expected an error for an ambiguous name. Got none")
+	}
+	if _, err := testClient.Meta.FieldIDByName("Sprint"); err == nil {
+		t.Error("Expected an error for an unknown name. Got none")
+	}
+	if requests != 1 {
+		t.Errorf("Expected the fields to be loaded once. Got %d requests", requests)
+	}
+
+	testClient.Meta.Invalidate()
+	testClient.Meta.Fields()
+	if requests != 2 {
+		t.Errorf("Expected the fields to be loaded again after Invalidate. Got %d requests", requests)
+	}
+}
+
+func TestMetadataCache_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[{"id":"1","name":"Blocker"},{"id":"3","name":"Major"}]`)
+	})
+
+	testClient.Meta.TTL = time.Nanosecond
+	for i := 0; i < 2; i++ {
+		priority, err := testClient.Meta.PriorityByName("major")
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if priority == nil || priority.ID != "3" {
+			t.Errorf("Expected priority 3. Got %+v", priority)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if requests != 2 {
+		t.Errorf("Expected the priorities to be loaded again after the TTL. Got %d requests", requests)
+	}
+}
+
+func TestMetadataCache_StatusesAndIssueTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"1","name":"Open"},{"id":"10001","name":"Done"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"10000","name":"Epic"},{"id":"10001","name":"Story"}]`)
+	})
+
+	status, err := testClient.Meta.StatusByName("Done")
+	if err != nil || status.ID != "10001" {
+		t.Errorf("Expected status 10001. Got %+v, %v", status, err)
+	}
+	issueType, err := testClient.Meta.IssueTypeByName("story")
+	if err != nil || issueType.ID != "10001" {
+		t.Errorf("Expected issue type 10001. Got %+v, %v", issueType, err)
+	}
+	if _, err := testClient.Meta.StatusByName("Closed"); err == nil {
+		t.Error("Expected an error for an unknown status. Got none")
+	}
+}
@@ -1,6 +1,8 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -42,16 +44,79 @@ type MetaIssueType struct {
 	Fields      tcontainer.MarshalMap `json:"fields,omitempty"`
 }
 
-// GetCreateMeta makes the api call to get the meta information required to create a ticket
+// MetaField is the meta information about a field of an issue returned from the createmeta and editmeta api.
+// AllowedValues is set for fields which only accept certain values, e.g. select lists, versions or components.
+type MetaField struct {
+	FieldID         string                  `json:"fieldId,omitempty"`
+	Key             string                  `json:"key,omitempty"`
+	Name            string                  `json:"name,omitempty"`
+	Required        bool                    `json:"required"`
+	Schema          FieldSchema             `json:"schema,omitempty"`
+	AutoCompleteURL string                  `json:"autoCompleteUrl,omitempty"`
+	HasDefaultValue bool                    `json:"hasDefaultValue,omitempty"`
+	DefaultValue    interface{}             `json:"defaultValue,omitempty"`
+	Operations      []string                `json:"operations,omitempty"`
+	AllowedValues   []MetaFieldAllowedValue `json:"allowedValues,omitempty"`
+}
+
+// MetaFieldAllowedValue is a value a field accepts.
+// Options of select lists have a Value, other entities like versions or components a Name.
+// Children holds the options of the second level of cascading select lists.
+type MetaFieldAllowedValue struct {
+	Self        string                  `json:"self,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Key         string                  `json:"key,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Value       string                  `json:"value,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Disabled    bool                    `json:"disabled,omitempty"`
+	Children    []MetaFieldAllowedValue `json:"children,omitempty"`
+}
+
+// EditMetaInfo contains information about the fields of an issue which can be edited, keyed by field id.
+type EditMetaInfo struct {
+	Fields map[string]MetaField `json:"fields,omitempty"`
+}
+
+// CreateMetaPageOptions specifies the optional parameters to the paginated createmeta api
+type CreateMetaPageOptions struct {
+	// StartAt is the index of the first item to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of items to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// createMetaIssueTypesResult is a page of the issue types a project can create issues of
+type createMetaIssueTypesResult struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IssueTypes []*MetaIssueType `json:"issueTypes"`
+}
+
+// createMetaFieldsResult is a page of the fields of an issue type a project can create issues of
+type createMetaFieldsResult struct {
+	StartAt    int         `json:"startAt"`
+	MaxResults int         `json:"maxResults"`
+	Total      int         `json:"total"`
+	Fields     []MetaField `json:"fields"`
+}
+
+// GetCreateMetaWithContext makes the api call to get the meta information required to create a ticket
+func (s *IssueService) GetCreateMetaWithContext(ctx context.Context, projectkeys string) (*CreateMetaInfo, *Response, error) {
+	return s.GetCreateMetaWithOptionsWithContext(ctx, &GetQueryOptions{ProjectKeys: projectkeys, Expand: "projects.issuetypes.fields"})
+}
+
+// GetCreateMeta wraps GetCreateMetaWithContext using the background context.
 func (s *IssueService) GetCreateMeta(projectkeys string) (*CreateMetaInfo, *Response, error) {
-	return s.GetCreateMetaWithOptions(&GetQueryOptions{ProjectKeys: projectkeys, Expand: "projects.issuetypes.fields"})
+	return s.GetCreateMetaWithContext(context.Background(), projectkeys)
 }
 
-// GetCreateMetaWithOptions makes the api call to get the meta information without requiring to have a projectKey
-func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
+// GetCreateMetaWithOptionsWithContext makes the api call to get the meta information without requiring to have a projectKey
+func (s *IssueService) GetCreateMetaWithOptionsWithContext(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
 	apiEndpoint := "rest/api/2/issue/createmeta"
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,6 +138,95 @@ func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*Crea
 	return meta, resp, nil
 }
 
+// GetCreateMetaWithOptions wraps GetCreateMetaWithOptionsWithContext using the background context.
+func (s *IssueService) GetCreateMetaWithOptions(options *GetQueryOptions) (*CreateMetaInfo, *Response, error) {
+	return s.GetCreateMetaWithOptionsWithContext(context.Background(), options)
+}
+
+// GetCreateMetaIssueTypesWithContext returns a page of the issue types issues can be created of in the given project.
+// It replaces the createmeta api expanded with "projects.issuetypes" on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-createmeta-projectIdOrKey-issuetypes-get
+func (s *IssueService) GetCreateMetaIssueTypesWithContext(ctx context.Context, projectIDOrKey string, options *CreateMetaPageOptions) ([]*MetaIssueType, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes", projectIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(createMetaIssueTypesResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.IssueTypes, resp, nil
+}
+
+// GetCreateMetaIssueTypes wraps GetCreateMetaIssueTypesWithContext using the background context.
+func (s *IssueService) GetCreateMetaIssueTypes(projectIDOrKey string, options *CreateMetaPageOptions) ([]*MetaIssueType, *Response, error) {
+	return s.GetCreateMetaIssueTypesWithContext(context.Background(), projectIDOrKey, options)
+}
+
+// GetCreateMetaFieldsWithContext returns a page of the fields of the given issue type
+// which can be set when creating an issue in the given project.
+// It replaces the createmeta api expanded with "projects.issuetypes.fields" on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issue-createmeta-projectIdOrKey-issuetypes-issueTypeId-get
+func (s *IssueService) GetCreateMetaFieldsWithContext(ctx context.Context, projectIDOrKey, issueTypeID string, options *CreateMetaPageOptions) ([]MetaField, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/createmeta/%s/issuetypes/%s", projectIDOrKey, issueTypeID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(createMetaFieldsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Fields, resp, nil
+}
+
+// GetCreateMetaFields wraps GetCreateMetaFieldsWithContext using the background context.
+func (s *IssueService) GetCreateMetaFields(projectIDOrKey, issueTypeID string, options *CreateMetaPageOptions) ([]MetaField, *Response, error) {
+	return s.GetCreateMetaFieldsWithContext(context.Background(), projectIDOrKey, issueTypeID, options)
+}
+
+// GetEditMetaWithContext returns the fields of the given issue which can be edited, including their allowed values.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/issue-getEditIssueMeta
+func (s *IssueService) GetEditMetaWithContext(ctx context.Context, issueID string) (*EditMetaInfo, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/editmeta", issueID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := new(EditMetaInfo)
+	resp, err := s.client.Do(req, meta)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return meta, resp, nil
+}
+
+// GetEditMeta wraps GetEditMetaWithContext using the background context.
+func (s *IssueService) GetEditMeta(issueID string) (*EditMetaInfo, *Response, error) {
+	return s.GetEditMetaWithContext(context.Background(), issueID)
+}
+
 // GetProjectWithName returns a project with "name" from the meta information received. If not found, this returns nil.
 // The comparison of the name is case insensitive.
 func (m *CreateMetaInfo) GetProjectWithName(name string) *MetaProject {
@@ -156,6 +310,19 @@ func (t *MetaIssueType) GetAllFields() (map[string]string, error) {
 	return ret, nil
 }
 
+// GetMetaFields returns the typed meta information of all the fields of the MetaIssueType, keyed by field id.
+func (t *MetaIssueType) GetMetaFields() (map[string]MetaField, error) {
+	data, err := json.Marshal(t.Fields)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]MetaField)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
 // CheckCompleteAndAvailable checks if the given fields satisfies the mandatory field required to create a issue for the given type
 // And also if the given fields are available.
 func (t *MetaIssueType) CheckCompleteAndAvailable(config map[string]string) (bool, error) {
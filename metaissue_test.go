@@ -998,3 +998,135 @@ func TestCreateMetaInfo_GetProjectWithKey_NilForNonExistent(t *testing.T) {
 		t.Errorf("Expected nil, received value")
 	}
 }
+
+func TestIssueService_GetCreateMetaIssueTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/createmeta/EX/issuetypes"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=1")
+
+		fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":2,"issueTypes":[{"self":"https://my.jira.com/rest/api/2/issuetype/10001","id":"10001","name":"Bug","subtask":false}]}`)
+	})
+
+	issueTypes, resp, err := testClient.Issue.GetCreateMetaIssueTypes("EX", &CreateMetaPageOptions{MaxResults: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issueTypes) != 1 || issueTypes[0].Name != "Bug" {
+		t.Errorf("Expected issue type Bug. Got %+v", issueTypes)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total of 2. Got %d", resp.Total)
+	}
+}
+
+func TestIssueService_GetCreateMetaFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/createmeta/EX/issuetypes/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"fields":[
+			{"fieldId":"summary","key":"summary","name":"Summary","required":true,"schema":{"type":"string","system":"summary"},"operations":["set"]},
+			{"fieldId":"customfield_10123","key":"customfield_10123","name":"Service","required":false,
+				"schema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select","customId":10123},
+				"allowedValues":[{"self":"https://my.jira.com/rest/api/2/customFieldOption/10200","id":"10200","value":"Billing"}]}]}`)
+	})
+
+	fields, _, err := testClient.Issue.GetCreateMetaFields("EX", "10001", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields. Got %d", len(fields))
+	}
+	if !fields[0].Required || fields[1].Schema.CustomID != 10123 || fields[1].AllowedValues[0].Value != "Billing" {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+}
+
+func TestIssueService_GetEditMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/EX-1/editmeta"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		fmt.Fprint(w, `{"fields":{"customfield_10200":{"required":false,"schema":{"type":"option-with-child","custom":"com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect","customId":10200},
+			"name":"Region","key":"customfield_10200","operations":["set"],
+			"allowedValues":[{"id":"10300","value":"Europe","children":[{"id":"10301","value":"Germany"}]}]}}}`)
+	})
+
+	meta, _, err := testClient.Issue.GetEditMeta("EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	field, ok := meta.Fields["customfield_10200"]
+	if !ok {
+		t.Fatalf("Expected field customfield_10200. Got %+v", meta)
+	}
+	if field.Name != "Region" || field.AllowedValues[0].Children[0].Value != "Germany" {
+		t.Errorf("Unexpected field: %+v", field)
+	}
+}
+
+func TestMetaIssueType_GetMetaFields(t *testing.T) {
+	data := make(map[string]interface{})
+	data["summary"] = map[string]interface{}{
+		"required": true,
+		"name":     "Summary",
+		"schema":   map[string]interface{}{"type": "string", "system": "summary"},
+	}
+	data["priority"] = map[string]interface{}{
+		"required":      false,
+		"name":          "Priority",
+		"allowedValues": []interface{}{map[string]interface{}{"id": "1", "name": "Highest"}},
+	}
+
+	m := new(MetaIssueType)
+	m.Fields = data
+
+	fields, err := m.GetMetaFields()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !fields["summary"].Required || fields["summary"].Schema.System != "summary" {
+		t.Errorf("Unexpected summary field: %+v", fields["summary"])
+	}
+	if len(fields["priority"].AllowedValues) != 1 || fields["priority"].AllowedValues[0].Name != "Highest" {
+		t.Errorf("Unexpected priority field: %+v", fields["priority"])
+	}
+}
+
+func TestIssueService_GetCreateMetaWithOptions_IssueTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/createmeta"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=projects.issuetypes.fields&issuetypeNames=Bug&projectKeys=EX")
+
+		fmt.Fprint(w, `{"projects":[{"key":"EX","issuetypes":[{"id":"10001","name":"Bug"}]}]}`)
+	})
+
+	meta, _, err := testClient.Issue.GetCreateMetaWithOptions(&GetQueryOptions{ProjectKeys: "EX", IssueTypeNames: "Bug", Expand: "projects.issuetypes.fields"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project := meta.GetProjectWithKey("EX"); project == nil || project.GetIssueTypeWithName("Bug") == nil {
+		t.Errorf("Expected issue type Bug of project EX. Got %+v", meta)
+	}
+}
@@ -0,0 +1,247 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMetricsBuckets are the upper bounds in seconds of the buckets of the request duration histogram
+var DefaultMetricsBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Metrics collects the number, errors and durations of the requests sent by a client,
+// and the rate limits JIRA Cloud reports in the X-RateLimit-* headers of its responses.
+// It serves them in the Prometheus text format, so it can be scraped by Prometheus:
+//
+//	metrics := jira.NewMetrics()
+//	client, err := jira.NewClient("https://my.atlassian.net", jira.WithMetrics(metrics))
+//	http.Handle("/metrics/jira", metrics)
+//
+// The requests are labeled with their method and EndpointTemplate, and the status code of their response.
+type Metrics struct {
+	// Namespace prefixes the names of the metrics. Defaults to "jira".
+	Namespace string
+
+	// Buckets are the upper bounds of the buckets of the request duration histogram.
+	// Defaults to DefaultMetricsBuckets.
+	Buckets []float64
+
+	mu        sync.Mutex
+	requests  map[requestLabels]float64
+	errors    map[requestLabels]float64
+	durations map[requestLabels]*durationHistogram
+	rateLimit map[string]float64
+}
+
+// requestLabels are the labels of the request metrics
+type requestLabels struct {
+	method string
+	route  string
+	status string
+}
+
+// durationHistogram is a histogram of request durations
+type durationHistogram struct {
+	counts []float64
+	count  float64
+	sum    float64
+}
+
+// These constants are the headers JIRA Cloud reports its rate limits in
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRateLimitNearLimit = "X-RateLimit-NearLimit"
+)
+
+// NewMetrics returns Metrics with the default namespace and buckets.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// Middleware returns a Middleware collecting the metrics of every request.
+// A retried request is counted once per attempt.
+func (m *Metrics) Middleware() Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			m.observe(req, resp, err, time.Since(start))
+			return resp, err
+		}
+	}
+}
+
+// observe records a request and the response or error it resulted in
+func (m *Metrics) observe(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	labels := requestLabels{method: req.Method, route: EndpointTemplate(req.URL.Path), status: "error"}
+	if resp != nil {
+		labels.status = strconv.Itoa(resp.StatusCode)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.requests == nil {
+		m.requests = map[requestLabels]float64{}
+		m.errors = map[requestLabels]float64{}
+		m.durations = map[requestLabels]*durationHistogram{}
+		m.rateLimit = map[string]float64{}
+	}
+
+	m.requests[labels]++
+	if err != nil || CheckResponse(resp) != nil {
+		m.errors[labels]++
+	}
+
+	routeLabels := requestLabels{method: labels.method, route: labels.route}
+	histogram, ok := m.durations[routeLabels]
+	if !ok {
+		histogram = &durationHistogram{counts: make([]float64, len(m.buckets()))}
+		m.durations[routeLabels] = histogram
+	}
+	seconds := duration.Seconds()
+	for i, bound := range m.buckets() {
+		if seconds <= bound {
+			histogram.counts[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += seconds
+
+	if resp != nil {
+		m.observeRateLimit(resp.Header)
+	}
+}
+
+// observeRateLimit updates the rate limit gauges from the headers of a response
+func (m *Metrics) observeRateLimit(header http.Header) {
+	for name, key := range map[string]string{"limit": headerRateLimitLimit, "remaining": headerRateLimitRemaining} {
+		if value, err := strconv.ParseFloat(header.Get(key), 64); err == nil {
+			m.rateLimit[name] = value
+		}
+	}
+	if reset := header.Get(headerRateLimitReset); reset != "" {
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02T15:04Z"} {
+			if t, err := time.Parse(layout, reset); err == nil {
+				m.rateLimit["reset_timestamp_seconds"] = float64(t.Unix())
+				break
+			}
+		}
+	}
+	if nearLimit := header.Get(headerRateLimitNearLimit); nearLimit != "" {
+		m.rateLimit["near_limit"] = 0
+		if nearLimit == "true" {
+			m.rateLimit["near_limit"] = 1
+		}
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format to w.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+	ns := m.Namespace
+	if ns == "" {
+		ns = "jira"
+	}
+
+	writeCounter := func(name, help string, values map[requestLabels]float64) {
+		fmt.Fprintf(&b, "# HELP %s_%s %s\n# TYPE %s_%s counter\n", ns, name, help, ns, name)
+		keys := make([]requestLabels, 0, len(values))
+		for labels := range values {
+			keys = append(keys, labels)
+		}
+		for _, labels := range sortLabels(keys) {
+			fmt.Fprintf(&b, "%s_%s{%s} %s\n", ns, name, labels.format(), formatFloat(values[labels]))
+		}
+	}
+	writeCounter("requests_total", "Number of requests sent to the JIRA API.", m.requests)
+	writeCounter("request_errors_total", "Number of requests to the JIRA API which failed or returned an error status.", m.errors)
+
+	name := ns + "_request_duration_seconds"
+	fmt.Fprintf(&b, "# HELP %s Duration of the requests sent to the JIRA API.\n# TYPE %s histogram\n", name, name)
+	keys := make([]requestLabels, 0, len(m.durations))
+	for labels := range m.durations {
+		keys = append(keys, labels)
+	}
+	for _, labels := range sortLabels(keys) {
+		histogram := m.durations[labels]
+		for i, bound := range m.buckets() {
+			fmt.Fprintf(&b, "%s_bucket{%s,le=%q} %s\n", name, labels.format(), formatFloat(bound), formatFloat(histogram.counts[i]))
+		}
+		fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %s\n", name, labels.format(), formatFloat(histogram.count))
+		fmt.Fprintf(&b, "%s_sum{%s} %s\n", name, labels.format(), formatFloat(histogram.sum))
+		fmt.Fprintf(&b, "%s_count{%s} %s\n", name, labels.format(), formatFloat(histogram.count))
+	}
+
+	gauges := make([]string, 0, len(m.rateLimit))
+	for gauge := range m.rateLimit {
+		gauges = append(gauges, gauge)
+	}
+	sort.Strings(gauges)
+	for _, gauge := range gauges {
+		name := ns + "_rate_limit_" + gauge
+		fmt.Fprintf(&b, "# HELP %s Rate limit reported by the last response of the JIRA API.\n# TYPE %s gauge\n", name, name)
+		fmt.Fprintf(&b, "%s %s\n", name, formatFloat(m.rateLimit[gauge]))
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// buckets returns the upper bounds of the buckets of the request duration histogram
+func (m *Metrics) buckets() []float64 {
+	if len(m.Buckets) > 0 {
+		return m.Buckets
+	}
+	return DefaultMetricsBuckets
+}
+
+// format returns the labels in the Prometheus text format, without the status if it is empty
+func (l requestLabels) format() string {
+	formatted := fmt.Sprintf("method=%s,route=%s", quoteLabel(l.method), quoteLabel(l.route))
+	if l.status != "" {
+		formatted += ",status=" + quoteLabel(l.status)
+	}
+	return formatted
+}
+
+// sortLabels sorts labels by route, method and status and returns them
+func sortLabels(labels []requestLabels) []requestLabels {
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].route != labels[j].route {
+			return labels[i].route < labels[j].route
+		}
+		if labels[i].method != labels[j].method {
+			return labels[i].method < labels[j].method
+		}
+		return labels[i].status < labels[j].status
+	})
+	return labels
+}
+
+// quoteLabel quotes a label value as required by the Prometheus text format
+func quoteLabel(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	value = strings.Replace(value, "\n", `\n`, -1)
+	return `"` + value + `"`
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
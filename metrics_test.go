@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "7")
+		w.Header().Set("X-RateLimit-Reset", "2021-05-04T10:30Z")
+		w.Header().Set("X-RateLimit-NearLimit", "true")
+		w.Write([]byte(`{"key":"EX-1"}`))
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	metrics := &Metrics{Buckets: []float64{60}}
+	testClient.Middlewares = []Middleware{metrics.Middleware()}
+	for _, key := range []string{"EX-1", "EX-1", "EX-2"} {
+		req, _ := testClient.NewRequest("GET", "rest/api/2/issue/"+key, nil)
+		testClient.Do(req, nil)
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, nil)
+	body := recorder.Body.String()
+
+	for _, want := range []string{
+		`jira_requests_total{method="GET",route="/rest/api/2/issue/{key}",status="200"} 2`,
+		`jira_requests_total{method="GET",route="/rest/api/2/issue/{key}",status="404"} 1`,
+		`jira_request_errors_total{method="GET",route="/rest/api/2/issue/{key}",status="404"} 1`,
+		`jira_request_duration_seconds_bucket{method="GET",route="/rest/api/2/issue/{key}",le="60"} 3`,
+		`jira_request_duration_seconds_count{method="GET",route="/rest/api/2/issue/{key}"} 3`,
+		"jira_rate_limit_limit 100",
+		"jira_rate_limit_remaining 7",
+		"jira_rate_limit_near_limit 1",
+		"jira_rate_limit_reset_timestamp_seconds 1.6201242e+09",
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("Expected the metrics to contain %q. Got:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, `jira_request_errors_total{method="GET",route="/rest/api/2/issue/{key}",status="200"}`) {
+		t.Error("Expected successful requests not to be counted as errors")
+	}
+}
+
+func TestQuoteLabel(t *testing.T) {
+	if got := quoteLabel("a\"b\\c\nd"); got != `"a\"b\\c\nd"` {
+		t.Errorf("Unexpected quoted label %s", got)
+	}
+}
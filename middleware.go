@@ -0,0 +1,51 @@
+package jira
+
+import "net/http"
+
+// SendFunc sends a single HTTP request to JIRA and returns its response.
+type SendFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of requests, e.g. to log them, record metrics or add headers.
+// It is called for every attempt, so a retried request passes through it more than once.
+//
+//	client.Middlewares = append(client.Middlewares, func(next jira.SendFunc) jira.SendFunc {
+//		return func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next(req)
+//			log.Printf("%s %s took %s", req.Method, req.URL, time.Since(start))
+//			return resp, err
+//		}
+//	})
+type Middleware func(next SendFunc) SendFunc
+
+// OnRequest returns a Middleware calling hook with every request before it is sent.
+// The request is not sent if hook returns an error.
+func OnRequest(hook func(req *http.Request) error) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := hook(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// OnResponse returns a Middleware calling hook with every request along with the response or error it resulted in.
+func OnResponse(hook func(req *http.Request, resp *http.Response, err error)) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			hook(req, resp, err)
+			return resp, err
+		}
+	}
+}
+
+// chain wraps send in middlewares, the first of them being the outermost.
+func chain(send SendFunc, middlewares []Middleware) SendFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		send = middlewares[i](send)
+	}
+	return send
+}
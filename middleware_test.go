@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Do_Middlewares(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request-Id") != "42" {
+			t.Errorf("Expected X-Request-Id header 42. Got %s", r.Header.Get("X-Request-Id"))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var calls []string
+	testClient.Middlewares = []Middleware{
+		OnRequest(func(req *http.Request) error {
+			calls = append(calls, "request")
+			req.Header.Set("X-Request-Id", "42")
+			return nil
+		}),
+		OnResponse(func(req *http.Request, resp *http.Response, err error) {
+			calls = append(calls, "response")
+			if resp == nil || resp.StatusCode != http.StatusNoContent {
+				t.Errorf("Expected status 204. Got %+v", resp)
+			}
+		}),
+	}
+
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(calls) != 2 || calls[0] != "request" || calls[1] != "response" {
+		t.Errorf("Expected request and response hooks to be called in order. Got %v", calls)
+	}
+}
+
+func TestClient_Do_MiddlewareError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not have been sent")
+	})
+
+	testClient.Middlewares = []Middleware{
+		OnRequest(func(req *http.Request) error {
+			return errors.New("not allowed")
+		}),
+	}
+
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, nil); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
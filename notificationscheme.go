@@ -0,0 +1,256 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// NotificationSchemeService handles notification schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/notificationscheme
+type NotificationSchemeService struct {
+	client *Client
+}
+
+// These constants are the types of recipients of a notification
+const (
+	NotificationTypeCurrentAssignee  = "CurrentAssignee"
+	NotificationTypeReporter         = "Reporter"
+	NotificationTypeCurrentUser      = "CurrentUser"
+	NotificationTypeProjectLead      = "ProjectLead"
+	NotificationTypeComponentLead    = "ComponentLead"
+	NotificationTypeUser             = "User"
+	NotificationTypeGroup            = "Group"
+	NotificationTypeProjectRole      = "ProjectRole"
+	NotificationTypeEmailAddress     = "EmailAddress"
+	NotificationTypeAllWatchers      = "AllWatchers"
+	NotificationTypeUserCustomField  = "UserCustomField"
+	NotificationTypeGroupCustomField = "GroupCustomField"
+)
+
+// NotificationScheme represents a notification scheme, who is notified about which issue events in the projects using it.
+// The NotificationSchemeEvents are only returned when they are expanded.
+type NotificationScheme struct {
+	Expand                   string                    `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID                       int                       `json:"id,omitempty" structs:"id,omitempty"`
+	Self                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	Name                     string                    `json:"name,omitempty" structs:"name,omitempty"`
+	Description              string                    `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemeEvent lists the recipients of the notifications about an issue event
+type NotificationSchemeEvent struct {
+	Event         *NotificationEvent  `json:"event,omitempty" structs:"event,omitempty"`
+	Notifications []EventNotification `json:"notifications,omitempty" structs:"notifications,omitempty"`
+}
+
+// NotificationEvent represents an issue event, e.g. "Issue created".
+// Custom events are based on the TemplateEvent.
+type NotificationEvent struct {
+	ID            int                `json:"id,omitempty" structs:"id,omitempty"`
+	Name          string             `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string             `json:"description,omitempty" structs:"description,omitempty"`
+	TemplateEvent *NotificationEvent `json:"templateEvent,omitempty" structs:"templateEvent,omitempty"`
+}
+
+// EventNotification represents a recipient of the notifications about an issue event.
+// NotificationType is one of the NotificationType constants, Parameter identifies e.g. the group or the project role.
+// Depending on the NotificationType, Group, Field, ProjectRole, User or EmailAddress describe the recipient when they are expanded.
+type EventNotification struct {
+	Expand           string     `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID               int        `json:"id,omitempty" structs:"id,omitempty"`
+	NotificationType string     `json:"notificationType,omitempty" structs:"notificationType,omitempty"`
+	Parameter        string     `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	Group            *UserGroup `json:"group,omitempty" structs:"group,omitempty"`
+	Field            *Field     `json:"field,omitempty" structs:"field,omitempty"`
+	EmailAddress     string     `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	ProjectRole      *Role      `json:"projectRole,omitempty" structs:"projectRole,omitempty"`
+	User             *User      `json:"user,omitempty" structs:"user,omitempty"`
+}
+
+// NotificationSchemeListOptions specifies the optional parameters to the NotificationSchemeService.GetList method
+type NotificationSchemeListOptions struct {
+	// Expand is a comma separated list of additional information to include, e.g. "all"
+	Expand string `url:"expand,omitempty"`
+	// StartAt is the index of the first notification scheme to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of notification schemes to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// NotificationSchemeGetOptions specifies the optional parameters to the NotificationSchemeService.Get method
+type NotificationSchemeGetOptions struct {
+	// Expand is a comma separated list of additional information to include, e.g. "all"
+	Expand string `url:"expand,omitempty"`
+}
+
+// notificationSchemeListResult is a page of notification schemes
+type notificationSchemeListResult struct {
+	StartAt    int                  `json:"startAt"`
+	MaxResults int                  `json:"maxResults"`
+	Total      int                  `json:"total"`
+	IsLast     bool                 `json:"isLast"`
+	Schemes    []NotificationScheme `json:"values"`
+}
+
+// NotificationSchemeOptions are passed to the NotificationSchemeService.Create function to create a new notification scheme.
+// They are passed to NotificationSchemeService.Update as well, where only the Name and the Description are changed.
+type NotificationSchemeOptions struct {
+	Name                     string                           `json:"name,omitempty" structs:"name,omitempty"`
+	Description              string                           `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEventOptions `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+}
+
+// NotificationSchemeEventOptions describes the recipients of the notifications about the issue event with the given id
+type NotificationSchemeEventOptions struct {
+	Event         NotificationEventID   `json:"event" structs:"event"`
+	Notifications []NotificationOptions `json:"notifications" structs:"notifications"`
+}
+
+// NotificationEventID identifies an issue event
+type NotificationEventID struct {
+	ID string `json:"id" structs:"id"`
+}
+
+// NotificationOptions describes a recipient of notifications.
+// NotificationType is one of the NotificationType constants.
+type NotificationOptions struct {
+	NotificationType string `json:"notificationType" structs:"notificationType"`
+	Parameter        string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+}
+
+// notificationSchemeCreateResult is returned by JIRA when a notification scheme was created
+type notificationSchemeCreateResult struct {
+	ID string `json:"id"`
+}
+
+// GetListWithContext returns a page of the notification schemes.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/notificationscheme-getNotificationSchemes
+func (s *NotificationSchemeService) GetListWithContext(ctx context.Context, options *NotificationSchemeListOptions) ([]NotificationScheme, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/notificationscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(notificationSchemeListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Schemes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *NotificationSchemeService) GetList(options *NotificationSchemeListOptions) ([]NotificationScheme, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the notification scheme for a given notification scheme id.
+// Expand "all" to get the events and their recipients.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/notificationscheme-getNotificationScheme
+func (s *NotificationSchemeService) GetWithContext(ctx context.Context, schemeID int, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/notificationscheme/%d", schemeID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *NotificationSchemeService) Get(schemeID int, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID, options)
+}
+
+// GetForProjectWithContext returns the notification scheme used by the project for a given project id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectKeyOrId}/notificationscheme-getNotificationScheme
+func (s *NotificationSchemeService) GetForProjectWithContext(ctx context.Context, projectIDOrKey string, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/project/%s/notificationscheme", projectIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetForProject wraps GetForProjectWithContext using the background context.
+func (s *NotificationSchemeService) GetForProject(projectIDOrKey string, options *NotificationSchemeGetOptions) (*NotificationScheme, *Response, error) {
+	return s.GetForProjectWithContext(context.Background(), projectIDOrKey, options)
+}
+
+// CreateWithContext creates a notification scheme and returns its id.
+// Creating notification schemes is only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-notificationscheme-post
+func (s *NotificationSchemeService) CreateWithContext(ctx context.Context, options *NotificationSchemeOptions) (string, *Response, error) {
+	apiEndpoint := restAPIBase + "/notificationscheme"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := new(notificationSchemeCreateResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	return result.ID, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *NotificationSchemeService) Create(options *NotificationSchemeOptions) (string, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext changes the name and the description of the notification scheme for a given notification scheme id.
+// Updating notification schemes is only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-notificationscheme-id-put
+func (s *NotificationSchemeService) UpdateWithContext(ctx context.Context, schemeID int, options *NotificationSchemeOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/notificationscheme/%d", restAPIBase, schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &NotificationSchemeOptions{Name: options.Name, Description: options.Description})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *NotificationSchemeService) Update(schemeID int, options *NotificationSchemeOptions) (*Response, error) {
+	return s.UpdateWithContext(context.Background(), schemeID, options)
+}
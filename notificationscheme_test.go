@@ -0,0 +1,140 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestNotificationSchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=all&maxResults=1")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":10100,"name":"Default Notification Scheme",
+			"notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created"},"notifications":[
+				{"id":1,"notificationType":"Group","parameter":"jira-administrators","group":{"name":"jira-administrators"}},
+				{"id":2,"notificationType":"CurrentAssignee"}]}]}]}`)
+	})
+
+	schemes, resp, err := testClient.NotificationScheme.GetList(&NotificationSchemeListOptions{Expand: "all", MaxResults: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || len(schemes[0].NotificationSchemeEvents) != 1 {
+		t.Fatalf("Expected 1 scheme with 1 event. Got %+v", schemes)
+	}
+	event := schemes[0].NotificationSchemeEvents[0]
+	if event.Event.Name != "Issue created" || len(event.Notifications) != 2 || event.Notifications[0].Group.Name != "jira-administrators" {
+		t.Errorf("Expected Issue created with 2 recipients. Got %+v", event)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total 2. Got %d", resp.Total)
+	}
+}
+
+func TestNotificationSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/notificationscheme/10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=all")
+		fmt.Fprint(w, `{"id":10100,"name":"Default Notification Scheme","notificationSchemeEvents":[
+			{"event":{"id":20,"name":"Custom event","templateEvent":{"id":1,"name":"Issue created"}},
+			"notifications":[{"id":3,"notificationType":"EmailAddress","parameter":"ops@example.com","emailAddress":"ops@example.com"}]}]}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.Get(10100, &NotificationSchemeGetOptions{Expand: "all"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || len(scheme.NotificationSchemeEvents) != 1 {
+		t.Fatalf("Expected scheme with 1 event. Got %+v", scheme)
+	}
+	event := scheme.NotificationSchemeEvents[0]
+	if event.Event.TemplateEvent.ID != 1 || event.Notifications[0].EmailAddress != "ops@example.com" {
+		t.Errorf("Unexpected event %+v", event)
+	}
+}
+
+func TestNotificationSchemeService_GetForProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/notificationscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10100,"name":"Default Notification Scheme"}`)
+	})
+
+	scheme, _, err := testClient.NotificationScheme.GetForProject("ABC", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 10100 {
+		t.Errorf("Expected scheme 10100. Got %+v", scheme)
+	}
+}
+
+func TestNotificationSchemeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/notificationscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		options := new(NotificationSchemeOptions)
+		json.NewDecoder(r.Body).Decode(options)
+		if options.Name != "Quiet" || len(options.NotificationSchemeEvents) != 1 || options.NotificationSchemeEvents[0].Event.ID != "1" {
+			t.Errorf("Unexpected options %+v", options)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10101"}`)
+	})
+
+	options := &NotificationSchemeOptions{
+		Name: "Quiet",
+		NotificationSchemeEvents: []NotificationSchemeEventOptions{
+			{Event: NotificationEventID{ID: "1"}, Notifications: []NotificationOptions{{NotificationType: NotificationTypeReporter}}},
+		},
+	}
+	schemeID, _, err := testClient.NotificationScheme.Create(options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if schemeID != "10101" {
+		t.Errorf("Expected scheme id 10101. Got %s", schemeID)
+	}
+}
+
+func TestNotificationSchemeService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/notificationscheme/10101"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"Silent","description":"No emails"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.NotificationScheme.Update(10101, &NotificationSchemeOptions{Name: "Silent", Description: "No emails"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
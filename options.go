@@ -0,0 +1,190 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClientOption configures a Client created by NewClient.
+//
+//	client, err := jira.NewClient("https://my.jira.com",
+//		jira.WithHTTPClient(tp.Client()),
+//		jira.WithUserAgent("my-tool/1.0"),
+//		jira.WithRequestTimeout(30*time.Second),
+//	)
+type ClientOption func(*clientOptions) error
+
+// clientOptions holds the configuration collected from the ClientOptions passed to NewClient.
+type clientOptions struct {
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	userAgent   string
+	header      http.Header
+	apiVersion  int
+	dialect     Dialect
+	retryPolicy *RetryPolicy
+	rateLimiter RateLimiter
+	middlewares []Middleware
+	cache       ResponseCache
+	contextPath string
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the API, e.g. one of the authentication transports.
+// http.DefaultClient is used if it is not set.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport the HTTP client sends requests with.
+// The HTTP client is copied, so the client passed to WithHTTPClient is not modified.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) error {
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of all requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithHeader adds a header to all requests, e.g. to pass an API gateway.
+// It can be given more than once, also for the same key.
+func WithHeader(key, value string) ClientOption {
+	return func(o *clientOptions) error {
+		o.header.Add(key, value)
+		return nil
+	}
+}
+
+// WithContextPath sets the path JIRA is served at below the base URL, e.g. /jira behind a reverse proxy
+// serving it at https://example.com/jira. It is the same as passing https://example.com/jira to NewClient.
+// All endpoints, also those starting with /rest, are resolved below it.
+func WithContextPath(contextPath string) ClientOption {
+	return func(o *clientOptions) error {
+		o.contextPath = contextPath
+		return nil
+	}
+}
+
+// WithAPIVersion sets the version of the REST API, e.g. 2 for /rest/api/2,
+// which is otherwise selected by the Dialect of the client.
+func WithAPIVersion(version int) ClientOption {
+	return func(o *clientOptions) error {
+		if version != 2 && version != 3 {
+			return fmt.Errorf("Unsupported REST API version %d, expected 2 or 3", version)
+		}
+		o.apiVersion = version
+		return nil
+	}
+}
+
+// WithDialect sets the variant of the REST API the services use, which is otherwise detected from the JIRA instance.
+func WithDialect(dialect Dialect) ClientOption {
+	return func(o *clientOptions) error {
+		if dialect != DialectAuto && dialect != DialectCloud && dialect != DialectServer {
+			return fmt.Errorf("Unsupported dialect %d", dialect)
+		}
+		o.dialect = dialect
+		return nil
+	}
+}
+
+// WithRetryPolicy retries failed requests as allowed by policy, see RetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(o *clientOptions) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimiter throttles all requests of the client, including retries, with limiter.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(o *clientOptions) error {
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRequestTimeout limits the time of each request, including reading its response.
+// The HTTP client is copied, so the client passed to WithHTTPClient is not modified.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		if timeout < 0 {
+			return fmt.Errorf("Invalid request timeout %s", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithLogger logs every request of the client with logger, see LogRequests.
+func WithLogger(logger Logger, options *LogOptions) ClientOption {
+	return func(o *clientOptions) error {
+		o.middlewares = append(o.middlewares, LogRequests(logger, options))
+		return nil
+	}
+}
+
+// WithTracer traces every request of the client with tracer and records their durations, see Trace.
+// durations may be nil.
+func WithTracer(tracer Tracer, durations DurationRecorder) ClientOption {
+	return func(o *clientOptions) error {
+		o.middlewares = append(o.middlewares, Trace(tracer, durations))
+		return nil
+	}
+}
+
+// WithMetrics collects the metrics of every request of the client in metrics, see Metrics.
+func WithMetrics(metrics *Metrics) ClientOption {
+	return func(o *clientOptions) error {
+		o.middlewares = append(o.middlewares, metrics.Middleware())
+		return nil
+	}
+}
+
+// WithCache caches the responses of GET requests in cache, see ResponseCache.
+func WithCache(cache ResponseCache) ClientOption {
+	return func(o *clientOptions) error {
+		o.cache = cache
+		return nil
+	}
+}
+
+// WithCompression asks JIRA for gzip compressed responses and compresses request bodies
+// of at least minBodySize bytes, see Compression.
+func WithCompression(minBodySize int) ClientOption {
+	return func(o *clientOptions) error {
+		o.middlewares = append(o.middlewares, Compression(minBodySize))
+		return nil
+	}
+}
+
+// client returns the HTTP client configured by the options.
+func (o *clientOptions) client() *http.Client {
+	httpClient := o.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if o.transport == nil && o.timeout == 0 {
+		return httpClient
+	}
+
+	configured := *httpClient
+	if o.transport != nil {
+		configured.Transport = o.transport
+	}
+	if o.timeout > 0 {
+		configured.Timeout = o.timeout
+	}
+	return &configured
+}
@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClient_WithTransportAndTimeout(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &recordingTransport{}
+	c, err := NewClient(testJIRAInstanceURL, WithHTTPClient(httpClient), WithTransport(transport), WithRequestTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("Got an error: %s", err)
+	}
+
+	if c.client == httpClient {
+		t.Error("Expected a copy of the HTTP client")
+	}
+	if c.client.Transport != transport || c.client.Timeout != 5*time.Second {
+		t.Errorf("Expected the transport and timeout to be set. Got %+v", c.client)
+	}
+	if httpClient.Transport != nil || httpClient.Timeout != 0 {
+		t.Errorf("Expected the given HTTP client to be unchanged. Got %+v", httpClient)
+	}
+}
+
+func TestNewClient_WithUserAgentAndHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "my-tool/1.0" {
+			t.Errorf("Expected the User-Agent my-tool/1.0. Got %q", got)
+		}
+		if got := r.Header["X-Request-Source"]; len(got) != 2 || got[0] != "a" || got[1] != "b" {
+			t.Errorf("Expected the header X-Request-Source a and b. Got %v", got)
+		}
+	})
+
+	c, err := NewClient(testServer.URL, WithUserAgent("my-tool/1.0"), WithHeader("X-Request-Source", "a"), WithHeader("X-Request-Source", "b"))
+	if err != nil {
+		t.Fatalf("Got an error: %s", err)
+	}
+	req, _ := c.NewRequest("GET", "rest/api/2/serverInfo", nil)
+	if _, err := c.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestNewClient_WithAPIVersion(t *testing.T) {
+	c, err := NewClient(testJIRAInstanceURL, WithAPIVersion(2))
+	if err != nil {
+		t.Fatalf("Got an error: %s", err)
+	}
+	c.Dialect = DialectCloud
+	if got := c.apiBase(context.Background()); got != "/rest/api/2" {
+		t.Errorf("Expected /rest/api/2. Got %s", got)
+	}
+
+	if _, err := NewClient(testJIRAInstanceURL, WithAPIVersion(4)); err == nil {
+		t.Error("Expected an error for an unsupported version. Got none")
+	}
+	if _, err := NewClient(testJIRAInstanceURL, WithRequestTimeout(-time.Second)); err == nil {
+		t.Error("Expected an error for a negative timeout. Got none")
+	}
+}
+
+func TestNewClient_WithRetryPolicyRateLimiterAndDialect(t *testing.T) {
+	policy := NewRetryPolicy(3, time.Minute)
+	limiter := &testRateLimiter{}
+	c, err := NewClient(testJIRAInstanceURL, WithRetryPolicy(policy), WithRateLimiter(limiter), WithDialect(DialectServer))
+	if err != nil {
+		t.Fatalf("Got an error: %s", err)
+	}
+	if c.RetryPolicy != policy || c.RateLimiter != limiter || c.Dialect != DialectServer {
+		t.Errorf("Expected the retry policy, rate limiter and dialect to be set. Got %+v", c)
+	}
+
+	if _, err := NewClient(testJIRAInstanceURL, WithDialect(Dialect(7))); err == nil {
+		t.Error("Expected an error for an unsupported dialect. Got none")
+	}
+}
+
+func TestNewClient_WithContextPath(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var paths []string
+	testMux.HandleFunc("/jira/", func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected the request below the context path, got %s", r.URL.Path)
+	})
+
+	for _, client := range []*Client{
+		func() *Client { c, _ := NewClient(testServer.URL, WithContextPath("/jira")); return c }(),
+		func() *Client { c, _ := NewClient(testServer.URL + "/jira"); return c }(),
+	} {
+		client.Dialect = DialectServer
+		paths = nil
+
+		if _, err := client.User.Delete("fred"); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if _, err := client.Field.DeleteContext("customfield_10000", "10100"); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		req, _ := client.NewRequest("DELETE", "/rest/api/2/project/a%2Fb/role/10002", nil)
+		if _, err := client.Do(req, nil); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+
+		want := []string{
+			"/jira/rest/api/2/user",
+			"/jira/rest/api/3/field/customfield_10000/context/10100",
+			"/jira/rest/api/2/project/a%2Fb/role/10002",
+		}
+		if !reflect.DeepEqual(paths, want) {
+			t.Errorf("Expected %v, got %v", want, paths)
+		}
+	}
+}
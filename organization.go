@@ -0,0 +1,350 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Organization represents an organization of customers of JIRA Service Management.
+// Organizations are added to service desks to let all of their customers raise requests.
+type Organization struct {
+	ID    string                `json:"id,omitempty" structs:"id,omitempty"`
+	Name  string                `json:"name,omitempty" structs:"name,omitempty"`
+	Links *CustomerRequestLinks `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// OrganizationsList reflects a page of organizations
+type OrganizationsList struct {
+	Size       int            `json:"size" structs:"size"`
+	Start      int            `json:"start" structs:"start"`
+	Limit      int            `json:"limit" structs:"limit"`
+	IsLastPage bool           `json:"isLastPage" structs:"isLastPage"`
+	Values     []Organization `json:"values" structs:"values"`
+}
+
+// ServiceDeskUsersList reflects a page of users, e.g. the customers of a service desk or the members of an organization
+type ServiceDeskUsersList struct {
+	Size       int    `json:"size" structs:"size"`
+	Start      int    `json:"start" structs:"start"`
+	Limit      int    `json:"limit" structs:"limit"`
+	IsLastPage bool   `json:"isLastPage" structs:"isLastPage"`
+	Values     []User `json:"values" structs:"values"`
+}
+
+// serviceDeskOrganization is the request of the ServiceDeskService.AddOrganization and RemoveOrganization methods
+type serviceDeskOrganization struct {
+	OrganizationID int `json:"organizationId"`
+}
+
+// users returns the request body identifying the given users in the dialect of the client:
+// by their account ids on JIRA Cloud and their usernames on JIRA Server.
+func (s *ServiceDeskService) users(ctx context.Context, users []string) map[string][]string {
+	if s.client.dialect(ctx).UsesAccountID() {
+		return map[string][]string{"accountIds": users}
+	}
+	return map[string][]string{"usernames": users}
+}
+
+// GetOrganizationsWithContext returns a page of the organizations of the JIRA instance.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization-getOrganizations
+func (s *ServiceDeskService) GetOrganizationsWithContext(ctx context.Context, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/organization", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(OrganizationsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetOrganizations wraps GetOrganizationsWithContext using the background context.
+func (s *ServiceDeskService) GetOrganizations(options *ServiceDeskListOptions) (*OrganizationsList, *Response, error) {
+	return s.GetOrganizationsWithContext(context.Background(), options)
+}
+
+// GetOrganizationWithContext returns the organization for a given organization id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization-getOrganization
+func (s *ServiceDeskService) GetOrganizationWithContext(ctx context.Context, organizationID string) (*Organization, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s", organizationID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization := new(Organization)
+	resp, err := s.client.Do(req, organization)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return organization, resp, nil
+}
+
+// GetOrganization wraps GetOrganizationWithContext using the background context.
+func (s *ServiceDeskService) GetOrganization(organizationID string) (*Organization, *Response, error) {
+	return s.GetOrganizationWithContext(context.Background(), organizationID)
+}
+
+// CreateOrganizationWithContext creates an organization with the given name.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization-createOrganization
+func (s *ServiceDeskService) CreateOrganizationWithContext(ctx context.Context, name string) (*Organization, *Response, error) {
+	apiEndpoint := "rest/servicedeskapi/organization"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &Organization{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization := new(Organization)
+	resp, err := s.client.Do(req, organization)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return organization, resp, nil
+}
+
+// CreateOrganization wraps CreateOrganizationWithContext using the background context.
+func (s *ServiceDeskService) CreateOrganization(name string) (*Organization, *Response, error) {
+	return s.CreateOrganizationWithContext(context.Background(), name)
+}
+
+// DeleteOrganizationWithContext deletes an organization. Its customers are not deleted.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization-deleteOrganization
+func (s *ServiceDeskService) DeleteOrganizationWithContext(ctx context.Context, organizationID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s", organizationID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteOrganization wraps DeleteOrganizationWithContext using the background context.
+func (s *ServiceDeskService) DeleteOrganization(organizationID string) (*Response, error) {
+	return s.DeleteOrganizationWithContext(context.Background(), organizationID)
+}
+
+// GetOrganizationUsersWithContext returns a page of the members of an organization.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization/{organizationId}/user-getUsersInOrganization
+func (s *ServiceDeskService) GetOrganizationUsersWithContext(ctx context.Context, organizationID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/organization/%s/user", organizationID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getUsers(ctx, apiEndpoint)
+}
+
+// GetOrganizationUsers wraps GetOrganizationUsersWithContext using the background context.
+func (s *ServiceDeskService) GetOrganizationUsers(organizationID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error) {
+	return s.GetOrganizationUsersWithContext(context.Background(), organizationID, options)
+}
+
+// AddOrganizationUsersWithContext adds customers to an organization.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization/{organizationId}/user-addUsersToOrganization
+func (s *ServiceDeskService) AddOrganizationUsersWithContext(ctx context.Context, organizationID string, users ...string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/user", organizationID)
+	return s.send(ctx, "POST", apiEndpoint, s.users(ctx, users))
+}
+
+// AddOrganizationUsers wraps AddOrganizationUsersWithContext using the background context.
+func (s *ServiceDeskService) AddOrganizationUsers(organizationID string, users ...string) (*Response, error) {
+	return s.AddOrganizationUsersWithContext(context.Background(), organizationID, users...)
+}
+
+// RemoveOrganizationUsersWithContext removes customers from an organization.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/organization/{organizationId}/user-removeUsersFromOrganization
+func (s *ServiceDeskService) RemoveOrganizationUsersWithContext(ctx context.Context, organizationID string, users ...string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/organization/%s/user", organizationID)
+	return s.send(ctx, "DELETE", apiEndpoint, s.users(ctx, users))
+}
+
+// RemoveOrganizationUsers wraps RemoveOrganizationUsersWithContext using the background context.
+func (s *ServiceDeskService) RemoveOrganizationUsers(organizationID string, users ...string) (*Response, error) {
+	return s.RemoveOrganizationUsersWithContext(context.Background(), organizationID, users...)
+}
+
+// GetServiceDeskOrganizationsWithContext returns a page of the organizations of a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/organization-getOrganizations
+func (s *ServiceDeskService) GetServiceDeskOrganizationsWithContext(ctx context.Context, serviceDeskID string, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/organization", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(OrganizationsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetServiceDeskOrganizations wraps GetServiceDeskOrganizationsWithContext using the background context.
+func (s *ServiceDeskService) GetServiceDeskOrganizations(serviceDeskID string, options *ServiceDeskListOptions) (*OrganizationsList, *Response, error) {
+	return s.GetServiceDeskOrganizationsWithContext(context.Background(), serviceDeskID, options)
+}
+
+// AddOrganizationWithContext adds an organization to a service desk, so its customers can raise requests on the service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/organization-addOrganization
+func (s *ServiceDeskService) AddOrganizationWithContext(ctx context.Context, serviceDeskID, organizationID string) (*Response, error) {
+	return s.updateOrganization(ctx, "POST", serviceDeskID, organizationID)
+}
+
+// AddOrganization wraps AddOrganizationWithContext using the background context.
+func (s *ServiceDeskService) AddOrganization(serviceDeskID, organizationID string) (*Response, error) {
+	return s.AddOrganizationWithContext(context.Background(), serviceDeskID, organizationID)
+}
+
+// RemoveOrganizationWithContext removes an organization from a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/organization-removeOrganization
+func (s *ServiceDeskService) RemoveOrganizationWithContext(ctx context.Context, serviceDeskID, organizationID string) (*Response, error) {
+	return s.updateOrganization(ctx, "DELETE", serviceDeskID, organizationID)
+}
+
+// RemoveOrganization wraps RemoveOrganizationWithContext using the background context.
+func (s *ServiceDeskService) RemoveOrganization(serviceDeskID, organizationID string) (*Response, error) {
+	return s.RemoveOrganizationWithContext(context.Background(), serviceDeskID, organizationID)
+}
+
+// updateOrganization adds (POST) or removes (DELETE) an organization of a service desk.
+// The API expects the id of the organization as number.
+func (s *ServiceDeskService) updateOrganization(ctx context.Context, method, serviceDeskID, organizationID string) (*Response, error) {
+	id, err := strconv.Atoi(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/organization", serviceDeskID)
+	return s.send(ctx, method, apiEndpoint, &serviceDeskOrganization{OrganizationID: id})
+}
+
+// GetCustomersWithContext returns a page of the customers of a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/customer-getCustomers
+func (s *ServiceDeskService) GetCustomersWithContext(ctx context.Context, serviceDeskID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/customer", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getUsers(ctx, apiEndpoint)
+}
+
+// GetCustomers wraps GetCustomersWithContext using the background context.
+func (s *ServiceDeskService) GetCustomers(serviceDeskID string, options *ServiceDeskListOptions) (*ServiceDeskUsersList, *Response, error) {
+	return s.GetCustomersWithContext(context.Background(), serviceDeskID, options)
+}
+
+// AddCustomersWithContext adds customers to a service desk.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/customer-addCustomers
+func (s *ServiceDeskService) AddCustomersWithContext(ctx context.Context, serviceDeskID string, users ...string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/customer", serviceDeskID)
+	return s.send(ctx, "POST", apiEndpoint, s.users(ctx, users))
+}
+
+// AddCustomers wraps AddCustomersWithContext using the background context.
+func (s *ServiceDeskService) AddCustomers(serviceDeskID string, users ...string) (*Response, error) {
+	return s.AddCustomersWithContext(context.Background(), serviceDeskID, users...)
+}
+
+// RemoveCustomersWithContext removes customers from a service desk.
+// Users are identified by their account id on JIRA Cloud and their username on JIRA Server.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/customer-removeCustomers
+func (s *ServiceDeskService) RemoveCustomersWithContext(ctx context.Context, serviceDeskID string, users ...string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/customer", serviceDeskID)
+	return s.send(ctx, "DELETE", apiEndpoint, s.users(ctx, users))
+}
+
+// RemoveCustomers wraps RemoveCustomersWithContext using the background context.
+func (s *ServiceDeskService) RemoveCustomers(serviceDeskID string, users ...string) (*Response, error) {
+	return s.RemoveCustomersWithContext(context.Background(), serviceDeskID, users...)
+}
+
+// CreateCustomerWithContext creates a customer, which is not added to any service desk or organization.
+// The customer has no access to JIRA apart from the customer portal.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/customer-createCustomer
+func (s *ServiceDeskService) CreateCustomerWithContext(ctx context.Context, email, displayName string) (*User, *Response, error) {
+	// JIRA Server still calls the display name the full name
+	nameKey := "displayName"
+	if s.client.dialect(ctx) == DialectServer {
+		nameKey = "fullName"
+	}
+
+	apiEndpoint := "rest/servicedeskapi/customer"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, map[string]string{"email": email, nameKey: displayName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return user, resp, nil
+}
+
+// CreateCustomer wraps CreateCustomerWithContext using the background context.
+func (s *ServiceDeskService) CreateCustomer(email, displayName string) (*User, *Response, error) {
+	return s.CreateCustomerWithContext(context.Background(), email, displayName)
+}
+
+// getUsers requests a page of users from the given endpoint.
+func (s *ServiceDeskService) getUsers(ctx context.Context, apiEndpoint string) (*ServiceDeskUsersList, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ServiceDeskUsersList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// send sends body to the given endpoint, which returns no content.
+func (s *ServiceDeskService) send(ctx context.Context, method, apiEndpoint string, body interface{}) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,230 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetOrganizations(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/organization"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?start=1")
+		fmt.Fprint(w, `{"size":1,"start":1,"limit":1,"isLastPage":false,"values":[{"id":"1","name":"Charlie Cakes Franchises"}]}`)
+	})
+
+	organizations, _, err := testClient.ServiceDesk.GetOrganizations(&ServiceDeskListOptions{Start: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if organizations == nil || organizations.IsLastPage || len(organizations.Values) != 1 || organizations.Values[0].Name != "Charlie Cakes Franchises" {
+		t.Errorf("Expected organization Charlie Cakes Franchises. Got %+v", organizations)
+	}
+}
+
+func TestServiceDeskService_GetOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/organization/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"1","name":"Charlie Cakes Franchises"}`)
+	})
+
+	organization, _, err := testClient.ServiceDesk.GetOrganization("1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if organization == nil || organization.ID != "1" {
+		t.Errorf("Expected organization 1. Got %+v", organization)
+	}
+}
+
+func TestServiceDeskService_CreateOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/organization"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"Charlie Chocolate Franchises"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"2","name":"Charlie Chocolate Franchises"}`)
+	})
+
+	organization, _, err := testClient.ServiceDesk.CreateOrganization("Charlie Chocolate Franchises")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if organization == nil || organization.ID != "2" {
+		t.Errorf("Expected organization 2. Got %+v", organization)
+	}
+}
+
+func TestServiceDeskService_DeleteOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/organization/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.ServiceDesk.DeleteOrganization("1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestServiceDeskService_OrganizationUsers(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testAPIEndpoint := "/rest/servicedeskapi/organization/1/user"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testAPIEndpoint)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"accountId":"qm:1","displayName":"Fred F. User"}]}`)
+		case "POST", "DELETE":
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"accountIds":["qm:1","qm:2"]}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	users, _, err := testClient.ServiceDesk.GetOrganizationUsers("1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if users == nil || len(users.Values) != 1 || users.Values[0].AccountID != "qm:1" {
+		t.Errorf("Expected member Fred. Got %+v", users)
+	}
+	if _, err := testClient.ServiceDesk.AddOrganizationUsers("1", "qm:1", "qm:2"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.ServiceDesk.RemoveOrganizationUsers("1", "qm:1", "qm:2"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestServiceDeskService_ServiceDeskOrganizations(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/organization"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testAPIEndpoint)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","name":"Charlie Cakes Franchises"}]}`)
+		case "POST", "DELETE":
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"organizationId":1}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	organizations, _, err := testClient.ServiceDesk.GetServiceDeskOrganizations("10", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if organizations == nil || len(organizations.Values) != 1 {
+		t.Errorf("Expected 1 organization. Got %+v", organizations)
+	}
+	if _, err := testClient.ServiceDesk.AddOrganization("10", "1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.ServiceDesk.RemoveOrganization("10", "1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.ServiceDesk.AddOrganization("10", "one"); err == nil {
+		t.Error("Expected an error for an organization id which is not a number")
+	}
+}
+
+func TestServiceDeskService_Customers(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectServer
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/customer"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, testAPIEndpoint)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"name":"fred","displayName":"Fred F. User"}]}`)
+		case "POST", "DELETE":
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"usernames":["fred"]}`+"\n" {
+				t.Errorf("Unexpected body %s", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	customers, _, err := testClient.ServiceDesk.GetCustomers("10", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if customers == nil || len(customers.Values) != 1 || customers.Values[0].Name != "fred" {
+		t.Errorf("Expected customer fred. Got %+v", customers)
+	}
+	if _, err := testClient.ServiceDesk.AddCustomers("10", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.ServiceDesk.RemoveCustomers("10", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestServiceDeskService_CreateCustomer(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testAPIEndpoint := "/rest/servicedeskapi/customer"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"displayName":"Fred F. User","email":"fred@example.com"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"accountId":"qm:1","emailAddress":"fred@example.com","displayName":"Fred F. User","active":true}`)
+	})
+
+	customer, _, err := testClient.ServiceDesk.CreateCustomer("fred@example.com", "Fred F. User")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if customer == nil || customer.AccountID != "qm:1" {
+		t.Errorf("Expected customer qm:1. Got %+v", customer)
+	}
+}
@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"sync"
+)
+
+// PageFetchFunc fetches the page of a paginated resource starting at startAt with up to maxResults items.
+// It returns the page, the number of items in it and the response, whose Total is the number of items of the resource
+// and whose MaxResults is the page size JIRA used, if the resource reports them.
+type PageFetchFunc func(ctx context.Context, startAt, maxResults int) (interface{}, int, *Response, error)
+
+// pageResult is a page fetched by a worker of FetchAllParallel
+type pageResult struct {
+	page interface{}
+	err  error
+}
+
+// FetchAllParallel fetches all pages of a paginated resource with fetch and passes them to merge in order.
+// Once the first page reveals the Total of the resource, the remaining pages are fetched concurrently
+// by up to workers goroutines. Resources which do not report a Total are fetched page by page.
+// On the first error the pending requests are cancelled and the error is returned.
+// merge is never called concurrently.
+func FetchAllParallel(ctx context.Context, startAt, maxResults, workers int, fetch PageFetchFunc, merge func(page interface{}) error) error {
+	if maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+
+	page, n, resp, err := fetch(ctx, startAt, maxResults)
+	if err != nil {
+		return err
+	}
+	if err := merge(page); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	startAt += n
+
+	if resp == nil || resp.Total == 0 {
+		return fetchPages(startAt, maxResults, func(startAt, maxResults int) (*Response, int, error) {
+			page, n, resp, err := fetch(ctx, startAt, maxResults)
+			if err != nil {
+				return resp, 0, err
+			}
+			return resp, n, merge(page)
+		})
+	}
+
+	// JIRA caps maxResults for some resources, the first page tells how many items it returns per page
+	pageSize := n
+	var starts []int
+	for s := startAt; s < resp.Total; s += pageSize {
+		starts = append(starts, s)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	results := make([]chan pageResult, len(starts))
+	for i := range results {
+		results[i] = make(chan pageResult, 1)
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(starts); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					results[i] <- pageResult{err: err}
+					continue
+				}
+				page, _, _, err := fetch(ctx, starts[i], pageSize)
+				results[i] <- pageResult{page: page, err: err}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := range starts {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	// Cancel the pending requests before waiting for the workers, or they would fetch all remaining pages
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for i := range starts {
+		var result pageResult
+		select {
+		case result = <-results[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if result.err != nil {
+			return result.err
+		}
+		if err := merge(result.page); err != nil {
+			return err
+		}
+	}
+	return nil
+}
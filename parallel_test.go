@@ -0,0 +1,120 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIssueService_SearchAllParallel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		// JIRA caps the page size at 3 although 10 are requested
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":3,"total":8,"issues":[`, startAt)
+		for i := startAt; i < startAt+3 && i < 8; i++ {
+			if i > startAt {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"key":"EX-%d"}`, i)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+
+	issues, err := testClient.Issue.SearchAllParallel("project = EX", &SearchOptions{MaxResults: 10}, 4)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 8 {
+		t.Fatalf("Expected 8 issues. Got %d", len(issues))
+	}
+	for i, issue := range issues {
+		if issue.Key != fmt.Sprintf("EX-%d", i) {
+			t.Errorf("Expected EX-%d at index %d. Got %s", i, i, issue.Key)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests. Got %d", requests)
+	}
+}
+
+func TestFetchAllParallel_Error(t *testing.T) {
+	failure := errors.New("page failed")
+	var merged []interface{}
+	err := FetchAllParallel(context.Background(), 0, 2, 3, func(ctx context.Context, startAt, maxResults int) (interface{}, int, *Response, error) {
+		if startAt == 4 {
+			return nil, 0, nil, failure
+		}
+		return startAt, 2, &Response{Total: 10}, nil
+	}, func(page interface{}) error {
+		merged = append(merged, page)
+		return nil
+	})
+
+	if err != failure {
+		t.Errorf("Expected the error of the failed page. Got %v", err)
+	}
+	if fmt.Sprint(merged) != "[0 2]" {
+		t.Errorf("Expected the pages before the failed one to be merged. Got %v", merged)
+	}
+}
+
+func TestFetchAllParallel_ErrorStopsRequests(t *testing.T) {
+	failure := errors.New("page failed")
+	var requests int32
+	err := FetchAllParallel(context.Background(), 0, 1, 2, func(ctx context.Context, startAt, maxResults int) (interface{}, int, *Response, error) {
+		atomic.AddInt32(&requests, 1)
+		if startAt == 1 {
+			return nil, 0, nil, failure
+		}
+		if startAt > 1 {
+			// Wait for the request to be cancelled
+			select {
+			case <-ctx.Done():
+				return nil, 0, nil, ctx.Err()
+			case <-time.After(10 * time.Millisecond):
+				return startAt, 1, nil, nil
+			}
+		}
+		return startAt, 1, &Response{Total: 100}, nil
+	}, func(page interface{}) error {
+		return nil
+	})
+
+	if err != failure {
+		t.Errorf("Expected the error of the failed page. Got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n > 4 {
+		t.Errorf("Expected the requests to stop after the failed page. Got %d requests", n)
+	}
+}
+
+func TestFetchAllParallel_WithoutTotal(t *testing.T) {
+	var merged []interface{}
+	err := FetchAllParallel(context.Background(), 0, 2, 3, func(ctx context.Context, startAt, maxResults int) (interface{}, int, *Response, error) {
+		n := 2
+		if startAt == 4 {
+			n = 1
+		}
+		return startAt, n, &Response{MaxResults: 2}, nil
+	}, func(page interface{}) error {
+		merged = append(merged, page)
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if fmt.Sprint(merged) != "[0 2 4]" {
+		t.Errorf("Expected the pages to be fetched until the short one. Got %v", merged)
+	}
+}
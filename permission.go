@@ -0,0 +1,124 @@
+package jira
+
+import "context"
+
+// PermissionService handles the permissions of users for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/mypermissions
+type PermissionService struct {
+	client *Client
+}
+
+// Permission represents a permission and whether the current user has it.
+// Type is either "GLOBAL" or "PROJECT".
+type Permission struct {
+	ID             string `json:"id,omitempty" structs:"id,omitempty"`
+	Key            string `json:"key,omitempty" structs:"key,omitempty"`
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+	Type           string `json:"type,omitempty" structs:"type,omitempty"`
+	Description    string `json:"description,omitempty" structs:"description,omitempty"`
+	HavePermission bool   `json:"havePermission,omitempty" structs:"havePermission,omitempty"`
+}
+
+// MyPermissionsOptions specifies the optional parameters to the PermissionService.GetMyPermissions method.
+// The permissions are returned in the context of the given project or issue.
+type MyPermissionsOptions struct {
+	// ProjectKey is the key of the project to check the permissions in
+	ProjectKey string `url:"projectKey,omitempty"`
+	// ProjectID is the id of the project to check the permissions in
+	ProjectID string `url:"projectId,omitempty"`
+	// IssueKey is the key of the issue to check the permissions on
+	IssueKey string `url:"issueKey,omitempty"`
+	// IssueID is the id of the issue to check the permissions on
+	IssueID string `url:"issueId,omitempty"`
+	// Permissions restricts the result to these permission keys, e.g. EDIT_ISSUES.
+	// JIRA Cloud requires at least one permission key.
+	Permissions []string `url:"permissions,omitempty,comma"`
+}
+
+// myPermissions is the permissions of the current user returned by JIRA
+type myPermissions struct {
+	Permissions map[string]Permission `json:"permissions"`
+}
+
+// PermissionCheckRequest describes the permissions to check for a user with PermissionService.Check.
+// If AccountID is empty, the permissions of the current user are checked.
+type PermissionCheckRequest struct {
+	AccountID          string                   `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	GlobalPermissions  []string                 `json:"globalPermissions,omitempty" structs:"globalPermissions,omitempty"`
+	ProjectPermissions []ProjectPermissionCheck `json:"projectPermissions,omitempty" structs:"projectPermissions,omitempty"`
+}
+
+// ProjectPermissionCheck describes project permissions to check in the projects and on the issues with the given ids
+type ProjectPermissionCheck struct {
+	Permissions []string `json:"permissions,omitempty" structs:"permissions,omitempty"`
+	Projects    []int    `json:"projects,omitempty" structs:"projects,omitempty"`
+	Issues      []int    `json:"issues,omitempty" structs:"issues,omitempty"`
+}
+
+// PermissionCheckResult is the result of PermissionService.Check.
+// It lists the global permissions the user has, and for each project permission
+// the ids of the projects and issues the user has it in.
+type PermissionCheckResult struct {
+	GlobalPermissions  []string                       `json:"globalPermissions" structs:"globalPermissions"`
+	ProjectPermissions []ProjectPermissionCheckResult `json:"projectPermissions" structs:"projectPermissions"`
+}
+
+// ProjectPermissionCheckResult lists the ids of the projects and issues a project permission is granted in
+type ProjectPermissionCheckResult struct {
+	Permission string `json:"permission" structs:"permission"`
+	Projects   []int  `json:"projects" structs:"projects"`
+	Issues     []int  `json:"issues" structs:"issues"`
+}
+
+// GetMyPermissionsWithContext returns the permissions of the current user, keyed by permission key.
+// Pass options to get the permissions in the context of a project or an issue.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/mypermissions-getPermissions
+func (s *PermissionService) GetMyPermissionsWithContext(ctx context.Context, options *MyPermissionsOptions) (map[string]Permission, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/mypermissions", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(myPermissions)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Permissions, resp, nil
+}
+
+// GetMyPermissions wraps GetMyPermissionsWithContext using the background context.
+func (s *PermissionService) GetMyPermissions(options *MyPermissionsOptions) (map[string]Permission, *Response, error) {
+	return s.GetMyPermissionsWithContext(context.Background(), options)
+}
+
+// CheckWithContext checks global and project permissions of a user in bulk.
+// Checking permissions in bulk is only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-permissions-check-post
+func (s *PermissionService) CheckWithContext(ctx context.Context, check *PermissionCheckRequest) (*PermissionCheckResult, *Response, error) {
+	apiEndpoint := restAPIBase + "/permissions/check"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, check)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(PermissionCheckResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// Check wraps CheckWithContext using the background context.
+func (s *PermissionService) Check(check *PermissionCheckRequest) (*PermissionCheckResult, *Response, error) {
+	return s.CheckWithContext(context.Background(), check)
+}
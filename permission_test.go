@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPermissionService_GetMyPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/mypermissions"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?issueKey=ABC-1&permissions=EDIT_ISSUES%2CTRANSITION_ISSUES")
+		fmt.Fprint(w, `{"permissions":{
+			"EDIT_ISSUES":{"id":"12","key":"EDIT_ISSUES","name":"Edit Issues","type":"PROJECT","havePermission":true},
+			"TRANSITION_ISSUES":{"id":"46","key":"TRANSITION_ISSUES","name":"Transition Issues","type":"PROJECT","havePermission":false}}}`)
+	})
+
+	options := &MyPermissionsOptions{IssueKey: "ABC-1", Permissions: []string{"EDIT_ISSUES", "TRANSITION_ISSUES"}}
+	permissions, _, err := testClient.Permission.GetMyPermissions(options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !permissions["EDIT_ISSUES"].HavePermission {
+		t.Errorf("Expected EDIT_ISSUES to be granted. Got %+v", permissions)
+	}
+	if permissions["TRANSITION_ISSUES"].HavePermission {
+		t.Errorf("Expected TRANSITION_ISSUES not to be granted. Got %+v", permissions)
+	}
+}
+
+func TestPermissionService_Check(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/permissions/check"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"globalPermissions":["ADMINISTER"],"projectPermissions":[{"permissions":["EDIT_ISSUES"],"issues":[10010,10011]}]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"globalPermissions":[],"projectPermissions":[{"permission":"EDIT_ISSUES","issues":[10010],"projects":[]}]}`)
+	})
+
+	check := &PermissionCheckRequest{
+		GlobalPermissions:  []string{"ADMINISTER"},
+		ProjectPermissions: []ProjectPermissionCheck{{Permissions: []string{"EDIT_ISSUES"}, Issues: []int{10010, 10011}}},
+	}
+	result, _, err := testClient.Permission.Check(check)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(result.GlobalPermissions) != 0 {
+		t.Errorf("Expected no global permissions. Got %+v", result.GlobalPermissions)
+	}
+	if len(result.ProjectPermissions) != 1 || len(result.ProjectPermissions[0].Issues) != 1 || result.ProjectPermissions[0].Issues[0] != 10010 {
+		t.Errorf("Expected EDIT_ISSUES on issue 10010. Got %+v", result.ProjectPermissions)
+	}
+}
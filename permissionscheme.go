@@ -0,0 +1,323 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// PermissionSchemeService handles permission schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme
+type PermissionSchemeService struct {
+	client *Client
+}
+
+// These constants are the types of holders a permission can be granted to
+const (
+	PermissionHolderTypeAnyone           = "anyone"
+	PermissionHolderTypeApplicationRole  = "applicationRole"
+	PermissionHolderTypeAssignee         = "assignee"
+	PermissionHolderTypeGroup            = "group"
+	PermissionHolderTypeGroupCustomField = "groupCustomField"
+	PermissionHolderTypeProjectLead      = "projectLead"
+	PermissionHolderTypeProjectRole      = "projectRole"
+	PermissionHolderTypeReporter         = "reporter"
+	PermissionHolderTypeUser             = "user"
+	PermissionHolderTypeUserCustomField  = "userCustomField"
+)
+
+// PermissionScheme represents a permission scheme, the permissions granted in the projects using it.
+// It is returned for a project by ProjectService.GetPermissionScheme as well.
+// The Permissions are only returned when they are expanded.
+type PermissionScheme struct {
+	Expand      string            `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID          int               `json:"id,omitempty" structs:"id,omitempty"`
+	Self        string            `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string            `json:"name,omitempty" structs:"name,omitempty"`
+	Description string            `json:"description,omitempty" structs:"description,omitempty"`
+	Permissions []PermissionGrant `json:"permissions,omitempty" structs:"permissions,omitempty"`
+}
+
+// PermissionGrant represents a permission, e.g. ADMINISTER_PROJECTS, granted to a holder in a permission scheme
+type PermissionGrant struct {
+	ID         int               `json:"id,omitempty" structs:"id,omitempty"`
+	Self       string            `json:"self,omitempty" structs:"self,omitempty"`
+	Holder     *PermissionHolder `json:"holder,omitempty" structs:"holder,omitempty"`
+	Permission string            `json:"permission,omitempty" structs:"permission,omitempty"`
+}
+
+// PermissionHolder represents who a permission is granted to.
+// Type is one of the PermissionHolderType constants, Parameter identifies e.g. the group or the project role.
+type PermissionHolder struct {
+	Type      string `json:"type,omitempty" structs:"type,omitempty"`
+	Parameter string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	Value     string `json:"value,omitempty" structs:"value,omitempty"`
+	Expand    string `json:"expand,omitempty" structs:"expand,omitempty"`
+}
+
+// PermissionSchemeGetOptions specifies the optional parameters to the Get methods of the PermissionSchemeService
+type PermissionSchemeGetOptions struct {
+	// Expand is a comma separated list of additional information to include, e.g. "permissions,group"
+	Expand string `url:"expand,omitempty"`
+}
+
+// permissionSchemeList is the list of permission schemes returned by JIRA
+type permissionSchemeList struct {
+	PermissionSchemes []PermissionScheme `json:"permissionSchemes"`
+}
+
+// permissionGrantList is the list of permission grants returned by JIRA
+type permissionGrantList struct {
+	Permissions []PermissionGrant `json:"permissions"`
+}
+
+// projectPermissionScheme assigns a permission scheme to a project
+type projectPermissionScheme struct {
+	ID int `json:"id" structs:"id"`
+}
+
+// GetListWithContext gets all permission schemes from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-getPermissionSchemes
+func (s *PermissionSchemeService) GetListWithContext(ctx context.Context, options *PermissionSchemeGetOptions) ([]PermissionScheme, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/permissionscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(permissionSchemeList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.PermissionSchemes, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *PermissionSchemeService) GetList(options *PermissionSchemeGetOptions) ([]PermissionScheme, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the permission scheme for a given permission scheme id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-getPermissionScheme
+func (s *PermissionSchemeService) GetWithContext(ctx context.Context, schemeID int, options *PermissionSchemeGetOptions) (*PermissionScheme, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/permissionscheme/%d", schemeID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *PermissionSchemeService) Get(schemeID int, options *PermissionSchemeGetOptions) (*PermissionScheme, *Response, error) {
+	return s.GetWithContext(context.Background(), schemeID, options)
+}
+
+// CreateWithContext creates a new permission scheme, including the grants in its Permissions.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-createPermissionScheme
+func (s *PermissionSchemeService) CreateWithContext(ctx context.Context, scheme *PermissionScheme) (*PermissionScheme, *Response, error) {
+	apiEndpoint := "rest/api/2/permissionscheme"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(PermissionScheme)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *PermissionSchemeService) Create(scheme *PermissionScheme) (*PermissionScheme, *Response, error) {
+	return s.CreateWithContext(context.Background(), scheme)
+}
+
+// UpdateWithContext updates the permission scheme with the id of the given scheme.
+// If Permissions is set, all grants of the scheme are replaced by them.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-updatePermissionScheme
+func (s *PermissionSchemeService) UpdateWithContext(ctx context.Context, scheme *PermissionScheme) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/permissionscheme/%d", scheme.ID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(PermissionScheme)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *PermissionSchemeService) Update(scheme *PermissionScheme) (*PermissionScheme, *Response, error) {
+	return s.UpdateWithContext(context.Background(), scheme)
+}
+
+// DeleteWithContext deletes the permission scheme for a given permission scheme id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-deletePermissionScheme
+func (s *PermissionSchemeService) DeleteWithContext(ctx context.Context, schemeID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/permissionscheme/%d", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *PermissionSchemeService) Delete(schemeID int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), schemeID)
+}
+
+// GetGrantsWithContext returns all permission grants of the permission scheme for a given permission scheme id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-getPermissionSchemeGrants
+func (s *PermissionSchemeService) GetGrantsWithContext(ctx context.Context, schemeID int, options *PermissionSchemeGetOptions) ([]PermissionGrant, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/permissionscheme/%d/permission", schemeID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(permissionGrantList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Permissions, resp, nil
+}
+
+// GetGrants wraps GetGrantsWithContext using the background context.
+func (s *PermissionSchemeService) GetGrants(schemeID int, options *PermissionSchemeGetOptions) ([]PermissionGrant, *Response, error) {
+	return s.GetGrantsWithContext(context.Background(), schemeID, options)
+}
+
+// GetGrantWithContext returns the permission grant for a given permission scheme id and grant id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-getPermissionSchemeGrant
+func (s *PermissionSchemeService) GetGrantWithContext(ctx context.Context, schemeID, grantID int, options *PermissionSchemeGetOptions) (*PermissionGrant, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/permissionscheme/%d/permission/%d", schemeID, grantID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grant := new(PermissionGrant)
+	resp, err := s.client.Do(req, grant)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return grant, resp, nil
+}
+
+// GetGrant wraps GetGrantWithContext using the background context.
+func (s *PermissionSchemeService) GetGrant(schemeID, grantID int, options *PermissionSchemeGetOptions) (*PermissionGrant, *Response, error) {
+	return s.GetGrantWithContext(context.Background(), schemeID, grantID, options)
+}
+
+// AddGrantWithContext grants a permission in the permission scheme for a given permission scheme id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-createPermissionGrant
+func (s *PermissionSchemeService) AddGrantWithContext(ctx context.Context, schemeID int, grant *PermissionGrant) (*PermissionGrant, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/permissionscheme/%d/permission", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, grant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(PermissionGrant)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// AddGrant wraps AddGrantWithContext using the background context.
+func (s *PermissionSchemeService) AddGrant(schemeID int, grant *PermissionGrant) (*PermissionGrant, *Response, error) {
+	return s.AddGrantWithContext(context.Background(), schemeID, grant)
+}
+
+// DeleteGrantWithContext removes the permission grant for a given permission scheme id and grant id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/permissionscheme-deletePermissionSchemeEntity
+func (s *PermissionSchemeService) DeleteGrantWithContext(ctx context.Context, schemeID, grantID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/permissionscheme/%d/permission/%d", schemeID, grantID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteGrant wraps DeleteGrantWithContext using the background context.
+func (s *PermissionSchemeService) DeleteGrant(schemeID, grantID int) (*Response, error) {
+	return s.DeleteGrantWithContext(context.Background(), schemeID, grantID)
+}
+
+// AssignToProjectWithContext makes the project for a given project id or key use the permission scheme with the given id.
+// The scheme currently used by a project is returned by ProjectService.GetPermissionScheme.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *PermissionSchemeService) AssignToProjectWithContext(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &projectPermissionScheme{ID: schemeID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// AssignToProject wraps AssignToProjectWithContext using the background context.
+func (s *PermissionSchemeService) AssignToProject(projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error) {
+	return s.AssignToProjectWithContext(context.Background(), projectIDOrKey, schemeID)
+}
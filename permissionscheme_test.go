@@ -0,0 +1,235 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPermissionSchemeService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=permissions")
+		fmt.Fprint(w, `{"permissionSchemes":[{"id":10000,"name":"Default Permission Scheme","permissions":[
+			{"id":10004,"holder":{"type":"group","parameter":"jira-administrators"},"permission":"ADMINISTER_PROJECTS"}]}]}`)
+	})
+
+	schemes, _, err := testClient.PermissionScheme.GetList(&PermissionSchemeGetOptions{Expand: "permissions"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || len(schemes[0].Permissions) != 1 || schemes[0].Permissions[0].Holder.Parameter != "jira-administrators" {
+		t.Errorf("Expected 1 scheme with 1 grant. Got %+v", schemes)
+	}
+}
+
+func TestPermissionSchemeService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10000,"name":"Default Permission Scheme","description":"Default"}`)
+	})
+
+	scheme, _, err := testClient.PermissionScheme.Get(10000, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.Name != "Default Permission Scheme" {
+		t.Errorf("Expected Default Permission Scheme. Got %+v", scheme)
+	}
+}
+
+func TestPermissionSchemeService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		scheme := new(PermissionScheme)
+		json.NewDecoder(r.Body).Decode(scheme)
+		if scheme.Name != "Restricted" || len(scheme.Permissions) != 1 || scheme.Permissions[0].Holder.Type != PermissionHolderTypeProjectRole {
+			t.Errorf("Unexpected scheme %+v", scheme)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10100,"name":"Restricted"}`)
+	})
+
+	scheme := &PermissionScheme{
+		Name: "Restricted",
+		Permissions: []PermissionGrant{
+			{Holder: &PermissionHolder{Type: PermissionHolderTypeProjectRole, Parameter: "10002"}, Permission: "BROWSE_PROJECTS"},
+		},
+	}
+	created, _, err := testClient.PermissionScheme.Create(scheme)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if created == nil || created.ID != 10100 {
+		t.Errorf("Expected scheme 10100. Got %+v", created)
+	}
+}
+
+func TestPermissionSchemeService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"id":10100,"description":"Only for team members"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10100,"name":"Restricted","description":"Only for team members"}`)
+	})
+
+	scheme, _, err := testClient.PermissionScheme.Update(&PermissionScheme{ID: 10100, Description: "Only for team members"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.Description != "Only for team members" {
+		t.Errorf("Expected updated description. Got %+v", scheme)
+	}
+}
+
+func TestPermissionSchemeService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.PermissionScheme.Delete(10100)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPermissionSchemeService_GetGrants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"permissions":[{"id":10004,"holder":{"type":"anyone"},"permission":"BROWSE_PROJECTS"},
+			{"id":10005,"holder":{"type":"projectRole","parameter":"10002"},"permission":"ADMINISTER_PROJECTS"}]}`)
+	})
+
+	grants, _, err := testClient.PermissionScheme.GetGrants(10000, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(grants) != 2 || grants[0].Holder.Type != PermissionHolderTypeAnyone {
+		t.Errorf("Expected 2 grants. Got %+v", grants)
+	}
+}
+
+func TestPermissionSchemeService_GetGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission/10004"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10004,"holder":{"type":"anyone"},"permission":"BROWSE_PROJECTS"}`)
+	})
+
+	grant, _, err := testClient.PermissionScheme.GetGrant(10000, 10004, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if grant == nil || grant.Permission != "BROWSE_PROJECTS" {
+		t.Errorf("Expected grant BROWSE_PROJECTS. Got %+v", grant)
+	}
+}
+
+func TestPermissionSchemeService_AddGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"holder":{"type":"group","parameter":"auditors"},"permission":"BROWSE_PROJECTS"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10010,"holder":{"type":"group","parameter":"auditors"},"permission":"BROWSE_PROJECTS"}`)
+	})
+
+	grant := &PermissionGrant{Holder: &PermissionHolder{Type: PermissionHolderTypeGroup, Parameter: "auditors"}, Permission: "BROWSE_PROJECTS"}
+	created, _, err := testClient.PermissionScheme.AddGrant(10000, grant)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if created == nil || created.ID != 10010 {
+		t.Errorf("Expected grant 10010. Got %+v", created)
+	}
+}
+
+func TestPermissionSchemeService_DeleteGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/permissionscheme/10000/permission/10010"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.PermissionScheme.DeleteGrant(10000, 10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPermissionSchemeService_AssignToProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/permissionscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"id":10100}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10100,"name":"Restricted"}`)
+	})
+
+	scheme, _, err := testClient.PermissionScheme.AssignToProject("ABC", 10100)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.Name != "Restricted" {
+		t.Errorf("Expected scheme Restricted. Got %+v", scheme)
+	}
+}
@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // PriorityService handles priorities for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-Priority
@@ -18,12 +23,12 @@ type Priority struct {
 	Description string `json:"description,omitempty" structs:"description,omitempty"`
 }
 
-// GetList gets all priorities from JIRA
+// GetListWithContext gets all priorities from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-priority-get
-func (s *PriorityService) GetList() ([]Priority, *Response, error) {
+func (s *PriorityService) GetListWithContext(ctx context.Context) ([]Priority, *Response, error) {
 	apiEndpoint := "rest/api/2/priority"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -35,3 +40,31 @@ func (s *PriorityService) GetList() ([]Priority, *Response, error) {
 	}
 	return priorityList, resp, nil
 }
+
+// GetList wraps GetListWithContext using the background context.
+func (s *PriorityService) GetList() ([]Priority, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the priority for a given priority id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/priority-getPriority
+func (s *PriorityService) GetWithContext(ctx context.Context, priorityID string) (*Priority, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/priority/%s", priorityID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	priority := new(Priority)
+	resp, err := s.client.Do(req, priority)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return priority, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *PriorityService) Get(priorityID string) (*Priority, *Response, error) {
+	return s.GetWithContext(context.Background(), priorityID)
+}
@@ -30,3 +30,23 @@ func TestPriorityService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestPriorityService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/priority/1"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/priority/1","statusColor":"#cc0000","name":"Blocker","id":"1"}`)
+	})
+
+	priority, _, err := testClient.Priority.Get("1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if priority == nil || priority.Name != "Blocker" {
+		t.Errorf("Expected priority Blocker. Got %+v", priority)
+	}
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -74,36 +75,88 @@ type ProjectComponent struct {
 	ProjectID           int    `json:"projectId" structs:"projectId,omitempty"`
 }
 
-// PermissionScheme represents the permission scheme for the project
-type PermissionScheme struct {
-	Expand      string `json:"expand" structs:"expand,omitempty"`
-	Self        string `json:"self" structs:"self,omitempty"`
-	ID          int    `json:"id" structs:"id,omitempty"`
-	Name        string `json:"name" structs:"name,omitempty"`
-	Description string `json:"description" structs:"description,omitempty"`
+// ProjectOptions are passed to the ProjectService.Create and ProjectService.Update functions
+// to create or update a JIRA project
+type ProjectOptions struct {
+	Key                string `json:"key,omitempty" structs:"key,omitempty"`
+	Name               string `json:"name,omitempty" structs:"name,omitempty"`
+	ProjectTypeKey     string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+	Description        string `json:"description,omitempty" structs:"description,omitempty"`
+	// Lead is the username of the project lead, LeadAccountID its account id on JIRA Cloud.
+	Lead                string `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int    `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int    `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int    `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// ProjectIdentity identifies a project which was just created
+type ProjectIdentity struct {
+	Self string `json:"self" structs:"self"`
+	ID   int    `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// These constants are the states of a project feature
+const (
+	ProjectFeatureStateEnabled  = "ENABLED"
+	ProjectFeatureStateDisabled = "DISABLED"
+)
+
+// ProjectFeature represents a feature of a project on JIRA Cloud, e.g. "jsw.agility.backlog" or "jsw.agility.releases".
+// Features whose ToggleLocked is true cannot be enabled or disabled.
+type ProjectFeature struct {
+	ProjectID            int      `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	Feature              string   `json:"feature,omitempty" structs:"feature,omitempty"`
+	State                string   `json:"state,omitempty" structs:"state,omitempty"`
+	ToggleLocked         bool     `json:"toggleLocked,omitempty" structs:"toggleLocked,omitempty"`
+	Prerequisites        []string `json:"prerequisites,omitempty" structs:"prerequisites,omitempty"`
+	LocalisedName        string   `json:"localisedName,omitempty" structs:"localisedName,omitempty"`
+	LocalisedDescription string   `json:"localisedDescription,omitempty" structs:"localisedDescription,omitempty"`
+	ImageURI             string   `json:"imageUri,omitempty" structs:"imageUri,omitempty"`
+}
+
+// projectFeatures is the list of the features of a project
+type projectFeatures struct {
+	Features []ProjectFeature `json:"features" structs:"features"`
+}
+
+// projectFeatureState is the request to enable or disable a project feature
+type projectFeatureState struct {
+	State string `json:"state" structs:"state"`
 }
 
 // Get All Projects Query Parameters
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-get
 type GetAllProjectsQueryParams struct {
 	Expand string `url:"expand,omitempty"`
-	Recent int32  `url:"recent.omitempty"`
+	Recent int32  `url:"recent,omitempty"`
 }
 
-// GetList gets all projects form JIRA
+// GetListWithContext gets all projects form JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-get
+func (s *ProjectService) GetListWithContext(ctx context.Context) (*ProjectList, *Response, error) {
+	return s.ListWithOptionsWithContext(ctx, &GetAllProjectsQueryParams{})
+}
+
+// GetList wraps GetListWithContext using the background context.
 func (s *ProjectService) GetList() (*ProjectList, *Response, error) {
-	return s.ListWithOptions(&GetAllProjectsQueryParams{})
+	return s.GetListWithContext(context.Background())
 }
 
-// ListWithOptions gets all projects form JIRA with optional query params, like &GetQueryOptions{Expand: "issueTypes"} to get
+// ListWithOptionsWithContext gets all projects form JIRA with optional query params, like &GetQueryOptions{Expand: "issueTypes"} to get
 // a list of all projects and their supported issuetypes
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-get
-func (s *ProjectService) ListWithOptions(options *GetAllProjectsQueryParams) (*ProjectList, *Response, error) {
-	const apiEndpoint = restAPIBase + "/project"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+func (s *ProjectService) ListWithOptionsWithContext(ctx context.Context, options *GetAllProjectsQueryParams) (*ProjectList, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/project"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,14 +179,19 @@ func (s *ProjectService) ListWithOptions(options *GetAllProjectsQueryParams) (*P
 	return projectList, resp, nil
 }
 
-// Get returns a full representation of the project for the given issue key.
+// ListWithOptions wraps ListWithOptionsWithContext using the background context.
+func (s *ProjectService) ListWithOptions(options *GetAllProjectsQueryParams) (*ProjectList, *Response, error) {
+	return s.ListWithOptionsWithContext(context.Background(), options)
+}
+
+// GetWithContext returns a full representation of the project for the given issue key.
 // JIRA will attempt to identify the project by the projectIdOrKey path parameter.
 // This can be an project id, or an project key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
-func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+func (s *ProjectService) GetWithContext(ctx context.Context, projectID string) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,14 +206,19 @@ func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
 	return project, resp, nil
 }
 
-// GetPermissionScheme returns a full representation of the permission scheme for the project
+// Get wraps GetWithContext using the background context.
+func (s *ProjectService) Get(projectID string) (*Project, *Response, error) {
+	return s.GetWithContext(context.Background(), projectID)
+}
+
+// GetPermissionSchemeWithContext returns a full representation of the permission scheme for the project
 // JIRA will attempt to identify the project by the projectIdOrKey path parameter.
 // This can be an project id, or an project key.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getProject
-func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/permissionscheme", projectID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+func (s *ProjectService) GetPermissionSchemeWithContext(ctx context.Context, projectID string) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/permissionscheme", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,3 +232,245 @@ func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionSchem
 
 	return ps, resp, nil
 }
+
+// GetPermissionScheme wraps GetPermissionSchemeWithContext using the background context.
+func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error) {
+	return s.GetPermissionSchemeWithContext(context.Background(), projectID)
+}
+
+// CreateWithContext creates a new project based on the given options.
+// Key, name, project type and lead are required.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-post
+func (s *ProjectService) CreateWithContext(ctx context.Context, options *ProjectOptions) (*ProjectIdentity, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/project"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(ProjectIdentity)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return project, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *ProjectService) Create(options *ProjectOptions) (*ProjectIdentity, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext updates the project identified by projectID (id or key) with the given options.
+// Only the set options are changed.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-projectIdOrKey-put
+func (s *ProjectService) UpdateWithContext(ctx context.Context, projectID string, options *ProjectOptions) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return project, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ProjectService) Update(projectID string, options *ProjectOptions) (*Project, *Response, error) {
+	return s.UpdateWithContext(context.Background(), projectID, options)
+}
+
+// ArchiveWithContext archives the project identified by projectID (id or key).
+// Archived projects are read-only and hidden from most views, but can be restored.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-projectIdOrKey-archive-post
+func (s *ProjectService) ArchiveWithContext(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/archive", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// Archive wraps ArchiveWithContext using the background context.
+func (s *ProjectService) Archive(projectID string) (*Response, error) {
+	return s.ArchiveWithContext(context.Background(), projectID)
+}
+
+// DeleteWithContext deletes the project identified by projectID (id or key).
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-project-projectIdOrKey-delete
+func (s *ProjectService) DeleteWithContext(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return resp, err
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *ProjectService) Delete(projectID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), projectID)
+}
+
+// GetPropertyKeysWithContext returns the keys of the properties of the project identified by projectID (id or key).
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/properties-getPropertiesKeys
+func (s *ProjectService) GetPropertyKeysWithContext(ctx context.Context, projectID string) ([]EntityPropertyKey, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/properties", s.client.apiBase(ctx), projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(entityPropertyKeys)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Keys, resp, nil
+}
+
+// GetPropertyKeys wraps GetPropertyKeysWithContext using the background context.
+func (s *ProjectService) GetPropertyKeys(projectID string) ([]EntityPropertyKey, *Response, error) {
+	return s.GetPropertyKeysWithContext(context.Background(), projectID)
+}
+
+// GetPropertyWithContext returns a property of the project identified by projectID (id or key).
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/properties-getProperty
+func (s *ProjectService) GetPropertyWithContext(ctx context.Context, projectID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/properties/%s", s.client.apiBase(ctx), projectID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// GetProperty wraps GetPropertyWithContext using the background context.
+func (s *ProjectService) GetProperty(projectID, propertyKey string) (*EntityProperty, *Response, error) {
+	return s.GetPropertyWithContext(context.Background(), projectID, propertyKey)
+}
+
+// SetPropertyWithContext creates or replaces a property of the project identified by projectID (id or key).
+// value is encoded as JSON.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/properties-setProperty
+func (s *ProjectService) SetPropertyWithContext(ctx context.Context, projectID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/properties/%s", s.client.apiBase(ctx), projectID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetProperty wraps SetPropertyWithContext using the background context.
+func (s *ProjectService) SetProperty(projectID, propertyKey string, value interface{}) (*Response, error) {
+	return s.SetPropertyWithContext(context.Background(), projectID, propertyKey, value)
+}
+
+// DeletePropertyWithContext removes a property from the project identified by projectID (id or key).
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/properties-deleteProperty
+func (s *ProjectService) DeletePropertyWithContext(ctx context.Context, projectID, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/properties/%s", s.client.apiBase(ctx), projectID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteProperty wraps DeletePropertyWithContext using the background context.
+func (s *ProjectService) DeleteProperty(projectID, propertyKey string) (*Response, error) {
+	return s.DeletePropertyWithContext(context.Background(), projectID, propertyKey)
+}
+
+// GetFeaturesWithContext returns the features of the project identified by projectID (id or key),
+// e.g. whether its board, backlog or releases are enabled.
+// Project features are only available on JIRA Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-features/#api-rest-api-3-project-projectidorkey-features-get
+func (s *ProjectService) GetFeaturesWithContext(ctx context.Context, projectID string) ([]ProjectFeature, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/features", restAPIBase, projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(projectFeatures)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Features, resp, nil
+}
+
+// GetFeatures wraps GetFeaturesWithContext using the background context.
+func (s *ProjectService) GetFeatures(projectID string) ([]ProjectFeature, *Response, error) {
+	return s.GetFeaturesWithContext(context.Background(), projectID)
+}
+
+// SetFeatureStateWithContext enables or disables a feature of the project identified by projectID (id or key).
+// state is one of the ProjectFeatureState constants. The features of the project are returned afterwards.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-features/#api-rest-api-3-project-projectidorkey-features-featurekey-put
+func (s *ProjectService) SetFeatureStateWithContext(ctx context.Context, projectID, featureKey, state string) ([]ProjectFeature, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/project/%s/features/%s", restAPIBase, projectID, featureKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &projectFeatureState{State: state})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(projectFeatures)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Features, resp, nil
+}
+
+// SetFeatureState wraps SetFeatureStateWithContext using the background context.
+func (s *ProjectService) SetFeatureState(projectID, featureKey, state string) ([]ProjectFeature, *Response, error) {
+	return s.SetFeatureStateWithContext(context.Background(), projectID, featureKey, state)
+}
@@ -10,7 +10,8 @@ import (
 func TestProjectService_GetList(t *testing.T) {
 	setup()
 	defer teardown()
-	testAPIEdpoint := "/rest/api/2/project"
+	testClient.Dialect = DialectCloud
+	testAPIEdpoint := "/rest/api/3/project"
 
 	raw, err := ioutil.ReadFile("./mocks/all_projects.json")
 	if err != nil {
@@ -34,7 +35,8 @@ func TestProjectService_GetList(t *testing.T) {
 func TestProjectService_ListWithOptions(t *testing.T) {
 	setup()
 	defer teardown()
-	testAPIEdpoint := "/rest/api/2/project"
+	testClient.Dialect = DialectCloud
+	testAPIEdpoint := "/rest/api/3/project"
 
 	raw, err := ioutil.ReadFile("./mocks/all_projects.json")
 	if err != nil {
@@ -42,11 +44,11 @@ func TestProjectService_ListWithOptions(t *testing.T) {
 	}
 	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/project?expand=issueTypes")
+		testRequestURL(t, r, "/rest/api/3/project?expand=issueTypes")
 		fmt.Fprint(w, string(raw))
 	})
 
-	projects, _, err := testClient.Project.ListWithOptions(&GetQueryOptions{Expand: "issueTypes"})
+	projects, _, err := testClient.Project.ListWithOptions(&GetAllProjectsQueryParams{Expand: "issueTypes"})
 	if projects == nil {
 		t.Error("Expected project list. Project list is nil")
 	}
@@ -156,3 +158,196 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/project")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/3/project/10042","id":10042,"key":"EX"}`)
+	})
+
+	project, _, err := testClient.Project.Create(&ProjectOptions{
+		Key:            "EX",
+		Name:           "Example",
+		ProjectTypeKey: "software",
+		LeadAccountID:  "5b10a0effa615349cb016cd8",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.ID != 10042 || project.Key != "EX" {
+		t.Errorf("Expected project EX with id 10042. Got %+v", project)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/project/EX")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/3/project/10042","id":"10042","key":"EX","name":"Renamed"}`)
+	})
+
+	project, _, err := testClient.Project.Update("EX", &ProjectOptions{Name: "Renamed"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.Name != "Renamed" {
+		t.Errorf("Expected renamed project. Got %+v", project)
+	}
+}
+
+func TestProjectService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/project/EX/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/3/project/EX/archive")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Archive("EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/3/project/EX")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Delete("EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties/template", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/EX/properties/template")
+
+		fmt.Fprint(w, `{"key":"template","value":{"name":"ops","version":2}}`)
+	})
+
+	property, _, err := testClient.Project.GetProperty("EX", "template")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if property.Key != "template" || !ok || value["name"] != "ops" {
+		t.Errorf("Expected property template with name ops. Got %+v", property)
+	}
+}
+
+func TestProjectService_SetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties/template", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/project/EX/properties/template")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"ops"}`+"\n" {
+			t.Errorf("Expected the property value as body. Got %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if _, err := testClient.Project.SetProperty("EX", "template", map[string]string{"name": "ops"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/EX/properties")
+
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/api/2/project/EX/properties/template","key":"template"}]}`)
+	})
+
+	keys, _, err := testClient.Project.GetPropertyKeys("EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Key != "template" {
+		t.Errorf("Expected key template. Got %+v", keys)
+	}
+}
+
+func TestProjectService_DeleteProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties/template", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/project/EX/properties/template")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.DeleteProperty("EX", "template"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetFeatures(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/project/EX/features", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/project/EX/features")
+
+		fmt.Fprint(w, `{"features":[{"projectId":10001,"state":"ENABLED","toggleLocked":true,"feature":"jsw.agility.backlog","prerequisites":[],"localisedName":"Backlog"}]}`)
+	})
+
+	features, _, err := testClient.Project.GetFeatures("EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(features) != 1 || features[0].Feature != "jsw.agility.backlog" || features[0].State != ProjectFeatureStateEnabled || !features[0].ToggleLocked {
+		t.Errorf("Expected the enabled backlog feature. Got %+v", features)
+	}
+}
+
+func TestProjectService_SetFeatureState(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/project/EX/features/jsw.agility.releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/3/project/EX/features/jsw.agility.releases")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"state":"DISABLED"}`+"\n" {
+			t.Errorf("Expected the state as body. Got %s", body)
+		}
+		fmt.Fprint(w, `{"features":[{"projectId":10001,"state":"DISABLED","feature":"jsw.agility.releases"}]}`)
+	})
+
+	features, _, err := testClient.Project.SetFeatureState("EX", "jsw.agility.releases", ProjectFeatureStateDisabled)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(features) != 1 || features[0].State != ProjectFeatureStateDisabled {
+		t.Errorf("Expected the disabled releases feature. Got %+v", features)
+	}
+}
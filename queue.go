@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// Queue represents a queue of a service desk, the issues matching its JQL as shown to the agents.
+// IssueCount is only set if it was requested with QueueListOptions.IncludeCount.
+type Queue struct {
+	ID         string   `json:"id,omitempty" structs:"id,omitempty"`
+	Name       string   `json:"name,omitempty" structs:"name,omitempty"`
+	JQL        string   `json:"jql,omitempty" structs:"jql,omitempty"`
+	Fields     []string `json:"fields,omitempty" structs:"fields,omitempty"`
+	IssueCount int      `json:"issueCount,omitempty" structs:"issueCount,omitempty"`
+}
+
+// QueuesList reflects a page of the queues of a service desk
+type QueuesList struct {
+	Size       int     `json:"size" structs:"size"`
+	Start      int     `json:"start" structs:"start"`
+	Limit      int     `json:"limit" structs:"limit"`
+	IsLastPage bool    `json:"isLastPage" structs:"isLastPage"`
+	Values     []Queue `json:"values" structs:"values"`
+}
+
+// QueueIssuesList reflects a page of the issues in a queue
+type QueueIssuesList struct {
+	Size       int     `json:"size" structs:"size"`
+	Start      int     `json:"start" structs:"start"`
+	Limit      int     `json:"limit" structs:"limit"`
+	IsLastPage bool    `json:"isLastPage" structs:"isLastPage"`
+	Values     []Issue `json:"values" structs:"values"`
+}
+
+// QueueListOptions specifies the optional parameters to the ServiceDeskService.GetQueues and GetQueue
+type QueueListOptions struct {
+	// IncludeCount specifies whether the number of issues in each queue is returned.
+	IncludeCount bool `url:"includeCount,omitempty"`
+
+	ServiceDeskListOptions
+}
+
+// GetQueuesWithContext returns a page of the queues of a service desk.
+// The current user has to be an agent of the service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/queue-getQueues
+func (s *ServiceDeskService) GetQueuesWithContext(ctx context.Context, serviceDeskID string, options *QueueListOptions) (*QueuesList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/queue", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(QueuesList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetQueues wraps GetQueuesWithContext using the background context.
+func (s *ServiceDeskService) GetQueues(serviceDeskID string, options *QueueListOptions) (*QueuesList, *Response, error) {
+	return s.GetQueuesWithContext(context.Background(), serviceDeskID, options)
+}
+
+// GetQueueWithContext returns a queue of a service desk. Only the IncludeCount of the options is used.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/queue-getQueue
+func (s *ServiceDeskService) GetQueueWithContext(ctx context.Context, serviceDeskID, queueID string, options *QueueListOptions) (*Queue, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/queue/%s", serviceDeskID, queueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queue := new(Queue)
+	resp, err := s.client.Do(req, queue)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return queue, resp, nil
+}
+
+// GetQueue wraps GetQueueWithContext using the background context.
+func (s *ServiceDeskService) GetQueue(serviceDeskID, queueID string, options *QueueListOptions) (*Queue, *Response, error) {
+	return s.GetQueueWithContext(context.Background(), serviceDeskID, queueID, options)
+}
+
+// GetQueueIssuesWithContext returns a page of the issues in a queue, in the order of the queue.
+// The issues hold the fields shown in the queue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/queue-getIssuesInQueue
+func (s *ServiceDeskService) GetQueueIssuesWithContext(ctx context.Context, serviceDeskID, queueID string, options *ServiceDeskListOptions) (*QueueIssuesList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/queue/%s/issue", serviceDeskID, queueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(QueueIssuesList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetQueueIssues wraps GetQueueIssuesWithContext using the background context.
+func (s *ServiceDeskService) GetQueueIssues(serviceDeskID, queueID string, options *ServiceDeskListOptions) (*QueueIssuesList, *Response, error) {
+	return s.GetQueueIssuesWithContext(context.Background(), serviceDeskID, queueID, options)
+}
+
+// GetQueueIssuesPagesWithContext calls f for the issues of all pages of a queue, starting at the Start of the options.
+// It stops at the first error returned by f.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/queue-getIssuesInQueue
+func (s *ServiceDeskService) GetQueueIssuesPagesWithContext(ctx context.Context, serviceDeskID, queueID string, options *ServiceDeskListOptions, f func(Issue) error) error {
+	opt := ServiceDeskListOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	for {
+		result, _, err := s.GetQueueIssuesWithContext(ctx, serviceDeskID, queueID, &opt)
+		if err != nil {
+			return err
+		}
+		for _, issue := range result.Values {
+			if err := f(issue); err != nil {
+				return err
+			}
+		}
+		if result.IsLastPage || len(result.Values) == 0 {
+			return nil
+		}
+		opt.Start += len(result.Values)
+	}
+}
+
+// GetQueueIssuesPages wraps GetQueueIssuesPagesWithContext using the background context.
+func (s *ServiceDeskService) GetQueueIssuesPages(serviceDeskID, queueID string, options *ServiceDeskListOptions, f func(Issue) error) error {
+	return s.GetQueueIssuesPagesWithContext(context.Background(), serviceDeskID, queueID, options, f)
+}
@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetQueues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/queue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?includeCount=true")
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[
+			{"id":"10","name":"Unassigned issues","jql":"project = SD AND assignee is EMPTY","fields":["issuetype","issuekey","summary"],"issueCount":10},
+			{"id":"20","name":"Assigned to me","jql":"project = SD AND assignee = currentUser()","fields":["issuetype","issuekey"],"issueCount":0}]}`)
+	})
+
+	queues, _, err := testClient.ServiceDesk.GetQueues("10", &QueueListOptions{IncludeCount: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if queues == nil || len(queues.Values) != 2 || queues.Values[0].IssueCount != 10 || len(queues.Values[0].Fields) != 3 {
+		t.Errorf("Expected 2 queues with issue counts. Got %+v", queues)
+	}
+}
+
+func TestServiceDeskService_GetQueue(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/queue/20"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"20","name":"Assigned to me","jql":"project = SD AND assignee = currentUser()"}`)
+	})
+
+	queue, _, err := testClient.ServiceDesk.GetQueue("10", "20", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if queue == nil || queue.Name != "Assigned to me" {
+		t.Errorf("Expected queue Assigned to me. Got %+v", queue)
+	}
+}
+
+func TestServiceDeskService_GetQueueIssuesPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/queue/20/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("start") {
+		case "":
+			testRequestURL(t, r, testAPIEndpoint+"?limit=2")
+			fmt.Fprint(w, `{"size":2,"start":0,"limit":2,"isLastPage":false,"values":[{"id":"10001","key":"SD-1","fields":{"summary":"first"}},{"id":"10002","key":"SD-2"}]}`)
+		case "2":
+			testRequestURL(t, r, testAPIEndpoint+"?limit=2&start=2")
+			fmt.Fprint(w, `{"size":1,"start":2,"limit":2,"isLastPage":true,"values":[{"id":"10003","key":"SD-3"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var keys []string
+	err := testClient.ServiceDesk.GetQueueIssuesPages("10", "20", &ServiceDeskListOptions{Limit: 2}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(keys) != 3 || keys[2] != "SD-3" {
+		t.Errorf("Expected the issues of both pages. Got %v", keys)
+	}
+}
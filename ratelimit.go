@@ -0,0 +1,14 @@
+package jira
+
+import "context"
+
+// RateLimiter throttles the requests sent to JIRA on the client side,
+// e.g. to stay below the per-user quotas enforced by Atlassian Cloud.
+//
+// Wait blocks until the next request may be sent, or returns an error if ctx is done first.
+// A *rate.Limiter of golang.org/x/time/rate satisfies this interface:
+//
+//	client, err := jira.NewClient("https://my.jira.com", jira.WithRateLimiter(rate.NewLimiter(rate.Limit(10), 1)))
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
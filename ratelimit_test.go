@@ -0,0 +1,58 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testRateLimiter struct {
+	waits int
+	err   error
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return l.err
+}
+
+func TestClient_Do_RateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	})
+
+	limiter := &testRateLimiter{}
+	testClient.RateLimiter = limiter
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 2, InitialInterval: time.Millisecond}
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if limiter.waits != 2 {
+		t.Errorf("Expected the limiter to be asked for every attempt, %d waits given", limiter.waits)
+	}
+}
+
+func TestClient_Do_RateLimiterError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request should not have been sent")
+	})
+
+	testClient.RateLimiter = &testRateLimiter{err: errors.New("rate: Wait(n=1) would exceed context deadline")}
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	if _, err := testClient.Do(req, nil); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
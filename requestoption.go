@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestOption changes a single request before it is sent, e.g. to add a header or query parameter
+// an endpoint needs which the method calling it does not set.
+type RequestOption func(req *http.Request)
+
+// requestOptionsKey is the context key of the RequestOptions attached by WithRequestOptions
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx which applies the given options to all requests created with it,
+// in addition to the options already attached to ctx.
+// Pass it to the ...WithContext methods of the services:
+//
+//	ctx := jira.WithRequestOptions(context.Background(),
+//		jira.RequestHeader("X-Force-Accept-Language", "true"),
+//		jira.RequestHeader("Accept-Language", "de"),
+//	)
+//	issue, _, err := client.Issue.GetWithContext(ctx, "EX-1", nil)
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing := requestOptionsFromContext(ctx)
+	combined := make([]RequestOption, 0, len(existing)+len(opts))
+	combined = append(combined, existing...)
+	combined = append(combined, opts...)
+	return context.WithValue(ctx, requestOptionsKey{}, combined)
+}
+
+// RequestHeader returns a RequestOption adding a header to the request.
+func RequestHeader(key, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Add(key, value)
+	}
+}
+
+// RequestQuery returns a RequestOption adding a query parameter to the request.
+func RequestQuery(key, value string) RequestOption {
+	return func(req *http.Request) {
+		query := req.URL.Query()
+		query.Add(key, value)
+		req.URL.RawQuery = query.Encode()
+	}
+}
+
+// requestOptionsFromContext returns the RequestOptions attached to ctx by WithRequestOptions
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return opts
+}
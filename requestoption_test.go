@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?expand=names&properties=a")
+		if got := r.Header.Get("X-Force-Accept-Language"); got != "true" {
+			t.Errorf("Expected the header X-Force-Accept-Language. Got %q", got)
+		}
+		if got := r.Header.Get("Accept-Language"); got != "de" {
+			t.Errorf("Expected the header Accept-Language. Got %q", got)
+		}
+		w.Write([]byte(`{"key":"EX-1"}`))
+	})
+
+	ctx := WithRequestOptions(context.Background(), RequestHeader("X-Force-Accept-Language", "true"))
+	ctx = WithRequestOptions(ctx, RequestHeader("Accept-Language", "de"), RequestQuery("properties", "a"))
+
+	req, err := testClient.NewRequestWithContext(ctx, "GET", "rest/api/2/issue/EX-1?expand=names", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWithRequestOptions_DoesNotChangeParent(t *testing.T) {
+	parent := WithRequestOptions(context.Background(), RequestHeader("A", "1"))
+	WithRequestOptions(parent, RequestHeader("B", "2"))
+
+	if got := len(requestOptionsFromContext(parent)); got != 1 {
+		t.Errorf("Expected 1 option on the parent context. Got %d", got)
+	}
+}
@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // ResolutionService handles resolutions for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-Resolution
@@ -16,12 +21,12 @@ type Resolution struct {
 	Name        string `json:"name" structs:"name"`
 }
 
-// GetList gets all resolutions from JIRA
+// GetListWithContext gets all resolutions from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-resolution-get
-func (s *ResolutionService) GetList() ([]Resolution, *Response, error) {
+func (s *ResolutionService) GetListWithContext(ctx context.Context) ([]Resolution, *Response, error) {
 	apiEndpoint := "rest/api/2/resolution"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -33,3 +38,31 @@ func (s *ResolutionService) GetList() ([]Resolution, *Response, error) {
 	}
 	return resolutionList, resp, nil
 }
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ResolutionService) GetList() ([]Resolution, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the resolution for a given resolution id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/resolution-getResolution
+func (s *ResolutionService) GetWithContext(ctx context.Context, resolutionID string) (*Resolution, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/resolution/%s", resolutionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolution := new(Resolution)
+	resp, err := s.client.Do(req, resolution)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return resolution, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ResolutionService) Get(resolutionID string) (*Resolution, *Response, error) {
+	return s.GetWithContext(context.Background(), resolutionID)
+}
@@ -30,3 +30,23 @@ func TestResolutionService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestResolutionService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/resolution/1"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/resolution/1","description":"A fix for this issue is checked into the tree and tested.","name":"Fixed","id":"1"}`)
+	})
+
+	resolution, _, err := testClient.Resolution.Get("1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if resolution == nil || resolution.Name != "Fixed" {
+		t.Errorf("Expected resolution Fixed. Got %+v", resolution)
+	}
+}
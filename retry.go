@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries requests which JIRA rejected
+// because of rate limiting (HTTP 429) or a server side error (HTTP 5xx).
+// Server side errors are only retried for idempotent requests (GET, HEAD, PUT and DELETE),
+// as JIRA may have processed a POST anyway, e.g. created the issue, before it failed.
+// Between two attempts the client waits with exponential backoff and jitter,
+// unless JIRA tells it how long to wait with a Retry-After header.
+//
+// A request is only retried if its body can be sent again, which is the case
+// for all requests created by the NewRequest methods of the Client.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first attempt.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// MaxElapsedTime limits the total time spent on a request including all retries.
+	// No further attempt is made once waiting for it would exceed this limit.
+	// Zero means no limit.
+	MaxElapsedTime time.Duration
+
+	// InitialInterval is the backoff before the first retry. It doubles with every attempt.
+	// Defaults to 500 milliseconds.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the backoff between two attempts.
+	// Defaults to 30 seconds.
+	MaxInterval time.Duration
+
+	// RetryNonIdempotent retries requests of all methods on server side errors,
+	// at the risk of e.g. creating an issue twice.
+	RetryNonIdempotent bool
+}
+
+// NewRetryPolicy returns a RetryPolicy making at most maxAttempts attempts
+// within maxElapsedTime, using the default backoff intervals.
+func NewRetryPolicy(maxAttempts int, maxElapsedTime time.Duration) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		MaxElapsedTime: maxElapsedTime,
+	}
+}
+
+// do sends req using send and retries it as long as the policy allows.
+func (p *RetryPolicy) do(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		resp, err := send(req)
+		if err != nil || attempt >= p.MaxAttempts || !p.shouldRetry(req, resp) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait := retryAfter(resp)
+		if wait < 0 {
+			wait = p.backoff(attempt)
+		}
+		if p.MaxElapsedTime > 0 && time.Since(start)+wait > p.MaxElapsedTime {
+			return resp, err
+		}
+
+		// The response is discarded, so drain it to allow the connection to be reused
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the time to wait before the retry following the given attempt.
+// Half of the interval is randomized so that concurrent clients do not retry in lockstep.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	interval := p.InitialInterval
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+
+	for i := 1; i < attempt && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	half := interval / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// shouldRetry reports whether req, which lead to resp, may succeed when sent again.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode >= 500 && (p.RetryNonIdempotent || isIdempotent(req.Method))
+}
+
+// isIdempotent reports whether sending a request with the given method twice has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait time requested by the Retry-After header of resp.
+// It returns -1 if the header is missing or invalid.
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return -1
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(time.Now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait
+	}
+	return -1
+}
@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_RetryOnServerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"key":"EX-1"}`+"\n" {
+			t.Errorf("Attempt %d: unexpected body %q", attempts, body)
+		}
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"key":"EX-1"}`)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, RetryNonIdempotent: true}
+	req, _ := testClient.NewRequest("POST", "rest/api/2/issue", &Issue{Key: "EX-1"})
+	issue := new(Issue)
+	if _, err := testClient.Do(req, issue); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, %d given", attempts)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, %q given", issue.Key)
+	}
+}
+
+func TestClient_Do_RetryIdempotentOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := map[string]int{}
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		attempts[r.Method]++
+		if attempts[r.Method] == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"key":"EX-1"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		attempts["bulk"]++
+		if attempts["bulk"] == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"issues":[]}`)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond}
+	req, _ := testClient.NewRequest("POST", "rest/api/2/issue", &Issue{Key: "EX-1"})
+	if _, err := testClient.Do(req, nil); err == nil {
+		t.Error("Expected the server error of the POST request. Got none")
+	}
+	req, _ = testClient.NewRequest("PUT", "rest/api/2/issue", &Issue{Key: "EX-1"})
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	req, _ = testClient.NewRequest("POST", "rest/api/2/issue/bulk", nil)
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if attempts["POST"] != 1 || attempts["PUT"] != 2 || attempts["bulk"] != 2 {
+		t.Errorf("Expected the POST request to be retried only when rate limited. Got %v attempts", attempts)
+	}
+}
+
+func TestClient_Do_RetryGivesUp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	testClient.RetryPolicy = NewRetryPolicy(2, time.Minute)
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	resp, err := testClient.Do(req, nil)
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d, %d given", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, %d given", attempts)
+	}
+}
+
+func TestClient_Do_NoRetryOnClientError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	testClient.RetryPolicy = &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond}
+	req, _ := testClient.NewRequest("GET", "/", nil)
+	testClient.Do(req, nil)
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, %d given", attempts)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := &RetryPolicy{InitialInterval: time.Second, MaxInterval: 4 * time.Second}
+	for attempt, max := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 10: 4 * time.Second} {
+		if got := p.backoff(attempt); got < max/2 || got > max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, max/2, max)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	if got := retryAfter(header("120")); got != 2*time.Minute {
+		t.Errorf("retryAfter(120) = %v, want %v", got, 2*time.Minute)
+	}
+	if got := retryAfter(header(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))); got != 0 {
+		t.Errorf("retryAfter(past date) = %v, want 0", got)
+	}
+	if got := retryAfter(header("soon")); got != -1 {
+		t.Errorf("retryAfter(soon) = %v, want -1", got)
+	}
+	if got := retryAfter(&http.Response{Header: http.Header{}}); got != -1 {
+		t.Errorf("retryAfter() = %v, want -1", got)
+	}
+}
@@ -0,0 +1,236 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ProjectRoleService handles project roles and their actors for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/role
+type ProjectRoleService struct {
+	client *Client
+}
+
+// Role represents a JIRA project role.
+// The Actors are only returned for the role of a project.
+type Role struct {
+	Self        string      `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string      `json:"name,omitempty" structs:"name,omitempty"`
+	ID          int         `json:"id,omitempty" structs:"id,omitempty"`
+	Description string      `json:"description,omitempty" structs:"description,omitempty"`
+	Actors      []RoleActor `json:"actors,omitempty" structs:"actors,omitempty"`
+}
+
+// These constants are the types of role actors
+const (
+	RoleActorTypeUser  = "atlassian-user-role-actor"
+	RoleActorTypeGroup = "atlassian-group-role-actor"
+)
+
+// RoleActor represents a user or a group which belongs to a role of a project.
+// Type is one of the RoleActorType constants.
+// On JIRA Cloud the ActorUser or the ActorGroup identify the actor.
+type RoleActor struct {
+	ID          int             `json:"id,omitempty" structs:"id,omitempty"`
+	DisplayName string          `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Type        string          `json:"type,omitempty" structs:"type,omitempty"`
+	Name        string          `json:"name,omitempty" structs:"name,omitempty"`
+	AvatarURL   string          `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	ActorUser   *RoleActorUser  `json:"actorUser,omitempty" structs:"actorUser,omitempty"`
+	ActorGroup  *RoleActorGroup `json:"actorGroup,omitempty" structs:"actorGroup,omitempty"`
+}
+
+// RoleActorUser identifies the user of a role actor on JIRA Cloud
+type RoleActorUser struct {
+	AccountID string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+}
+
+// RoleActorGroup identifies the group of a role actor on JIRA Cloud
+type RoleActorGroup struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+}
+
+// roleActors is the list of users or groups added to a role of a project
+type roleActors struct {
+	User  []string `json:"user,omitempty" structs:"user,omitempty"`
+	Group []string `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// GetListWithContext gets all project roles from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/role-getProjectRoles
+func (s *ProjectRoleService) GetListWithContext(ctx context.Context) ([]Role, *Response, error) {
+	apiEndpoint := "rest/api/2/role"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roleList := []Role{}
+	resp, err := s.client.Do(req, &roleList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return roleList, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ProjectRoleService) GetList() ([]Role, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the project role for a given role id
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/role-getProjectRolesById
+func (s *ProjectRoleService) GetWithContext(ctx context.Context, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/role/%d", roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ProjectRoleService) Get(roleID int) (*Role, *Response, error) {
+	return s.GetWithContext(context.Background(), roleID)
+}
+
+// GetProjectRolesWithContext returns the roles of the project for a given project id or key.
+// The result maps the role names to the URLs of the roles in the project.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-getProjectRoles
+func (s *ProjectRoleService) GetProjectRolesWithContext(ctx context.Context, projectIDOrKey string) (map[string]string, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := map[string]string{}
+	resp, err := s.client.Do(req, &roles)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return roles, resp, nil
+}
+
+// GetProjectRoles wraps GetProjectRolesWithContext using the background context.
+func (s *ProjectRoleService) GetProjectRoles(projectIDOrKey string) (map[string]string, *Response, error) {
+	return s.GetProjectRolesWithContext(context.Background(), projectIDOrKey)
+}
+
+// GetProjectRoleWithContext returns the role with the given id of the project for a given project id or key, including its actors.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-getProjectRole
+func (s *ProjectRoleService) GetProjectRoleWithContext(ctx context.Context, projectIDOrKey string, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectIDOrKey, roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// GetProjectRole wraps GetProjectRoleWithContext using the background context.
+func (s *ProjectRoleService) GetProjectRole(projectIDOrKey string, roleID int) (*Role, *Response, error) {
+	return s.GetProjectRoleWithContext(context.Background(), projectIDOrKey, roleID)
+}
+
+// addActors adds the users or groups to the role with the given id of a project
+func (s *ProjectRoleService) addActors(ctx context.Context, projectIDOrKey string, roleID int, actors *roleActors) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectIDOrKey, roleID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// AddUsersWithContext adds users as actors to the role with the given id of the project for a given project id or key.
+// The users are identified by their names, or by their account ids on JIRA Cloud.
+// The role is returned with all of its actors.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-addActorUsers
+func (s *ProjectRoleService) AddUsersWithContext(ctx context.Context, projectIDOrKey string, roleID int, users []string) (*Role, *Response, error) {
+	return s.addActors(ctx, projectIDOrKey, roleID, &roleActors{User: users})
+}
+
+// AddUsers wraps AddUsersWithContext using the background context.
+func (s *ProjectRoleService) AddUsers(projectIDOrKey string, roleID int, users []string) (*Role, *Response, error) {
+	return s.AddUsersWithContext(context.Background(), projectIDOrKey, roleID, users)
+}
+
+// AddGroupsWithContext adds groups as actors to the role with the given id of the project for a given project id or key.
+// The role is returned with all of its actors.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-addActorUsers
+func (s *ProjectRoleService) AddGroupsWithContext(ctx context.Context, projectIDOrKey string, roleID int, groups []string) (*Role, *Response, error) {
+	return s.addActors(ctx, projectIDOrKey, roleID, &roleActors{Group: groups})
+}
+
+// AddGroups wraps AddGroupsWithContext using the background context.
+func (s *ProjectRoleService) AddGroups(projectIDOrKey string, roleID int, groups []string) (*Role, *Response, error) {
+	return s.AddGroupsWithContext(context.Background(), projectIDOrKey, roleID, groups)
+}
+
+// removeActor removes the actor identified by the query parameter from the role with the given id of a project
+func (s *ProjectRoleService) removeActor(ctx context.Context, projectIDOrKey string, roleID int, parameter, value string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d?%s=%s", projectIDOrKey, roleID, parameter, url.QueryEscape(value))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveUserWithContext removes a user from the actors of the role with the given id of the project for a given project id or key.
+// The user is identified by its name, or by its account id on JIRA Cloud.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-deleteActor
+func (s *ProjectRoleService) RemoveUserWithContext(ctx context.Context, projectIDOrKey string, roleID int, user string) (*Response, error) {
+	return s.removeActor(ctx, projectIDOrKey, roleID, "user", user)
+}
+
+// RemoveUser wraps RemoveUserWithContext using the background context.
+func (s *ProjectRoleService) RemoveUser(projectIDOrKey string, roleID int, user string) (*Response, error) {
+	return s.RemoveUserWithContext(context.Background(), projectIDOrKey, roleID, user)
+}
+
+// RemoveGroupWithContext removes a group from the actors of the role with the given id of the project for a given project id or key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/project/{projectIdOrKey}/role-deleteActor
+func (s *ProjectRoleService) RemoveGroupWithContext(ctx context.Context, projectIDOrKey string, roleID int, group string) (*Response, error) {
+	return s.removeActor(ctx, projectIDOrKey, roleID, "group", group)
+}
+
+// RemoveGroup wraps RemoveGroupWithContext using the background context.
+func (s *ProjectRoleService) RemoveGroup(projectIDOrKey string, roleID int, group string) (*Response, error) {
+	return s.RemoveGroupWithContext(context.Background(), projectIDOrKey, roleID, group)
+}
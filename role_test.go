@@ -0,0 +1,180 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestProjectRoleService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/role"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":10002,"name":"Administrators"},{"id":10001,"name":"Developers"}]`)
+	})
+
+	roles, _, err := testClient.ProjectRole.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(roles) != 2 || roles[0].Name != "Administrators" {
+		t.Errorf("Expected 2 roles. Got %+v", roles)
+	}
+}
+
+func TestProjectRoleService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10002,"name":"Administrators","description":"Project administrators"}`)
+	})
+
+	role, _, err := testClient.ProjectRole.Get(10002)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || role.Name != "Administrators" {
+		t.Errorf("Expected role Administrators. Got %+v", role)
+	}
+}
+
+func TestProjectRoleService_GetProjectRoles(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"Administrators":"https://my.jira.com/rest/api/2/project/ABC/role/10002",
+			"Developers":"https://my.jira.com/rest/api/2/project/ABC/role/10001"}`)
+	})
+
+	roles, _, err := testClient.ProjectRole.GetProjectRoles("ABC")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(roles) != 2 || roles["Administrators"] != "https://my.jira.com/rest/api/2/project/ABC/role/10002" {
+		t.Errorf("Expected 2 roles. Got %+v", roles)
+	}
+}
+
+func TestProjectRoleService_GetProjectRole(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":10002,"name":"Administrators","actors":[
+			{"id":10240,"displayName":"jira-administrators","type":"atlassian-group-role-actor","name":"jira-administrators",
+				"actorGroup":{"name":"jira-administrators","displayName":"jira-administrators"}},
+			{"id":10241,"displayName":"Fred F. User","type":"atlassian-user-role-actor","name":"fred",
+				"actorUser":{"accountId":"5b10a2844c20165700ede21g"}}]}`)
+	})
+
+	role, _, err := testClient.ProjectRole.GetProjectRole("ABC", 10002)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || len(role.Actors) != 2 {
+		t.Fatalf("Expected role with 2 actors. Got %+v", role)
+	}
+	if role.Actors[0].Type != RoleActorTypeGroup || role.Actors[0].ActorGroup.Name != "jira-administrators" {
+		t.Errorf("Expected group actor. Got %+v", role.Actors[0])
+	}
+	if role.Actors[1].Type != RoleActorTypeUser || role.Actors[1].ActorUser.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected user actor. Got %+v", role.Actors[1])
+	}
+}
+
+func TestProjectRoleService_AddUsers(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"user":["fred"]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10002,"name":"Administrators","actors":[{"id":10241,"type":"atlassian-user-role-actor","name":"fred"}]}`)
+	})
+
+	role, _, err := testClient.ProjectRole.AddUsers("ABC", 10002, []string{"fred"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if role == nil || len(role.Actors) != 1 || role.Actors[0].Name != "fred" {
+		t.Errorf("Expected role with actor fred. Got %+v", role)
+	}
+}
+
+func TestProjectRoleService_AddGroups(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"group":["team-leads"]}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10002,"name":"Administrators","actors":[{"id":10242,"type":"atlassian-group-role-actor","name":"team-leads"}]}`)
+	})
+
+	_, _, err := testClient.ProjectRole.AddGroups("ABC", 10002, []string{"team-leads"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectRoleService_RemoveUser(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?user=fred")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.ProjectRole.RemoveUser("ABC", 10002, "fred")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectRoleService_RemoveGroup(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/project/ABC/role/10002"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?group=team+leads")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.ProjectRole.RemoveGroup("ABC", 10002, "team leads")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,288 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ScreenService handles screens, their tabs and the fields on them for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens
+type ScreenService struct {
+	client *Client
+}
+
+// Screen represents a JIRA screen, which shows the fields of an issue on its tabs
+type Screen struct {
+	ID          int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// ScreenTab represents a tab of a screen
+type ScreenTab struct {
+	ID   int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// ScreenField represents a field on a tab of a screen, or a field which can be added to a screen
+type ScreenField struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// ScreenListOptions specifies the optional parameters to the ScreenService.GetList method
+type ScreenListOptions struct {
+	// IDs restricts the result to the screens with these ids
+	IDs []int `url:"id,omitempty"`
+	// StartAt is the index of the first screen to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of screens to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// screenListResult is a page of screens
+type screenListResult struct {
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Screens    []Screen `json:"values"`
+}
+
+// screenFieldID identifies the field to add to a tab of a screen
+type screenFieldID struct {
+	FieldID string `json:"fieldId" structs:"fieldId"`
+}
+
+// GetListWithContext returns a page of the screens.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-screens-get
+func (s *ScreenService) GetListWithContext(ctx context.Context, options *ScreenListOptions) ([]Screen, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/screens", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(screenListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Screens, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ScreenService) GetList(options *ScreenListOptions) ([]Screen, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetAvailableFieldsWithContext returns the fields which can be added to the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-getFieldsToAdd
+func (s *ScreenService) GetAvailableFieldsWithContext(ctx context.Context, screenID int) ([]ScreenField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/availableFields", screenID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := []ScreenField{}
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return fields, resp, nil
+}
+
+// GetAvailableFields wraps GetAvailableFieldsWithContext using the background context.
+func (s *ScreenService) GetAvailableFields(screenID int) ([]ScreenField, *Response, error) {
+	return s.GetAvailableFieldsWithContext(context.Background(), screenID)
+}
+
+// AddFieldToDefaultScreenWithContext adds the field with the given id to the default tab of the default screen.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-addFieldToDefaultScreen
+func (s *ScreenService) AddFieldToDefaultScreenWithContext(ctx context.Context, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/addToDefault/%s", fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddFieldToDefaultScreen wraps AddFieldToDefaultScreenWithContext using the background context.
+func (s *ScreenService) AddFieldToDefaultScreen(fieldID string) (*Response, error) {
+	return s.AddFieldToDefaultScreenWithContext(context.Background(), fieldID)
+}
+
+// GetTabsWithContext returns all tabs of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-getAllTabs
+func (s *ScreenService) GetTabsWithContext(ctx context.Context, screenID int) ([]ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tabs := []ScreenTab{}
+	resp, err := s.client.Do(req, &tabs)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return tabs, resp, nil
+}
+
+// GetTabs wraps GetTabsWithContext using the background context.
+func (s *ScreenService) GetTabs(screenID int) ([]ScreenTab, *Response, error) {
+	return s.GetTabsWithContext(context.Background(), screenID)
+}
+
+// AddTabWithContext adds a tab with the given name to the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-addTab
+func (s *ScreenService) AddTabWithContext(ctx context.Context, screenID int, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs", screenID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &ScreenTab{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tab := new(ScreenTab)
+	resp, err := s.client.Do(req, tab)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return tab, resp, nil
+}
+
+// AddTab wraps AddTabWithContext using the background context.
+func (s *ScreenService) AddTab(screenID int, name string) (*ScreenTab, *Response, error) {
+	return s.AddTabWithContext(context.Background(), screenID, name)
+}
+
+// RenameTabWithContext renames the tab with the given id of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-renameTab
+func (s *ScreenService) RenameTabWithContext(ctx context.Context, screenID, tabID int, name string) (*ScreenTab, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &ScreenTab{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tab := new(ScreenTab)
+	resp, err := s.client.Do(req, tab)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return tab, resp, nil
+}
+
+// RenameTab wraps RenameTabWithContext using the background context.
+func (s *ScreenService) RenameTab(screenID, tabID int, name string) (*ScreenTab, *Response, error) {
+	return s.RenameTabWithContext(context.Background(), screenID, tabID, name)
+}
+
+// DeleteTabWithContext deletes the tab with the given id of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-deleteTab
+func (s *ScreenService) DeleteTabWithContext(ctx context.Context, screenID, tabID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d", screenID, tabID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteTab wraps DeleteTabWithContext using the background context.
+func (s *ScreenService) DeleteTab(screenID, tabID int) (*Response, error) {
+	return s.DeleteTabWithContext(context.Background(), screenID, tabID)
+}
+
+// GetTabFieldsWithContext returns the fields on the tab with the given id of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-getAllFields
+func (s *ScreenService) GetTabFieldsWithContext(ctx context.Context, screenID, tabID int) ([]ScreenField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := []ScreenField{}
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return fields, resp, nil
+}
+
+// GetTabFields wraps GetTabFieldsWithContext using the background context.
+func (s *ScreenService) GetTabFields(screenID, tabID int) ([]ScreenField, *Response, error) {
+	return s.GetTabFieldsWithContext(context.Background(), screenID, tabID)
+}
+
+// AddTabFieldWithContext adds the field with the given id to the tab with the given id of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-addField
+func (s *ScreenService) AddTabFieldWithContext(ctx context.Context, screenID, tabID int, fieldID string) (*ScreenField, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields", screenID, tabID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &screenFieldID{FieldID: fieldID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	field := new(ScreenField)
+	resp, err := s.client.Do(req, field)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return field, resp, nil
+}
+
+// AddTabField wraps AddTabFieldWithContext using the background context.
+func (s *ScreenService) AddTabField(screenID, tabID int, fieldID string) (*ScreenField, *Response, error) {
+	return s.AddTabFieldWithContext(context.Background(), screenID, tabID, fieldID)
+}
+
+// RemoveTabFieldWithContext removes the field with the given id from the tab with the given id of the screen for a given screen id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/screens-removeField
+func (s *ScreenService) RemoveTabFieldWithContext(ctx context.Context, screenID, tabID int, fieldID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/screens/%d/tabs/%d/fields/%s", screenID, tabID, fieldID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveTabField wraps RemoveTabFieldWithContext using the background context.
+func (s *ScreenService) RemoveTabField(screenID, tabID int, fieldID string) (*Response, error) {
+	return s.RemoveTabFieldWithContext(context.Background(), screenID, tabID, fieldID)
+}
@@ -0,0 +1,217 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestScreenService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":40,"isLast":false,"values":[
+			{"id":1,"name":"Default Screen"},{"id":2,"name":"Workflow Screen"}]}`)
+	})
+
+	screens, resp, err := testClient.Screen.GetList(&ScreenListOptions{MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(screens) != 2 || screens[0].Name != "Default Screen" {
+		t.Errorf("Expected 2 screens. Got %+v", screens)
+	}
+	if resp.Total != 40 {
+		t.Errorf("Expected total 40. Got %d", resp.Total)
+	}
+}
+
+func TestScreenService_GetAvailableFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/availableFields"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"customfield_10100","name":"Severity"}]`)
+	})
+
+	fields, _, err := testClient.Screen.GetAvailableFields(1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "customfield_10100" {
+		t.Errorf("Expected field customfield_10100. Got %+v", fields)
+	}
+}
+
+func TestScreenService_AddFieldToDefaultScreen(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/addToDefault/customfield_10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+	})
+
+	_, err := testClient.Screen.AddFieldToDefaultScreen("customfield_10100")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_GetTabs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":10000,"name":"Field Tab"},{"id":10001,"name":"Incident"}]`)
+	})
+
+	tabs, _, err := testClient.Screen.GetTabs(1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(tabs) != 2 || tabs[1].ID != 10001 {
+		t.Errorf("Expected 2 tabs. Got %+v", tabs)
+	}
+}
+
+func TestScreenService_AddTab(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"Incident"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"Incident"}`)
+	})
+
+	tab, _, err := testClient.Screen.AddTab(1, "Incident")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if tab == nil || tab.ID != 10001 {
+		t.Errorf("Expected tab 10001. Got %+v", tab)
+	}
+}
+
+func TestScreenService_RenameTab(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"Outage"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"Outage"}`)
+	})
+
+	tab, _, err := testClient.Screen.RenameTab(1, 10001, "Outage")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if tab == nil || tab.Name != "Outage" {
+		t.Errorf("Expected tab Outage. Got %+v", tab)
+	}
+}
+
+func TestScreenService_DeleteTab(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Screen.DeleteTab(1, 10001)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestScreenService_GetTabFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs/10000/fields"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"description","name":"Description"}]`)
+	})
+
+	fields, _, err := testClient.Screen.GetTabFields(1, 10000)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(fields) != 2 || fields[0].ID != "summary" {
+		t.Errorf("Expected 2 fields. Got %+v", fields)
+	}
+}
+
+func TestScreenService_AddTabField(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs/10000/fields"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"fieldId":"customfield_10100"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		fmt.Fprint(w, `{"id":"customfield_10100","name":"Severity"}`)
+	})
+
+	field, _, err := testClient.Screen.AddTabField(1, 10000, "customfield_10100")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if field == nil || field.Name != "Severity" {
+		t.Errorf("Expected field Severity. Got %+v", field)
+	}
+}
+
+func TestScreenService_RemoveTabField(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/screens/1/tabs/10000/fields/customfield_10100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Screen.RemoveTabField(1, 10000, "customfield_10100")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
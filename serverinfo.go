@@ -0,0 +1,104 @@
+package jira
+
+import "context"
+
+// ServerInfoService handles the information about the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/serverInfo
+type ServerInfoService struct {
+	client *Client
+}
+
+// These constants are the deployment types of JIRA
+const (
+	DeploymentTypeCloud  = "Cloud"
+	DeploymentTypeServer = "Server"
+)
+
+// ServerInfo represents the information about a JIRA instance.
+// The HealthChecks are only returned when they are requested.
+type ServerInfo struct {
+	BaseURL        string        `json:"baseUrl,omitempty" structs:"baseUrl,omitempty"`
+	Version        string        `json:"version,omitempty" structs:"version,omitempty"`
+	VersionNumbers []int         `json:"versionNumbers,omitempty" structs:"versionNumbers,omitempty"`
+	DeploymentType string        `json:"deploymentType,omitempty" structs:"deploymentType,omitempty"`
+	BuildNumber    int           `json:"buildNumber,omitempty" structs:"buildNumber,omitempty"`
+	BuildDate      *Time         `json:"buildDate,omitempty" structs:"buildDate,omitempty"`
+	ServerTime     *Time         `json:"serverTime,omitempty" structs:"serverTime,omitempty"`
+	ScmInfo        string        `json:"scmInfo,omitempty" structs:"scmInfo,omitempty"`
+	ServerTitle    string        `json:"serverTitle,omitempty" structs:"serverTitle,omitempty"`
+	HealthChecks   []HealthCheck `json:"healthChecks,omitempty" structs:"healthChecks,omitempty"`
+}
+
+// HealthCheck represents the result of a health check of a JIRA instance
+type HealthCheck struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Passed      bool   `json:"passed,omitempty" structs:"passed,omitempty"`
+}
+
+// ServerInfoOptions specifies the optional parameters to the ServerInfoService.Get method
+type ServerInfoOptions struct {
+	// DoHealthCheck runs the health checks of the instance and returns their results
+	DoHealthCheck bool `url:"doHealthCheck,omitempty"`
+}
+
+// IsCloud reports whether the JIRA instance is a JIRA Cloud site.
+// Instances of JIRA Server and JIRA Data Center are not.
+func (i *ServerInfo) IsCloud() bool {
+	return i.DeploymentType == DeploymentTypeCloud
+}
+
+// Healthy reports whether all health checks of the JIRA instance passed
+func (i *ServerInfo) Healthy() bool {
+	for _, check := range i.HealthChecks {
+		if !check.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWithContext returns the information about the JIRA instance, e.g. its version and deployment type.
+// The information is available without authentication.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/serverInfo-getServerInfo
+func (s *ServerInfoService) GetWithContext(ctx context.Context, options *ServerInfoOptions) (*ServerInfo, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/serverInfo", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := new(ServerInfo)
+	resp, err := s.client.Do(req, info)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return info, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ServerInfoService) Get(options *ServerInfoOptions) (*ServerInfo, *Response, error) {
+	return s.GetWithContext(context.Background(), options)
+}
+
+// IsCloudWithContext reports whether the client talks to a JIRA Cloud site.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/serverInfo-getServerInfo
+func (s *ServerInfoService) IsCloudWithContext(ctx context.Context) (bool, *Response, error) {
+	info, resp, err := s.GetWithContext(ctx, nil)
+	if err != nil {
+		return false, resp, err
+	}
+	return info.IsCloud(), resp, nil
+}
+
+// IsCloud wraps IsCloudWithContext using the background context.
+func (s *ServerInfoService) IsCloud() (bool, *Response, error) {
+	return s.IsCloudWithContext(context.Background())
+}
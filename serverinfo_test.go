@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServerInfoService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/serverInfo"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"baseUrl":"https://jira.example.com","version":"8.5.4","versionNumbers":[8,5,4],
+			"deploymentType":"Server","buildNumber":805004,"buildDate":"2020-02-12T00:00:00.000+0000",
+			"serverTime":"2020-05-13T10:33:14.806+0000","scmInfo":"a4b5b8e","serverTitle":"Example JIRA"}`)
+	})
+
+	info, _, err := testClient.ServerInfo.Get(nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if info == nil || info.Version != "8.5.4" || info.BuildNumber != 805004 || len(info.VersionNumbers) != 3 {
+		t.Fatalf("Expected version 8.5.4. Got %+v", info)
+	}
+	if info.IsCloud() {
+		t.Error("Expected a Server instance")
+	}
+	expected := time.Date(2020, time.May, 13, 10, 33, 14, 806000000, time.UTC)
+	if !time.Time(*info.ServerTime).Equal(expected) {
+		t.Errorf("Expected server time %s. Got %s", expected, time.Time(*info.ServerTime))
+	}
+}
+
+func TestServerInfoService_Get_HealthCheck(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/serverInfo"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?doHealthCheck=true")
+		fmt.Fprint(w, `{"version":"8.5.4","deploymentType":"Server","healthChecks":[
+			{"name":"Database","description":"Connection to the database","passed":true},
+			{"name":"Index","description":"Search index","passed":false}]}`)
+	})
+
+	info, _, err := testClient.ServerInfo.Get(&ServerInfoOptions{DoHealthCheck: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(info.HealthChecks) != 2 {
+		t.Fatalf("Expected 2 health checks. Got %+v", info.HealthChecks)
+	}
+	if info.Healthy() {
+		t.Error("Expected failed health check to be reported")
+	}
+}
+
+func TestServerInfoService_IsCloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/serverInfo"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"baseUrl":"https://example.atlassian.net","version":"1001.0.0-SNAPSHOT","deploymentType":"Cloud"}`)
+	})
+
+	cloud, _, err := testClient.ServerInfo.IsCloud()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !cloud {
+		t.Error("Expected a Cloud instance")
+	}
+}
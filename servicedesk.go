@@ -0,0 +1,219 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServiceDeskService handles the service desks of JIRA Service Management (formerly JIRA Service Desk),
+// e.g. to discover the request types customers can raise and the fields of their forms.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk
+type ServiceDeskService struct {
+	client *Client
+}
+
+// ServiceDesk represents a service desk, which is based on a JIRA project.
+type ServiceDesk struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	ProjectID   string `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	ProjectName string `json:"projectName,omitempty" structs:"projectName,omitempty"`
+	ProjectKey  string `json:"projectKey,omitempty" structs:"projectKey,omitempty"`
+}
+
+// ServiceDesksList reflects a page of service desks
+type ServiceDesksList struct {
+	Size       int           `json:"size" structs:"size"`
+	Start      int           `json:"start" structs:"start"`
+	Limit      int           `json:"limit" structs:"limit"`
+	IsLastPage bool          `json:"isLastPage" structs:"isLastPage"`
+	Values     []ServiceDesk `json:"values" structs:"values"`
+}
+
+// RequestType represents a type of request customers can raise on a service desk, e.g. "Get IT help".
+type RequestType struct {
+	ID            string   `json:"id,omitempty" structs:"id,omitempty"`
+	Name          string   `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string   `json:"description,omitempty" structs:"description,omitempty"`
+	HelpText      string   `json:"helpText,omitempty" structs:"helpText,omitempty"`
+	IssueTypeID   string   `json:"issueTypeId,omitempty" structs:"issueTypeId,omitempty"`
+	ServiceDeskID string   `json:"serviceDeskId,omitempty" structs:"serviceDeskId,omitempty"`
+	GroupIDs      []string `json:"groupIds,omitempty" structs:"groupIds,omitempty"`
+}
+
+// RequestTypesList reflects a page of request types
+type RequestTypesList struct {
+	Size       int           `json:"size" structs:"size"`
+	Start      int           `json:"start" structs:"start"`
+	Limit      int           `json:"limit" structs:"limit"`
+	IsLastPage bool          `json:"isLastPage" structs:"isLastPage"`
+	Values     []RequestType `json:"values" structs:"values"`
+}
+
+// RequestTypeFields holds the fields of the form of a request type
+// and whether the current user can raise requests on behalf of customers and add participants.
+type RequestTypeFields struct {
+	RequestTypeFields         []RequestTypeField `json:"requestTypeFields,omitempty" structs:"requestTypeFields,omitempty"`
+	CanRaiseOnBehalfOf        bool               `json:"canRaiseOnBehalfOf,omitempty" structs:"canRaiseOnBehalfOf,omitempty"`
+	CanAddRequestParticipants bool               `json:"canAddRequestParticipants,omitempty" structs:"canAddRequestParticipants,omitempty"`
+}
+
+// RequestTypeField represents a field on the form of a request type.
+// FieldID is the id of the JIRA field the value is stored in, e.g. "summary" or "customfield_10010".
+type RequestTypeField struct {
+	FieldID       string                  `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	Name          string                  `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string                  `json:"description,omitempty" structs:"description,omitempty"`
+	Required      bool                    `json:"required,omitempty" structs:"required,omitempty"`
+	Visible       bool                    `json:"visible,omitempty" structs:"visible,omitempty"`
+	DefaultValues []RequestTypeFieldValue `json:"defaultValues,omitempty" structs:"defaultValues,omitempty"`
+	ValidValues   []RequestTypeFieldValue `json:"validValues,omitempty" structs:"validValues,omitempty"`
+	JiraSchema    *FieldSchema            `json:"jiraSchema,omitempty" structs:"jiraSchema,omitempty"`
+}
+
+// RequestTypeFieldValue represents a value of a request type field, e.g. an option of a select list.
+type RequestTypeFieldValue struct {
+	Value    string                  `json:"value,omitempty" structs:"value,omitempty"`
+	Label    string                  `json:"label,omitempty" structs:"label,omitempty"`
+	Children []RequestTypeFieldValue `json:"children,omitempty" structs:"children,omitempty"`
+}
+
+// ServiceDeskListOptions specifies the optional parameters to the ServiceDeskService.GetList
+type ServiceDeskListOptions struct {
+	// Start is the index of the first item to return. Base index: 0.
+	Start int `url:"start,omitempty"`
+	// Limit is the maximum number of items to return per page. Default: 50.
+	Limit int `url:"limit,omitempty"`
+}
+
+// RequestTypeListOptions specifies the optional parameters to the ServiceDeskService.GetRequestTypes
+type RequestTypeListOptions struct {
+	// SearchQuery filters the request types by their name.
+	SearchQuery string `url:"searchQuery,omitempty"`
+	// GroupID filters the request types by the portal group they belong to.
+	GroupID int `url:"groupId,omitempty"`
+
+	ServiceDeskListOptions
+}
+
+// GetListWithContext returns a page of the service desks the current user can access.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk-getServiceDesks
+func (s *ServiceDeskService) GetListWithContext(ctx context.Context, options *ServiceDeskListOptions) (*ServiceDesksList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/servicedesk", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ServiceDesksList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ServiceDeskService) GetList(options *ServiceDeskListOptions) (*ServiceDesksList, *Response, error) {
+	return s.GetListWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the service desk for a given service desk id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk-getServiceDeskById
+func (s *ServiceDeskService) GetWithContext(ctx context.Context, serviceDeskID string) (*ServiceDesk, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s", serviceDeskID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceDesk := new(ServiceDesk)
+	resp, err := s.client.Do(req, serviceDesk)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return serviceDesk, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ServiceDeskService) Get(serviceDeskID string) (*ServiceDesk, *Response, error) {
+	return s.GetWithContext(context.Background(), serviceDeskID)
+}
+
+// GetRequestTypesWithContext returns a page of the request types of a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/requesttype-getRequestTypes
+func (s *ServiceDeskService) GetRequestTypesWithContext(ctx context.Context, serviceDeskID string, options *RequestTypeListOptions) (*RequestTypesList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestTypesList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// GetRequestTypes wraps GetRequestTypesWithContext using the background context.
+func (s *ServiceDeskService) GetRequestTypes(serviceDeskID string, options *RequestTypeListOptions) (*RequestTypesList, *Response, error) {
+	return s.GetRequestTypesWithContext(context.Background(), serviceDeskID, options)
+}
+
+// GetRequestTypeWithContext returns a request type of a service desk.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/requesttype-getRequestTypeById
+func (s *ServiceDeskService) GetRequestTypeWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype/%s", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestType := new(RequestType)
+	resp, err := s.client.Do(req, requestType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return requestType, resp, nil
+}
+
+// GetRequestType wraps GetRequestTypeWithContext using the background context.
+func (s *ServiceDeskService) GetRequestType(serviceDeskID, requestTypeID string) (*RequestType, *Response, error) {
+	return s.GetRequestTypeWithContext(context.Background(), serviceDeskID, requestTypeID)
+}
+
+// GetRequestTypeFieldsWithContext returns the fields of the form of a request type,
+// with their valid values, to build a form for raising requests of the type.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-servicedesk/REST/cloud/#servicedeskapi/servicedesk/{serviceDeskId}/requesttype/{requestTypeId}/field-getRequestTypeFields
+func (s *ServiceDeskService) GetRequestTypeFieldsWithContext(ctx context.Context, serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%s/requesttype/%s/field", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := new(RequestTypeFields)
+	resp, err := s.client.Do(req, fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return fields, resp, nil
+}
+
+// GetRequestTypeFields wraps GetRequestTypeFieldsWithContext using the background context.
+func (s *ServiceDeskService) GetRequestTypeFields(serviceDeskID, requestTypeID string) (*RequestTypeFields, *Response, error) {
+	return s.GetRequestTypeFieldsWithContext(context.Background(), serviceDeskID, requestTypeID)
+}
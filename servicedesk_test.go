@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServiceDeskService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?limit=2&start=2")
+		fmt.Fprint(w, `{"size":1,"start":2,"limit":2,"isLastPage":true,"values":[{"id":"10","projectId":"11001","projectName":"IT Help Desk","projectKey":"ITH"}]}`)
+	})
+
+	list, _, err := testClient.ServiceDesk.GetList(&ServiceDeskListOptions{Start: 2, Limit: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if list == nil || !list.IsLastPage || len(list.Values) != 1 || list.Values[0].ProjectKey != "ITH" {
+		t.Errorf("Expected the last page with service desk ITH. Got %+v", list)
+	}
+}
+
+func TestServiceDeskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10","projectId":"11001","projectName":"IT Help Desk","projectKey":"ITH"}`)
+	})
+
+	serviceDesk, _, err := testClient.ServiceDesk.Get("10")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if serviceDesk == nil || serviceDesk.ProjectName != "IT Help Desk" {
+		t.Errorf("Expected service desk IT Help Desk. Got %+v", serviceDesk)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/requesttype"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?searchQuery=help")
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[
+			{"id":"11001","name":"Get IT Help","description":"Get IT Help","helpText":"Please tell us your problem","issueTypeId":"12345","serviceDeskId":"10","groupIds":["12"]}]}`)
+	})
+
+	list, _, err := testClient.ServiceDesk.GetRequestTypes("10", &RequestTypeListOptions{SearchQuery: "help"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if list == nil || len(list.Values) != 1 || list.Values[0].IssueTypeID != "12345" || list.Values[0].GroupIDs[0] != "12" {
+		t.Errorf("Expected request type Get IT Help. Got %+v", list)
+	}
+}
+
+func TestServiceDeskService_GetRequestType(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/requesttype/11001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"11001","name":"Get IT Help","serviceDeskId":"10"}`)
+	})
+
+	requestType, _, err := testClient.ServiceDesk.GetRequestType("10", "11001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if requestType == nil || requestType.Name != "Get IT Help" {
+		t.Errorf("Expected request type Get IT Help. Got %+v", requestType)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypeFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10/requesttype/11001/field"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"canRaiseOnBehalfOf":true,"canAddRequestParticipants":true,"requestTypeFields":[
+			{"fieldId":"summary","name":"What do you need?","required":true,"visible":true,"jiraSchema":{"type":"string","system":"summary"}},
+			{"fieldId":"customfield_10010","name":"Urgency","required":false,"visible":true,
+				"validValues":[{"value":"10100","label":"High","children":[]},{"value":"10101","label":"Low","children":[]}],
+				"jiraSchema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select","customId":10010}}]}`)
+	})
+
+	fields, _, err := testClient.ServiceDesk.GetRequestTypeFields("10", "11001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if fields == nil || !fields.CanRaiseOnBehalfOf || len(fields.RequestTypeFields) != 2 {
+		t.Fatalf("Expected 2 fields of the request type. Got %+v", fields)
+	}
+	urgency := fields.RequestTypeFields[1]
+	if len(urgency.ValidValues) != 2 || urgency.ValidValues[0].Label != "High" || urgency.JiraSchema.CustomID != 10010 {
+		t.Errorf("Expected the options of Urgency. Got %+v", urgency)
+	}
+}
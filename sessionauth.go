@@ -0,0 +1,167 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// SessionAuthTransport is an http.RoundTripper that authenticates all requests
+// with a session cookie of JIRA's cookie-based authentication.
+// It logs in with the username and password on the first request,
+// and again whenever the session expired and JIRA answers a request with 401 Unauthorized,
+// before it sends the request again.
+//
+// It is meant for instances of JIRA Server which do not accept HTTP BASIC authentication.
+// Unlike CookieAuthTransport, it is safe for concurrent use.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
+type SessionAuthTransport struct {
+	Username string
+	Password string
+
+	// AuthURL is the URL of the session resource, e.g. https://jira.example.com/rest/auth/1/session
+	AuthURL string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu      sync.Mutex
+	cookies []*http.Cookie
+	// logins counts the logins, to log in once when concurrent requests find the session expired
+	logins int
+}
+
+// RoundTrip adds the session cookie to the request and logs in again if the session expired.
+func (t *SessionAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cookies, logins, err := t.session(req.Context(), 0)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport().RoundTrip(t.authenticate(req, cookies, getBody))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The session expired: log in again and retry the request once
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	cookies, _, err = t.session(req.Context(), logins)
+	if err != nil {
+		return nil, err
+	}
+	return t.transport().RoundTrip(t.authenticate(req, cookies, getBody))
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using session cookies
+func (t *SessionAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// LoginWithContext logs in and replaces the session cookie.
+func (t *SessionAuthTransport) LoginWithContext(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.login(ctx)
+}
+
+// Login wraps LoginWithContext using the background context.
+func (t *SessionAuthTransport) Login() error {
+	return t.LoginWithContext(context.Background())
+}
+
+// session returns the session cookies and the number of logins.
+// It logs in if there is no session yet or if no other request logged in since the login with number expired.
+func (t *SessionAuthTransport) session(ctx context.Context, expired int) ([]*http.Cookie, int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.logins == 0 || t.logins == expired {
+		if err := t.login(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+	return t.cookies, t.logins, nil
+}
+
+// login logs in and stores the session cookies. t.mu has to be held.
+func (t *SessionAuthTransport) login(ctx context.Context) error {
+	body, err := json.Marshal(struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{t.Username, t.Password})
+	if err != nil {
+		return err
+	}
+	req, err := newRequestWithContext(ctx, "POST", t.AuthURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return NewJiraError(newResponse(resp, nil), fmt.Errorf("sessionauth: login failed with status %d", resp.StatusCode))
+	}
+
+	t.cookies = resp.Cookies()
+	t.logins++
+	return nil
+}
+
+// authenticate returns a clone of req with the session cookies and a fresh body
+func (t *SessionAuthTransport) authenticate(req *http.Request, cookies []*http.Cookie, getBody func() (io.ReadCloser, error)) *http.Request {
+	req2 := cloneRequest(req) // per RoundTripper contract
+	for _, cookie := range cookies {
+		// Don't add an empty value cookie to the request
+		if cookie.Value != "" {
+			req2.AddCookie(cookie)
+		}
+	}
+	if getBody != nil {
+		req2.Body, _ = getBody()
+	}
+	return req2
+}
+
+func (t *SessionAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// rewindableBody returns a function returning the body of req from the start, to send it again,
+// and closes the body of req. It reads the body into memory if req has no GetBody.
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		req.Body.Close()
+		return req.GetBody, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}, nil
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSessionAuthTransport_ReLogin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	session := ""
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"username":"fred","password":"secret"}` {
+			t.Errorf("Unexpected login %s", body)
+		}
+		logins++
+		session = fmt.Sprintf("session-%d", logins)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: session})
+		fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"`+session+`"}}`)
+	})
+	var bodies []string
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != session {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000"}`)
+	})
+
+	tp := &SessionAuthTransport{
+		Username: "fred",
+		Password: "secret",
+		AuthURL:  testServer.URL + "/rest/auth/1/session",
+	}
+	client, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()), WithDialect(DialectServer))
+
+	if _, _, err := client.Issue.AddComment("EX-1", &Comment{Body: "first"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if logins != 1 {
+		t.Errorf("Expected 1 login, got %d", logins)
+	}
+
+	// Expire the session
+	session = "expired"
+	if _, _, err := client.Issue.AddComment("EX-1", &Comment{Body: "second"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if logins != 2 {
+		t.Errorf("Expected another login for the expired session, got %d logins", logins)
+	}
+	if len(bodies) != 2 || !strings.Contains(bodies[1], `"body":"second"`) {
+		t.Errorf("Expected the request to be sent again with its body, got %q", bodies)
+	}
+}
+
+func TestSessionAuthTransport_LoginFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errorMessages":["Login failed"],"errors":{}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request without session")
+	})
+
+	tp := &SessionAuthTransport{
+		Username: "fred",
+		Password: "wrong",
+		AuthURL:  testServer.URL + "/rest/auth/1/session",
+	}
+	if err := tp.Login(); !IsUnauthorized(err) {
+		t.Errorf("Expected an unauthorized error, got %v", err)
+	}
+
+	client, _ := NewClient(testServer.URL, WithHTTPClient(tp.Client()))
+	if _, _, err := client.User.GetSelf(); err == nil {
+		t.Error("Expected an error, got none")
+	}
+}
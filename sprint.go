@@ -1,7 +1,9 @@
 package jira
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -12,6 +14,24 @@ type SprintService struct {
 	client *Client
 }
 
+// These constants are the states a JIRA agile sprint can be in
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// SprintOptions holds the fields used to create or partially update a sprint.
+// Zero values are left out of the request.
+type SprintOptions struct {
+	Name          string     `json:"name,omitempty" structs:"name,omitempty"`
+	StartDate     *time.Time `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	OriginBoardID int        `json:"originBoardId,omitempty" structs:"originBoardId,omitempty"`
+	Goal          string     `json:"goal,omitempty" structs:"goal,omitempty"`
+	State         string     `json:"state,omitempty" structs:"state,omitempty"`
+}
+
 // IssuesWrapper represents a wrapper struct for moving issues to sprint
 type IssuesWrapper struct {
 	Issues []string `json:"issues"`
@@ -22,17 +42,17 @@ type IssuesInSprintResult struct {
 	Issues []Issue `json:"issues"`
 }
 
-// MoveIssuesToSprint moves issues to a sprint, for a given sprint Id.
+// MoveIssuesToSprintWithContext moves issues to a sprint, for a given sprint Id.
 // Issues can only be moved to open or active sprints.
 // The maximum number of issues that can be moved in one operation is 50.
 //
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-moveIssuesToSprint
-func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Response, error) {
+func (s *SprintService) MoveIssuesToSprintWithContext(ctx context.Context, sprintID int, issueIDs []string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
 
 	payload := IssuesWrapper{Issues: issueIDs}
 
-	req, err := s.client.NewRequest("POST", apiEndpoint, payload)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
 
 	if err != nil {
 		return nil, err
@@ -45,15 +65,20 @@ func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Re
 	return resp, err
 }
 
-// GetIssuesForSprint returns all issues in a sprint, for a given sprint Id.
+// MoveIssuesToSprint wraps MoveIssuesToSprintWithContext using the background context.
+func (s *SprintService) MoveIssuesToSprint(sprintID int, issueIDs []string) (*Response, error) {
+	return s.MoveIssuesToSprintWithContext(context.Background(), sprintID, issueIDs)
+}
+
+// GetIssuesForSprintWithContext returns all issues in a sprint, for a given sprint Id.
 // This only includes issues that the user has permission to view.
 // By default, the returned issues are ordered by rank.
 //
 //  JIRA API Docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getIssuesForSprint
-func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, error) {
+func (s *SprintService) GetIssuesForSprintWithContext(ctx context.Context, sprintID int) ([]Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -68,7 +93,42 @@ func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, er
 	return result.Issues, resp, err
 }
 
-// GetIssue returns a full representation of the issue for the given issue key.
+// GetIssuesForSprint wraps GetIssuesForSprintWithContext using the background context.
+func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, error) {
+	return s.GetIssuesForSprintWithContext(context.Background(), sprintID)
+}
+
+// GetIssuesForSprintWithOptionsWithContext returns a page of the issues in a sprint, for a given sprint Id.
+// The options select e.g. the fields of the issues, like the estimation field of the board for velocity reports.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API Docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getIssuesForSprint
+func (s *SprintService) GetIssuesForSprintWithOptionsWithContext(ctx context.Context, sprintID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(searchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Issues, resp, nil
+}
+
+// GetIssuesForSprintWithOptions wraps GetIssuesForSprintWithOptionsWithContext using the background context.
+func (s *SprintService) GetIssuesForSprintWithOptions(sprintID int, options *BoardIssuesOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesForSprintWithOptionsWithContext(context.Background(), sprintID, options)
+}
+
+// GetIssueWithContext returns a full representation of the issue for the given issue key.
 // JIRA will attempt to identify the issue by the issueIdOrKey path parameter.
 // This can be an issue id, or an issue key.
 // If the issue cannot be found via an exact match, JIRA will also look for the issue in a case-insensitive way, or by looking to see if the issue was moved.
@@ -78,10 +138,10 @@ func (s *SprintService) GetIssuesForSprint(sprintID int) ([]Issue, *Response, er
 // JIRA API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/issue-getIssue
 //
 // TODO: create agile service for holding all agile apis' implementation
-func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+func (s *SprintService) GetIssueWithContext(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/issue/%s", issueID)
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -105,3 +165,99 @@ func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Iss
 
 	return issue, resp, nil
 }
+
+// GetIssue wraps GetIssueWithContext using the background context.
+func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+	return s.GetIssueWithContext(context.Background(), issueID, options)
+}
+
+// GetWithContext returns the sprint for a given sprint Id.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-getSprint
+func (s *SprintService) GetWithContext(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return sprint, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *SprintService) Get(sprintID int) (*Sprint, *Response, error) {
+	return s.GetWithContext(context.Background(), sprintID)
+}
+
+// CreateWithContext creates a future sprint on the board given by OriginBoardID.
+// Name and OriginBoardID are required.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-createSprint
+func (s *SprintService) CreateWithContext(ctx context.Context, sprint *SprintOptions) (*Sprint, *Response, error) {
+	apiEndpoint := "rest/agile/1.0/sprint"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Sprint)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return created, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *SprintService) Create(sprint *SprintOptions) (*Sprint, *Response, error) {
+	return s.CreateWithContext(context.Background(), sprint)
+}
+
+// UpdateWithContext partially updates a sprint. Only the fields set in sprint are changed.
+// Setting State starts (SprintStateActive) or closes (SprintStateClosed) the sprint.
+// A future sprint can only be started once it has a StartDate and an EndDate.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) UpdateWithContext(ctx context.Context, sprintID int, sprint *SprintOptions) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Sprint)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return updated, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *SprintService) Update(sprintID int, sprint *SprintOptions) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(context.Background(), sprintID, sprint)
+}
+
+// UpdateStateWithContext moves a sprint to the given state.
+// Use it with SprintStateActive to start a sprint and SprintStateClosed to close it.
+//
+// JIRA API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) UpdateStateWithContext(ctx context.Context, sprintID int, state string) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(ctx, sprintID, &SprintOptions{State: state})
+}
+
+// UpdateState wraps UpdateStateWithContext using the background context.
+func (s *SprintService) UpdateState(sprintID int, state string) (*Sprint, *Response, error) {
+	return s.UpdateStateWithContext(context.Background(), sprintID, state)
+}
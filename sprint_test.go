@@ -94,3 +94,108 @@ func TestSprintService_GetIssue(t *testing.T) {
 	}
 
 }
+
+func TestSprintService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":37,"self":"http://www.example.com/jira/rest/agile/1.0/sprint/37","state":"active","name":"sprint 1","startDate":"2015-04-11T15:22:00.000+10:00","endDate":"2015-04-20T01:22:00.000+10:00","originBoardId":5,"goal":"sprint 1 goal"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Get(37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.State != SprintStateActive || sprint.Goal != "sprint 1 goal" {
+		t.Errorf("Expected active sprint with goal. Got %+v", sprint)
+	}
+}
+
+func TestSprintService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if _, ok := payload["state"]; ok {
+			t.Errorf("Expected no state in payload, got %v", payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":38,"state":"future","name":"sprint 2","originBoardId":5}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Create(&SprintOptions{Name: "sprint 2", OriginBoardID: 5})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.ID != 38 {
+		t.Errorf("Expected sprint 38. Got %+v", sprint)
+	}
+}
+
+func TestSprintService_UpdateState(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload SprintOptions
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.State != SprintStateClosed {
+			t.Errorf("Expected state %s in payload, got %s instead", SprintStateClosed, payload.State)
+		}
+
+		fmt.Fprint(w, `{"id":37,"state":"closed","name":"sprint 1","originBoardId":5}`)
+	})
+
+	sprint, _, err := testClient.Sprint.UpdateState(37, SprintStateClosed)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.State != SprintStateClosed {
+		t.Errorf("Expected closed sprint. Got %+v", sprint)
+	}
+}
+
+func TestSprintService_GetIssuesForSprintWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/123/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?fields=status%2Ccustomfield_10002&maxResults=2")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":5,"issues":[{"id":"10001","key":"KEY-1","fields":{"customfield_10002":3}},{"id":"10002","key":"KEY-2","fields":{}}]}`)
+	})
+
+	issues, resp, err := testClient.Sprint.GetIssuesForSprintWithOptions(123, &BoardIssuesOptions{Fields: "status,customfield_10002", MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues. Got %d", len(issues))
+	}
+	if points, ok := issues[0].Fields.CustomFieldNumber("customfield_10002"); !ok || points != 3 {
+		t.Errorf("Expected 3 story points. Got %v", points)
+	}
+	if resp.Total != 5 {
+		t.Errorf("Expected total 5. Got %d", resp.Total)
+	}
+}
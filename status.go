@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusService handles statuses for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/status
+type StatusService struct {
+	client *Client
+}
+
+// GetListWithContext gets all statuses from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/status-getStatuses
+func (s *StatusService) GetListWithContext(ctx context.Context) ([]Status, *Response, error) {
+	apiEndpoint := "rest/api/2/status"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statusList := []Status{}
+	resp, err := s.client.Do(req, &statusList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return statusList, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *StatusService) GetList() ([]Status, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the status for a given status id or name
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/status-getStatus
+func (s *StatusService) GetWithContext(ctx context.Context, idOrName string) (*Status, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/status/%s", idOrName)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(Status)
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return status, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *StatusService) Get(idOrName string) (*Status, *Response, error) {
+	return s.GetWithContext(context.Background(), idOrName)
+}
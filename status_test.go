@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStatusService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/status"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/status/1","name":"Open","id":"1","statusCategory":{"id":2,"key":"new","name":"To Do"}},
+			{"self":"http://www.example.com/jira/rest/api/2/status/6","name":"Closed","id":"6","statusCategory":{"id":3,"key":"done","name":"Done"}}]`)
+	})
+
+	statuses, _, err := testClient.Status.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(statuses) != 2 || statuses[1].StatusCategory.Key != StatusCategoryComplete {
+		t.Errorf("Expected 2 statuses. Got %+v", statuses)
+	}
+}
+
+func TestStatusService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/status/Open"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/status/1","name":"Open","id":"1","statusCategory":{"id":2,"key":"new","name":"To Do"}}`)
+	})
+
+	status, _, err := testClient.Status.Get("Open")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if status == nil || status.ID != "1" {
+		t.Errorf("Expected status 1. Got %+v", status)
+	}
+}
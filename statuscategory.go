@@ -1,5 +1,10 @@
 package jira
 
+import (
+	"context"
+	"fmt"
+)
+
 // StatusCategoryService handles status categories for the JIRA instance / API.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-Statuscategory
@@ -25,12 +30,12 @@ const (
 	StatusCategoryUndefined  = "undefined"
 )
 
-// GetList gets all status categories from JIRA
+// GetListWithContext gets all status categories from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-statuscategory-get
-func (s *StatusCategoryService) GetList() ([]StatusCategory, *Response, error) {
+func (s *StatusCategoryService) GetListWithContext(ctx context.Context) ([]StatusCategory, *Response, error) {
 	apiEndpoint := "rest/api/2/statuscategory"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,3 +47,31 @@ func (s *StatusCategoryService) GetList() ([]StatusCategory, *Response, error) {
 	}
 	return statusCategoryList, resp, nil
 }
+
+// GetList wraps GetListWithContext using the background context.
+func (s *StatusCategoryService) GetList() ([]StatusCategory, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext gets the status category for a given status category id or key
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/statuscategory-getStatusCategory
+func (s *StatusCategoryService) GetWithContext(ctx context.Context, idOrKey string) (*StatusCategory, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/statuscategory/%s", idOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statusCategory := new(StatusCategory)
+	resp, err := s.client.Do(req, statusCategory)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return statusCategory, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *StatusCategoryService) Get(idOrKey string) (*StatusCategory, *Response, error) {
+	return s.GetWithContext(context.Background(), idOrKey)
+}
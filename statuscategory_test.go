@@ -30,3 +30,23 @@ func TestStatusCategoryService_GetList(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestStatusCategoryService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/statuscategory/done"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/statuscategory/3","id":3,"key":"done","colorName":"green","name":"Done"}`)
+	})
+
+	statusCategory, _, err := testClient.StatusCategory.Get(StatusCategoryComplete)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if statusCategory == nil || statusCategory.ID != 3 {
+		t.Errorf("Expected status category 3. Got %+v", statusCategory)
+	}
+}
@@ -0,0 +1,139 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Tracer starts the spans of the requests sent by a client, see Trace.
+// An OpenTelemetry tracer is used with a small adapter converting the attributes:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string, attrs ...jira.Attribute) (context.Context, jira.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(toOtel(attrs)...))
+//		return ctx, otelSpan{span}
+//	}
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is the span of a single request started by a Tracer.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// DurationRecorder records the duration of the requests sent by a client, e.g. in an OpenTelemetry histogram.
+type DurationRecorder interface {
+	Record(ctx context.Context, duration time.Duration, attrs ...Attribute)
+}
+
+// Attribute is a key value pair describing a request, named after the OpenTelemetry semantic conventions for HTTP.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// These constants are the keys of the attributes of the traced requests
+const (
+	AttributeHTTPMethod     = "http.request.method"
+	AttributeHTTPRoute      = "http.route"
+	AttributeHTTPStatusCode = "http.response.status_code"
+	AttributeServerAddress  = "server.address"
+	AttributeURL            = "url.full"
+	AttributeErrorType      = "error.type"
+)
+
+var (
+	endpointIDPattern  = regexp.MustCompile(`^[0-9]+$|^[0-9a-fA-F-]{32,36}$`)
+	endpointKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*(-[0-9]+)?$`)
+)
+
+// EndpointTemplate returns the path of a request with the ids and keys replaced by placeholders,
+// e.g. /rest/api/2/issue/{key}/comment/{id} for /rest/api/2/issue/EX-1/comment/10000.
+// It keeps the number of distinct span names and metric labels small.
+func EndpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "api":
+			// the version of the REST API, e.g. /rest/api/2
+			continue
+		case endpointIDPattern.MatchString(segment):
+			segments[i] = "{id}"
+		case endpointKeyPattern.MatchString(segment):
+			segments[i] = "{key}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// errorType returns the value of the error.type attribute for err, which is "timeout", "canceled"
+// or the type of the innermost error, e.g. "*net.OpError".
+// In contrast to the message of the error its values are few, so it can be a metric label.
+func errorType(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	for errors.Unwrap(err) != nil {
+		err = errors.Unwrap(err)
+	}
+	return fmt.Sprintf("%T", err)
+}
+
+// Trace returns a Middleware starting a span with tracer for every request, named after its method and EndpointTemplate,
+// e.g. "GET /rest/api/2/issue/{key}". The request is sent with the context of the span,
+// so spans started by an instrumented transport become its children.
+// If durations is not nil, the duration of every request is recorded with the same attributes as the span.
+// A retried request results in a span per attempt.
+func Trace(tracer Tracer, durations DurationRecorder) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			route := EndpointTemplate(req.URL.Path)
+			attrs := []Attribute{
+				{Key: AttributeHTTPMethod, Value: req.Method},
+				{Key: AttributeHTTPRoute, Value: route},
+				{Key: AttributeServerAddress, Value: req.URL.Hostname()},
+				{Key: AttributeURL, Value: req.URL.String()},
+			}
+
+			ctx, span := tracer.Start(req.Context(), req.Method+" "+route, attrs...)
+			defer span.End()
+
+			start := time.Now()
+			resp, err := next(req.WithContext(ctx))
+			duration := time.Since(start)
+
+			var result []Attribute
+			if err != nil {
+				span.RecordError(err)
+				result = append(result, Attribute{Key: AttributeErrorType, Value: errorType(err)})
+			}
+			if resp != nil {
+				result = append(result, Attribute{Key: AttributeHTTPStatusCode, Value: resp.StatusCode})
+				if CheckResponse(resp) != nil {
+					result = append(result, Attribute{Key: AttributeErrorType, Value: http.StatusText(resp.StatusCode)})
+				}
+			}
+			span.SetAttributes(result...)
+
+			if durations != nil {
+				// the full URL is left out of the metric attributes, it would create a time series per issue
+				durations.Record(ctx, duration, append(attrs[:3:3], result...)...)
+			}
+			return resp, err
+		}
+	}
+}
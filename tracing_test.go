@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+
+func (s *testSpan) End() { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: map[string]interface{}{}}
+	span.SetAttributes(attrs...)
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+type testDurations struct {
+	attrs []Attribute
+}
+
+func (d *testDurations) Record(ctx context.Context, duration time.Duration, attrs ...Attribute) {
+	d.attrs = attrs
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/2/issue/EX-1/comment/10000":          "/rest/api/2/issue/{key}/comment/{id}",
+		"/rest/api/2/project/EX":                        "/rest/api/2/project/{key}",
+		"/rest/api/3/user":                              "/rest/api/3/user",
+		"/rest/agile/1.0/board/7/sprint":                "/rest/agile/1.0/board/{id}/sprint",
+		"/rest/api/2/user/5b10ac8d82e05b22cc7d4ef5/x":   "/rest/api/2/user/5b10ac8d82e05b22cc7d4ef5/x",
+		"/rest/servicedeskapi/request/EX-2/participant": "/rest/servicedeskapi/request/{key}/participant",
+	}
+	for path, want := range tests {
+		if got := EndpointTemplate(path); got != want {
+			t.Errorf("EndpointTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	tracer := &testTracer{}
+	durations := &testDurations{}
+	var sentSpan interface{}
+	testClient.Middlewares = []Middleware{Trace(tracer, durations), OnRequest(func(req *http.Request) error {
+		sentSpan = req.Context().Value(testSpanKey{})
+		return nil
+	})}
+
+	req, _ := testClient.NewRequest("GET", "rest/api/2/issue/EX-1", nil)
+	testClient.Do(req, nil)
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Expected 1 span. Got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "GET /rest/api/2/issue/{key}" || !span.ended {
+		t.Errorf("Expected the ended span GET /rest/api/2/issue/{key}. Got %+v", span)
+	}
+	if span.attrs[AttributeHTTPStatusCode] != http.StatusNotFound || span.attrs[AttributeErrorType] != "Not Found" {
+		t.Errorf("Expected the status code attributes. Got %v", span.attrs)
+	}
+	if sentSpan != span {
+		t.Error("Expected the request to be sent with the context of the span")
+	}
+	if len(durations.attrs) != 5 || durations.attrs[1].Value != "/rest/api/2/issue/{key}" {
+		t.Errorf("Expected the duration to be recorded with the route and status. Got %v", durations.attrs)
+	}
+}
+
+func TestTrace_Error(t *testing.T) {
+	tracer := &testTracer{}
+	failure := errors.New("connection refused")
+	send := Trace(tracer, nil)(func(req *http.Request) (*http.Response, error) {
+		return nil, failure
+	})
+
+	req, _ := http.NewRequest("GET", "https://jira.example.com/rest/api/2/myself", nil)
+	if _, err := send(req); err != failure {
+		t.Errorf("Expected the error to be returned. Got %v", err)
+	}
+	if span := tracer.spans[0]; span.err != failure || span.attrs[AttributeErrorType] != "*errors.errorString" {
+		t.Errorf("Expected the error to be recorded. Got %+v", span)
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{&url.Error{Op: "Get", URL: "https://jira.example.com", Err: context.DeadlineExceeded}, "timeout"},
+		{&url.Error{Op: "Get", URL: "https://jira.example.com", Err: context.Canceled}, "canceled"},
+		{&url.Error{Op: "Get", URL: "https://jira.example.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, "*errors.errorString"},
+		{&net.DNSError{Err: "no such host", Name: "jira.example.com"}, "*net.DNSError"},
+	}
+	for _, test := range tests {
+		if got := errorType(test.err); got != test.want {
+			t.Errorf("Expected %s for %v. Got %s", test.want, test.err, got)
+		}
+	}
+}
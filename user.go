@@ -1,10 +1,13 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // UserService handles users for the JIRA instance / API.
@@ -20,6 +23,7 @@ type User struct {
 	Name            string     `json:"name,omitempty" structs:"name,omitempty"`
 	Password        string     `json:"-"`
 	Key             string     `json:"key,omitempty" structs:"key,omitempty"`
+	AccountID       string     `json:"accountId,omitempty" structs:"accountId,omitempty"`
 	EmailAddress    string     `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
 	AvatarUrls      AvatarUrls `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	DisplayName     string     `json:"displayName,omitempty" structs:"displayName,omitempty"`
@@ -41,6 +45,124 @@ type UserGroups struct {
 	Items []UserGroup `json:"items,omitempty" structs:"items,omitempty"`
 }
 
+// UserColumn is a column of the issue navigator shown to a user
+type UserColumn struct {
+	Label string `json:"label,omitempty" structs:"label,omitempty"`
+	Value string `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// These constants are the states of a user anonymization task
+const (
+	UserAnonymizationStatusInProgress       = "IN_PROGRESS"
+	UserAnonymizationStatusCompleted        = "COMPLETED"
+	UserAnonymizationStatusInterrupted      = "INTERRUPTED"
+	UserAnonymizationStatusFailed           = "FAILED"
+	UserAnonymizationStatusValidationFailed = "VALIDATION_FAILED"
+)
+
+// UserAnonymizationMessage holds the errors or warnings reported for one check of a user anonymization
+type UserAnonymizationMessage struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty" structs:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// UserAnonymizationValidation is the result of checking whether a user can be anonymized
+type UserAnonymizationValidation struct {
+	UserKey                       string                              `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName                      string                              `json:"userName,omitempty" structs:"userName,omitempty"`
+	DisplayName                   string                              `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Email                         string                              `json:"email,omitempty" structs:"email,omitempty"`
+	Deleted                       bool                                `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	Success                       bool                                `json:"success,omitempty" structs:"success,omitempty"`
+	BusinessLogicValidationFailed bool                                `json:"businessLogicValidationFailed,omitempty" structs:"businessLogicValidationFailed,omitempty"`
+	Errors                        map[string]UserAnonymizationMessage `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings                      map[string]UserAnonymizationMessage `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	Operations                    []string                            `json:"operations,omitempty" structs:"operations,omitempty"`
+	AffectedEntities              map[string]interface{}              `json:"affectedEntities,omitempty" structs:"affectedEntities,omitempty"`
+}
+
+// UserAnonymizationOptions are passed to UserService.ScheduleAnonymization.
+// NewOwnerKey is the key of the user who takes over the entities owned by the anonymized user.
+type UserAnonymizationOptions struct {
+	UserKey     string `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	NewOwnerKey string `json:"newOwnerKey,omitempty" structs:"newOwnerKey,omitempty"`
+}
+
+// UserAnonymizationProgress is the state of a scheduled user anonymization.
+// Status is one of the UserAnonymizationStatus constants.
+type UserAnonymizationProgress struct {
+	UserKey         string                              `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName        string                              `json:"userName,omitempty" structs:"userName,omitempty"`
+	FullName        string                              `json:"fullName,omitempty" structs:"fullName,omitempty"`
+	ProgressURL     string                              `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int                                 `json:"currentProgress,omitempty" structs:"currentProgress,omitempty"`
+	CurrentSubTask  string                              `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	SubmittedTime   *Time                               `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime       *Time                               `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime      *Time                               `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Status          string                              `json:"status,omitempty" structs:"status,omitempty"`
+	ExecutingNode   string                              `json:"executingNode,omitempty" structs:"executingNode,omitempty"`
+	IsRerun         bool                                `json:"isRerun,omitempty" structs:"isRerun,omitempty"`
+	Operations      []string                            `json:"operations,omitempty" structs:"operations,omitempty"`
+	Errors          map[string]UserAnonymizationMessage `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings        map[string]UserAnonymizationMessage `json:"warnings,omitempty" structs:"warnings,omitempty"`
+}
+
+// userBulkMaxAccountIDs is the number of account ids the bulk user endpoint accepts in a single request.
+const userBulkMaxAccountIDs = 100
+
+// userBulkResult is a page of users returned by the bulk user endpoint
+type userBulkResult struct {
+	StartAt    int    `json:"startAt" structs:"startAt"`
+	MaxResults int    `json:"maxResults" structs:"maxResults"`
+	Total      int    `json:"total" structs:"total"`
+	IsLast     bool   `json:"isLast" structs:"isLast"`
+	Users      []User `json:"values" structs:"values"`
+}
+
+// AssignableUserSearchOptions specifies the optional parameters to the search for assignable users.
+// FindAssignable searches within Project or for the issue IssueKey, FindAssignableInProjects within ProjectKeys.
+type AssignableUserSearchOptions struct {
+	// Query matches the display name or email address of the users
+	Query string `url:"query,omitempty"`
+	// Username searches for the user with exactly this name
+	Username string `url:"username,omitempty"`
+	// AccountID searches for the user with exactly this account id
+	AccountID string `url:"accountId,omitempty"`
+	// Project is the key or id of the project the users are assignable in
+	Project string `url:"project,omitempty"`
+	// IssueKey is the key of the issue the users are assignable to
+	IssueKey string `url:"issueKey,omitempty"`
+	// ProjectKeys are the keys of the projects the users are assignable in
+	ProjectKeys []string `url:"projectKeys,comma,omitempty"`
+	// StartAt is the index of the first user to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of users to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// UserPermissionSearchOptions specifies the optional parameters to the search for users with permissions.
+// Either IssueKey or ProjectKey should be set.
+type UserPermissionSearchOptions struct {
+	// Permissions are the keys of the permissions the users must have, e.g. BROWSE or EDIT_ISSUES.
+	// They are only used by FindUsersWithPermission.
+	Permissions []string `url:"permissions,comma,omitempty"`
+	// Query matches the display name or email address of the users
+	Query string `url:"query,omitempty"`
+	// Username searches for the user with exactly this name
+	Username string `url:"username,omitempty"`
+	// AccountID searches for the user with exactly this account id
+	AccountID string `url:"accountId,omitempty"`
+	// IssueKey is the key of the issue the permissions are checked for
+	IssueKey string `url:"issueKey,omitempty"`
+	// ProjectKey is the key of the project the permissions are checked for
+	ProjectKey string `url:"projectKey,omitempty"`
+	// StartAt is the index of the first user to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of users to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
 type userSearchParam struct {
 	name  string
 	value string
@@ -50,21 +172,68 @@ type userSearch []userSearchParam
 
 type userSearchF func(userSearch) userSearch
 
-// Get gets user info from JIRA
+// SearchOption tweaks the parameters of a search, e.g. WithMaxResults.
+// It is the type of the options of UserService.Find and IssueService.Find.
+type SearchOption = userSearchF
+
+// queryString joins the search parameters into a URL query string.
+// The values are expected to be escaped by the option which added them.
+func (s userSearch) queryString() string {
+	params := make([]string, 0, len(s))
+	for _, param := range s {
+		params = append(params, param.name+"="+param.value)
+	}
+	return strings.Join(params, "&")
+}
+
+// GetWithContext gets user info from JIRA
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUser
-func (s *UserService) Get(username string) (*User, *Response, error) {
+func (s *UserService) GetWithContext(ctx context.Context, username string) (*User, *Response, error) {
 	qp := url.Values{}
 	qp["username"] = []string{username}
-	return s.GetWithQueryParams(qp)
+	return s.GetWithQueryParamsWithContext(ctx, qp)
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *UserService) Get(username string) (*User, *Response, error) {
+	return s.GetWithContext(context.Background(), username)
+}
+
+// GetByAccountIDWithContext gets user info from JIRA for the given account id.
+// Jira Cloud identifies users by their account id only, lookups by username are rejected there.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-user-get
+func (s *UserService) GetByAccountIDWithContext(ctx context.Context, accountID string) (*User, *Response, error) {
+	qp := url.Values{}
+	qp["accountId"] = []string{accountID}
+	return s.GetWithQueryParamsWithContext(ctx, qp)
+}
+
+// GetByAccountID wraps GetByAccountIDWithContext using the background context.
+func (s *UserService) GetByAccountID(accountID string) (*User, *Response, error) {
+	return s.GetByAccountIDWithContext(context.Background(), accountID)
+}
+
+// GetUserWithContext gets the full user info from JIRA for a user reference, e.g. the assignee of an issue.
+// The user is looked up by its account id or its username, depending on the Dialect of the client.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-user-get
+func (s *UserService) GetUserWithContext(ctx context.Context, user *User) (*User, *Response, error) {
+	return s.GetWithQueryParamsWithContext(ctx, s.client.dialect(ctx).UserQuery(user))
+}
+
+// GetUser wraps GetUserWithContext using the background context.
+func (s *UserService) GetUser(user *User) (*User, *Response, error) {
+	return s.GetUserWithContext(context.Background(), user)
 }
 
-// Create creates an user in JIRA.
+// CreateWithContext creates an user in JIRA.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-createUser
-func (s *UserService) Create(user *User) (*User, *Response, error) {
-	const apiEndpoint = restAPIBase + "/user"
-	req, err := s.client.NewRequest("POST", apiEndpoint, user)
+func (s *UserService) CreateWithContext(ctx context.Context, user *User) (*User, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/user"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, user)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,13 +258,42 @@ func (s *UserService) Create(user *User) (*User, *Response, error) {
 	return responseUser, resp, nil
 }
 
-// Delete deletes an user from JIRA.
+// Create wraps CreateWithContext using the background context.
+func (s *UserService) Create(user *User) (*User, *Response, error) {
+	return s.CreateWithContext(context.Background(), user)
+}
+
+// DeleteWithContext deletes an user from JIRA.
+// The user is given by name on JIRA Server and by account id on JIRA Cloud, see Client.Dialect.
 // Returns http.StatusNoContent on success.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-user-delete
+func (s *UserService) DeleteWithContext(ctx context.Context, username string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/user?%s=%s", s.client.apiBase(ctx), s.client.dialect(ctx).userParam(), url.QueryEscape(username))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
 func (s *UserService) Delete(username string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user?username=%s", url.QueryEscape(username))
-	req, err := s.client.NewRequest("DELETE", apiEndpoint, nil)
+	return s.DeleteWithContext(context.Background(), username)
+}
+
+// DeleteByAccountIDWithContext deletes the user with the given account id from JIRA.
+// Returns http.StatusNoContent on success.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-user-delete
+func (s *UserService) DeleteByAccountIDWithContext(ctx context.Context, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/user?accountId=%s", s.client.apiBase(ctx), url.QueryEscape(accountID))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -107,12 +305,41 @@ func (s *UserService) Delete(username string) (*Response, error) {
 	return resp, nil
 }
 
-// GetGroups returns the groups which the user belongs to
+// DeleteByAccountID wraps DeleteByAccountIDWithContext using the background context.
+func (s *UserService) DeleteByAccountID(accountID string) (*Response, error) {
+	return s.DeleteByAccountIDWithContext(context.Background(), accountID)
+}
+
+// GetGroupsWithContext returns the groups which the user belongs to
+// The user is given by name on JIRA Server and by account id on JIRA Cloud, see Client.Dialect.
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUserGroups
+func (s *UserService) GetGroupsWithContext(ctx context.Context, username string) (*[]UserGroup, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/user/groups?%s=%s", s.client.apiBase(ctx), s.client.dialect(ctx).userParam(), url.QueryEscape(username))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userGroups := new([]UserGroup)
+	resp, err := s.client.Do(req, userGroups)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return userGroups, resp, nil
+}
+
+// GetGroups wraps GetGroupsWithContext using the background context.
 func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/groups?username=%s", url.QueryEscape(username))
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	return s.GetGroupsWithContext(context.Background(), username)
+}
+
+// GetGroupsByAccountIDWithContext returns the groups which the user with the given account id belongs to
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-user-groups-get
+func (s *UserService) GetGroupsByAccountIDWithContext(ctx context.Context, accountID string) (*[]UserGroup, *Response, error) {
+	apiEndpoint := fmt.Sprintf("%s/user/groups?accountId=%s", s.client.apiBase(ctx), url.QueryEscape(accountID))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -125,12 +352,17 @@ func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error
 	return userGroups, resp, nil
 }
 
+// GetGroupsByAccountID wraps GetGroupsByAccountIDWithContext using the background context.
+func (s *UserService) GetGroupsByAccountID(accountID string) (*[]UserGroup, *Response, error) {
+	return s.GetGroupsByAccountIDWithContext(context.Background(), accountID)
+}
+
 // Get information about the current logged-in user
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-myself-get
-func (s *UserService) GetSelf() (*User, *Response, error) {
-	const apiEndpoint = "rest/api/2/myself"
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+func (s *UserService) GetSelfWithContext(ctx context.Context) (*User, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/myself"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,6 +374,11 @@ func (s *UserService) GetSelf() (*User, *Response, error) {
 	return &user, resp, nil
 }
 
+// GetSelf wraps GetSelfWithContext using the background context.
+func (s *UserService) GetSelf() (*User, *Response, error) {
+	return s.GetSelfWithContext(context.Background())
+}
+
 // WithMaxResults sets the max results to return
 func WithMaxResults(maxResults int) userSearchF {
 	return func(s userSearch) userSearch {
@@ -190,23 +427,81 @@ func WithUsername(username string) userSearchF {
 	}
 }
 
-// Find searches for user info from JIRA:
-// It can find users by email, username or name
+// WithAccountID sets the account id
+func WithAccountID(accountID string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "accountId", value: url.QueryEscape(accountID)})
+		return s
+	}
+}
+
+// FindWithContext searches for user info from JIRA:
+// It can find users by email, username, name or account id
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsers
-func (s *UserService) Find(tweaks ...userSearchF) ([]User, *Response, error) {
-	search := []userSearchParam{}
+func (s *UserService) FindWithContext(ctx context.Context, tweaks ...userSearchF) ([]User, *Response, error) {
+	search := userSearch{}
 	for _, f := range tweaks {
 		search = f(search)
 	}
+	return s.find(ctx, search)
+}
+
+// Find wraps FindWithContext using the background context.
+func (s *UserService) Find(tweaks ...userSearchF) ([]User, *Response, error) {
+	return s.FindWithContext(context.Background(), tweaks...)
+}
+
+// FindPagesWithContext searches for users like FindWithContext, but follows all result pages and calls f for each user found.
+// WithStartAt sets the first user to return, WithMaxResults the page size.
+// The user search does not report the number of users, so pages are requested until JIRA returns an empty one.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsers
+func (s *UserService) FindPagesWithContext(ctx context.Context, f func(User) error, tweaks ...userSearchF) error {
+	search := userSearch{}
+	for _, tweak := range tweaks {
+		search = tweak(search)
+	}
 
-	var queryString = ""
+	var startAt, maxResults int
+	params := userSearch{}
 	for _, param := range search {
-		queryString += param.name + "=" + param.value + "&"
+		switch param.name {
+		case "startAt":
+			startAt, _ = strconv.Atoi(param.value)
+		case "maxResults":
+			maxResults, _ = strconv.Atoi(param.value)
+		default:
+			params = append(params, param)
+		}
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?" + queryString[:len(queryString)-1])
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	return fetchPages(startAt, maxResults, func(startAt, maxResults int) (*Response, int, error) {
+		page := append(userSearch{}, params...)
+		page = WithStartAt(startAt)(page)
+		page = WithMaxResults(maxResults)(page)
+		users, resp, err := s.find(ctx, page)
+		if err != nil {
+			return resp, 0, err
+		}
+		for _, user := range users {
+			if err := f(user); err != nil {
+				return resp, 0, err
+			}
+		}
+		return resp, len(users), nil
+	})
+}
+
+// FindPages wraps FindPagesWithContext using the background context.
+func (s *UserService) FindPages(f func(User) error, tweaks ...userSearchF) error {
+	return s.FindPagesWithContext(context.Background(), f, tweaks...)
+}
+
+// find requests a single page of the user search.
+func (s *UserService) find(ctx context.Context, search userSearch) ([]User, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/user/search?" + search.queryString()
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -222,12 +517,12 @@ func (s *UserService) Find(tweaks ...userSearchF) ([]User, *Response, error) {
 // Returns a list of users that match the search string and property.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-user-search-get
-func (s *UserService) FindWithQueryParams(qp url.Values) ([]User, *Response, error) {
-	apiEndpoint := restAPIBase + "/user/search"
+func (s *UserService) FindWithQueryParamsWithContext(ctx context.Context, qp url.Values) ([]User, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/user/search"
 	if len(qp) > 0 {
 		apiEndpoint += "?" + qp.Encode()
 	}
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,16 +535,21 @@ func (s *UserService) FindWithQueryParams(qp url.Values) ([]User, *Response, err
 	return users, resp, nil
 }
 
+// FindWithQueryParams wraps FindWithQueryParamsWithContext using the background context.
+func (s *UserService) FindWithQueryParams(qp url.Values) ([]User, *Response, error) {
+	return s.FindWithQueryParamsWithContext(context.Background(), qp)
+}
+
 // Returns a user.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-user-get
-func (s *UserService) GetWithQueryParams(qp url.Values) (*User, *Response, error) {
-	apiEndpoint := restAPIBase + "/user"
+func (s *UserService) GetWithQueryParamsWithContext(ctx context.Context, qp url.Values) (*User, *Response, error) {
+	apiEndpoint := s.client.apiBase(ctx) + "/user"
 	if len(qp) > 0 {
 		apiEndpoint += "?" + qp.Encode()
 	}
 
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -261,3 +561,291 @@ func (s *UserService) GetWithQueryParams(qp url.Values) (*User, *Response, error
 	}
 	return user, resp, nil
 }
+
+// GetWithQueryParams wraps GetWithQueryParamsWithContext using the background context.
+func (s *UserService) GetWithQueryParams(qp url.Values) (*User, *Response, error) {
+	return s.GetWithQueryParamsWithContext(context.Background(), qp)
+}
+
+// GetBulkWithContext returns the users for the given account ids.
+// The ids are requested in chunks of 100, the maximum the endpoint accepts, and all result pages are followed.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-user-bulk-get
+func (s *UserService) GetBulkWithContext(ctx context.Context, accountIDs []string) ([]User, error) {
+	users := []User{}
+	for len(accountIDs) > 0 {
+		chunk := accountIDs
+		if len(chunk) > userBulkMaxAccountIDs {
+			chunk = chunk[:userBulkMaxAccountIDs]
+		}
+		accountIDs = accountIDs[len(chunk):]
+
+		err := fetchPages(0, len(chunk), func(startAt, maxResults int) (*Response, int, error) {
+			page, resp, err := s.getBulkPage(ctx, chunk, startAt, maxResults)
+			if err != nil {
+				return resp, 0, err
+			}
+			users = append(users, page...)
+			return resp, len(page), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// GetBulk wraps GetBulkWithContext using the background context.
+func (s *UserService) GetBulk(accountIDs []string) ([]User, error) {
+	return s.GetBulkWithContext(context.Background(), accountIDs)
+}
+
+// getBulkPage requests a single page of the bulk user lookup.
+func (s *UserService) getBulkPage(ctx context.Context, accountIDs []string, startAt, maxResults int) ([]User, *Response, error) {
+	qp := url.Values{}
+	qp["accountId"] = accountIDs
+	qp.Set("startAt", strconv.Itoa(startAt))
+	qp.Set("maxResults", strconv.Itoa(maxResults))
+	apiEndpoint := restAPIBase + "/user/bulk?" + qp.Encode()
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(userBulkResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Users, resp, nil
+}
+
+// FindAssignableWithContext returns the users who can be assigned issues of options.Project,
+// or the issue options.IssueKey, e.g. to check an assignee before setting it.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-findAssignableUsers
+func (s *UserService) FindAssignableWithContext(ctx context.Context, options *AssignableUserSearchOptions) ([]User, *Response, error) {
+	return s.findWithOptions(ctx, s.client.apiBase(ctx)+"/user/assignable/search", options)
+}
+
+// FindAssignable wraps FindAssignableWithContext using the background context.
+func (s *UserService) FindAssignable(options *AssignableUserSearchOptions) ([]User, *Response, error) {
+	return s.FindAssignableWithContext(context.Background(), options)
+}
+
+// FindAssignableInProjectsWithContext returns the users who can be assigned issues in all of options.ProjectKeys.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-findBulkAssignableUsers
+func (s *UserService) FindAssignableInProjectsWithContext(ctx context.Context, options *AssignableUserSearchOptions) ([]User, *Response, error) {
+	return s.findWithOptions(ctx, s.client.apiBase(ctx)+"/user/assignable/multiProjectSearch", options)
+}
+
+// FindAssignableInProjects wraps FindAssignableInProjectsWithContext using the background context.
+func (s *UserService) FindAssignableInProjects(options *AssignableUserSearchOptions) ([]User, *Response, error) {
+	return s.FindAssignableInProjectsWithContext(context.Background(), options)
+}
+
+// findWithOptions requests the users from one of the user search endpoints taking url-tagged options.
+func (s *UserService) findWithOptions(ctx context.Context, apiEndpoint string, options interface{}) ([]User, *Response, error) {
+	apiEndpoint, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return users, resp, nil
+}
+
+// FindUsersWithPermissionWithContext returns the users who have all of options.Permissions
+// for the issue options.IssueKey or the project options.ProjectKey.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-findUsersWithAllPermissions
+func (s *UserService) FindUsersWithPermissionWithContext(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.findWithOptions(ctx, s.client.apiBase(ctx)+"/user/permission/search", options)
+}
+
+// FindUsersWithPermission wraps FindUsersWithPermissionWithContext using the background context.
+func (s *UserService) FindUsersWithPermission(options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.FindUsersWithPermissionWithContext(context.Background(), options)
+}
+
+// FindUsersWithBrowsePermissionWithContext returns the users who can browse the issue options.IssueKey
+// or the issues of the project options.ProjectKey, e.g. to find users who can be mentioned on an issue.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-findUsersWithBrowsePermission
+func (s *UserService) FindUsersWithBrowsePermissionWithContext(ctx context.Context, options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.findWithOptions(ctx, s.client.apiBase(ctx)+"/user/viewissue/search", options)
+}
+
+// FindUsersWithBrowsePermission wraps FindUsersWithBrowsePermissionWithContext using the background context.
+func (s *UserService) FindUsersWithBrowsePermission(options *UserPermissionSearchOptions) ([]User, *Response, error) {
+	return s.FindUsersWithBrowsePermissionWithContext(context.Background(), options)
+}
+
+// columnsEndpoint returns the endpoint of the issue navigator columns of the given user.
+// An empty username addresses the current user.
+func (s *UserService) columnsEndpoint(ctx context.Context, username string) string {
+	apiEndpoint := s.client.apiBase(ctx) + "/user/columns"
+	if username != "" {
+		apiEndpoint += "?" + s.client.dialect(ctx).userParam() + "=" + url.QueryEscape(username)
+	}
+	return apiEndpoint
+}
+
+// GetColumnsWithContext returns the issue navigator columns of the user with the given name.
+// An empty username returns the columns of the current user.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-defaultColumns
+func (s *UserService) GetColumnsWithContext(ctx context.Context, username string) ([]UserColumn, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", s.columnsEndpoint(ctx, username), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := []UserColumn{}
+	resp, err := s.client.Do(req, &columns)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return columns, resp, nil
+}
+
+// GetColumns wraps GetColumnsWithContext using the background context.
+func (s *UserService) GetColumns(username string) ([]UserColumn, *Response, error) {
+	return s.GetColumnsWithContext(context.Background(), username)
+}
+
+// SetColumnsWithContext sets the issue navigator columns of the user with the given name.
+// columns are the field ids in the order they are shown, e.g. "issuekey" or "summary".
+// An empty username sets the columns of the current user.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-setColumns
+func (s *UserService) SetColumnsWithContext(ctx context.Context, username string, columns []string) (*Response, error) {
+	form := url.Values{}
+	form["columns"] = columns
+	req, err := s.client.NewRawRequestWithContext(ctx, "PUT", s.columnsEndpoint(ctx, username), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetColumns wraps SetColumnsWithContext using the background context.
+func (s *UserService) SetColumns(username string, columns []string) (*Response, error) {
+	return s.SetColumnsWithContext(context.Background(), username, columns)
+}
+
+// ResetColumnsWithContext resets the issue navigator columns of the user with the given name to the system default.
+// An empty username resets the columns of the current user.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/user-resetColumns
+func (s *UserService) ResetColumnsWithContext(ctx context.Context, username string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", s.columnsEndpoint(ctx, username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// ResetColumns wraps ResetColumnsWithContext using the background context.
+func (s *UserService) ResetColumns(username string) (*Response, error) {
+	return s.ResetColumnsWithContext(context.Background(), username)
+}
+
+// ValidateAnonymizationWithContext checks whether the user with the given key can be anonymized
+// and lists what the anonymization would change.
+// It is only available on Jira Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-validateUserAnonymization
+func (s *UserService) ValidateAnonymizationWithContext(ctx context.Context, userKey string) (*UserAnonymizationValidation, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/user/anonymization?userKey=%s&expand=affectedEntities", url.QueryEscape(userKey))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validation := new(UserAnonymizationValidation)
+	resp, err := s.client.Do(req, validation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return validation, resp, nil
+}
+
+// ValidateAnonymization wraps ValidateAnonymizationWithContext using the background context.
+func (s *UserService) ValidateAnonymization(userKey string) (*UserAnonymizationValidation, *Response, error) {
+	return s.ValidateAnonymizationWithContext(context.Background(), userKey)
+}
+
+// ScheduleAnonymizationWithContext starts the anonymization of a user.
+// The anonymization runs in the background, use GetAnonymizationProgressWithContext to follow it.
+// It is only available on Jira Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-scheduleUserAnonymization
+func (s *UserService) ScheduleAnonymizationWithContext(ctx context.Context, options *UserAnonymizationOptions) (*UserAnonymizationProgress, *Response, error) {
+	apiEndpoint := "rest/api/2/user/anonymization"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(UserAnonymizationProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return progress, resp, nil
+}
+
+// ScheduleAnonymization wraps ScheduleAnonymizationWithContext using the background context.
+func (s *UserService) ScheduleAnonymization(options *UserAnonymizationOptions) (*UserAnonymizationProgress, *Response, error) {
+	return s.ScheduleAnonymizationWithContext(context.Background(), options)
+}
+
+// GetAnonymizationProgressWithContext returns the state of the user anonymization task with the given id.
+// Without a task id the state of the last anonymization is returned.
+// It is only available on Jira Data Center.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.7.0/#api/2/user/anonymization-getProgress
+func (s *UserService) GetAnonymizationProgressWithContext(ctx context.Context, taskID string) (*UserAnonymizationProgress, *Response, error) {
+	apiEndpoint := "rest/api/2/user/anonymization/progress"
+	if taskID != "" {
+		apiEndpoint += "?taskId=" + url.QueryEscape(taskID)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(UserAnonymizationProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return progress, resp, nil
+}
+
+// GetAnonymizationProgress wraps GetAnonymizationProgressWithContext using the background context.
+func (s *UserService) GetAnonymizationProgress(taskID string) (*UserAnonymizationProgress, *Response, error) {
+	return s.GetAnonymizationProgressWithContext(context.Background(), taskID)
+}
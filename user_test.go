@@ -2,16 +2,20 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 )
 
 func TestUserService_Get_Success(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/user?username=fred")
+		testRequestURL(t, r, "/rest/api/3/user?username=fred")
 
 		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred",
         "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
@@ -32,9 +36,10 @@ func TestUserService_Get_Success(t *testing.T) {
 func TestUserService_Create(t *testing.T) {
 	setup()
 	defer teardown()
-	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/user", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testRequestURL(t, r, "/rest/api/2/user")
+		testRequestURL(t, r, "/rest/api/3/user")
 
 		w.WriteHeader(http.StatusCreated)
 		fmt.Fprint(w, `{"name":"charlie","password":"abracadabra","emailAddress":"charlie@atlassian.com",
@@ -76,6 +81,22 @@ func TestUserService_Delete(t *testing.T) {
 	}
 }
 
+func TestUserService_Delete_Cloud(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/3/user?accountId=5b10a2844c20165700ede21g")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.Delete("5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestUserService_GetGroups(t *testing.T) {
 	setup()
 	defer teardown()
@@ -127,7 +148,7 @@ func TestUserService_Find_Success(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/user/search?username=fred@example.com")
+		testRequestURL(t, r, "/rest/api/2/user/search?username=fred%40example.com")
 
 		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred",
         "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
@@ -138,7 +159,7 @@ func TestUserService_Find_Success(t *testing.T) {
         }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}]`)
 	})
 
-	if user, _, err := testClient.User.Find("fred@example.com"); err != nil {
+	if user, _, err := testClient.User.Find(WithUsername("fred@example.com")); err != nil {
 		t.Errorf("Error given: %s", err)
 	} else if user == nil {
 		t.Error("Expected user. User is nil")
@@ -150,7 +171,7 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 	defer teardown()
 	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testRequestURL(t, r, "/rest/api/2/user/search?username=fred@example.com&startAt=100&maxResults=1000")
+		testRequestURL(t, r, "/rest/api/2/user/search?username=fred%40example.com&startAt=100&maxResults=1000")
 
 		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/api/2/user?username=fred","key":"fred",
         "name":"fred","emailAddress":"fred@example.com","avatarUrls":{"48x48":"http://www.example.com/jira/secure/useravatar?size=large&ownerId=fred",
@@ -161,9 +182,366 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
         }]},"applicationRoles":{"size":1,"items":[]},"expand":"groups,applicationRoles"}]`)
 	})
 
-	if user, _, err := testClient.User.Find("fred@example.com", WithStartAt(100), WithMaxResults(1000)); err != nil {
+	if user, _, err := testClient.User.Find(WithUsername("fred@example.com"), WithStartAt(100), WithMaxResults(1000)); err != nil {
 		t.Errorf("Error given: %s", err)
 	} else if user == nil {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("query") != "fred" {
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `[{"name":"fred"},{"name":"freddy"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"frederick"}]`)
+		case "3":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var users []string
+	err := testClient.User.FindPages(func(user User) error {
+		users = append(users, user.Name)
+		return nil
+	}, WithQuery("fred"), WithMaxResults(2))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 3 {
+		t.Errorf("Expected 3 users, %v given", users)
+	}
+}
+
+func TestUserService_GetBulk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	accountIDs := make([]string, 150)
+	for i := range accountIDs {
+		accountIDs[i] = fmt.Sprintf("account-%d", i)
+	}
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/3/user/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		ids := r.URL.Query()["accountId"]
+		if len(ids) > 100 {
+			t.Errorf("Expected at most 100 account ids per request, %d given", len(ids))
+		}
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		end := startAt + 50
+		if end > len(ids) {
+			end = len(ids)
+		}
+		values := []string{}
+		for _, id := range ids[startAt:end] {
+			values = append(values, fmt.Sprintf(`{"accountId":%q}`, id))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":50,"total":%d,"isLast":%t,"values":[%s]}`,
+			startAt, len(ids), end == len(ids), strings.Join(values, ","))
+	})
+
+	users, err := testClient.User.GetBulk(accountIDs)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 150 {
+		t.Fatalf("Expected 150 users, %d given", len(users))
+	}
+	if users[149].AccountID != "account-149" {
+		t.Errorf("Expected account-149 as last user, %s given", users[149].AccountID)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, %d sent", requests)
+	}
+}
+
+func TestUserService_FindAssignable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/assignable/search?issueKey=EX-1&maxResults=10&query=fred")
+
+		fmt.Fprint(w, `[{"name":"fred","accountId":"5b10a2844c20165700ede21g","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignable(&AssignableUserSearchOptions{IssueKey: "EX-1", Query: "fred", MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "fred" {
+		t.Errorf("Expected user fred. Got %+v", users)
+	}
+}
+
+func TestUserService_FindAssignableInProjects(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/multiProjectSearch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/assignable/multiProjectSearch?projectKeys=EX%2CABC&query=fred")
+
+		fmt.Fprint(w, `[{"name":"fred"},{"name":"freddy"}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignableInProjects(&AssignableUserSearchOptions{ProjectKeys: []string{"EX", "ABC"}, Query: "fred"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 2 {
+		t.Errorf("Expected 2 users. Got %+v", users)
+	}
+}
+
+func TestUserService_FindUsersWithPermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/permission/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/permission/search?issueKey=EX-1&permissions=BROWSE%2CEDIT_ISSUES&query=fred")
+
+		fmt.Fprint(w, `[{"name":"fred"}]`)
+	})
+
+	users, _, err := testClient.User.FindUsersWithPermission(&UserPermissionSearchOptions{
+		Permissions: []string{"BROWSE", "EDIT_ISSUES"},
+		Query:       "fred",
+		IssueKey:    "EX-1",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "fred" {
+		t.Errorf("Expected user fred. Got %+v", users)
+	}
+}
+
+func TestUserService_FindUsersWithBrowsePermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/viewissue/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/viewissue/search?projectKey=EX&query=fr")
+
+		fmt.Fprint(w, `[{"name":"fred"},{"name":"frank"}]`)
+	})
+
+	users, _, err := testClient.User.FindUsersWithBrowsePermission(&UserPermissionSearchOptions{Query: "fr", ProjectKey: "EX"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 2 {
+		t.Errorf("Expected 2 users. Got %+v", users)
+	}
+}
+
+func TestUserService_GetByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.Dialect = DialectCloud
+	testMux.HandleFunc("/rest/api/3/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/3/user?accountId=5b10a2844c20165700ede21g")
+
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/3/user?accountId=5b10a2844c20165700ede21g","accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","active":true}`)
+	})
+
+	user, _, err := testClient.User.GetByAccountID("5b10a2844c20165700ede21g")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if user == nil || user.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected user with account id. Got %+v", user)
+	}
+}
+
+func TestUserService_DeleteByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/user?accountId=5b10a2844c20165700ede21g")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := testClient.User.DeleteByAccountID("5b10a2844c20165700ede21g")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Wrong status code: %d. Expected %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUserService_GetGroupsByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/groups?accountId=5b10a2844c20165700ede21g")
+
+		fmt.Fprint(w, `[{"name":"jira-software-users"}]`)
+	})
+
+	if groups, _, err := testClient.User.GetGroupsByAccountID("5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	} else if groups == nil || len(*groups) != 1 {
+		t.Errorf("Expected one user group. Got %+v", groups)
+	}
+}
+
+func TestUserService_Find_AccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/search?accountId=5b10a2844c20165700ede21g")
+
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g"}]`)
+	})
+
+	users, _, err := testClient.User.Find(WithAccountID("5b10a2844c20165700ede21g"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected user with account id. Got %+v", users)
+	}
+}
+
+func TestUserService_GetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/columns?username=fred")
+
+		fmt.Fprint(w, `[{"label":"Key","value":"issuekey"},{"label":"Summary","value":"summary"}]`)
+	})
+
+	columns, _, err := testClient.User.GetColumns("fred")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(columns) != 2 || columns[0].Value != "issuekey" {
+		t.Errorf("Expected 2 columns starting with issuekey. Got %+v", columns)
+	}
+}
+
+func TestUserService_SetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/user/columns?username=fred")
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+		if columns := r.PostForm["columns"]; len(columns) != 2 || columns[0] != "issuekey" || columns[1] != "summary" {
+			t.Errorf("Expected columns issuekey and summary. Got %v", columns)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.User.SetColumns("fred", []string{"issuekey", "summary"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_ResetColumns(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/columns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/user/columns")
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query for the current user. Got %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.ResetColumns(""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_ValidateAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/anonymization?userKey=JIRAUSER10100&expand=affectedEntities")
+
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","userName":"fred","displayName":"Fred F. User","deleted":false,
+			"success":false,"errors":{"USER_IS_ADMIN":{"errorMessages":["The user is the last administrator."],"errors":{}}},
+			"warnings":{},"operations":["USER_NAME_CHANGE","USER_KEY_CHANGE"],"businessLogicValidationFailed":true}`)
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymization("JIRAUSER10100")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if validation == nil || validation.Success || len(validation.Errors["USER_IS_ADMIN"].ErrorMessages) != 1 {
+		t.Errorf("Expected a failed validation. Got %+v", validation)
+	}
+}
+
+func TestUserService_ScheduleAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/user/anonymization")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"userKey":"JIRAUSER10100","newOwnerKey":"JIRAUSER10000"}`+"\n" {
+			t.Errorf("Unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","progressUrl":"/rest/api/2/user/anonymization/progress?taskId=10","currentProgress":0,"status":"IN_PROGRESS"}`)
+	})
+
+	progress, _, err := testClient.User.ScheduleAnonymization(&UserAnonymizationOptions{UserKey: "JIRAUSER10100", NewOwnerKey: "JIRAUSER10000"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if progress == nil || progress.Status != UserAnonymizationStatusInProgress {
+		t.Errorf("Expected anonymization in progress. Got %+v", progress)
+	}
+}
+
+func TestUserService_GetAnonymizationProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/progress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/user/anonymization/progress?taskId=10")
+
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","currentProgress":100,"status":"COMPLETED",
+			"submittedTime":"2019-12-06T09:47:36.021+0100","finishTime":"2019-12-06T09:48:01.311+0100"}`)
+	})
+
+	progress, _, err := testClient.User.GetAnonymizationProgress("10")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if progress == nil || progress.Status != UserAnonymizationStatusCompleted || progress.FinishTime == nil {
+		t.Errorf("Expected completed anonymization. Got %+v", progress)
+	}
+}
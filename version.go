@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -18,20 +19,48 @@ type Version struct {
 	Self            string `json:"self,omitempty" structs:"self,omitempty"`
 	ID              string `json:"id,omitempty" structs:"id,omitempty"`
 	Name            string `json:"name,omitempty" structs:"name,omitempty"`
-	Description     string `json:"description,omitempty" structs:"name,omitempty"`
+	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        bool   `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        bool   `json:"released,omitempty" structs:"released,omitempty"`
+	Overdue         bool   `json:"overdue,omitempty" structs:"overdue,omitempty"`
+	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
 	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
 }
 
-// Get gets version info from JIRA
+// VersionDeleteOptions specifies the optional parameters to the VersionService.Delete.
+// Without them the version is removed from the fix and affects versions of its issues.
+type VersionDeleteOptions struct {
+	// MoveFixIssuesTo is the id of the version the issues fixed in the deleted version are moved to.
+	MoveFixIssuesTo string `url:"moveFixIssuesTo,omitempty"`
+	// MoveAffectedIssuesTo is the id of the version the issues affecting the deleted version are moved to.
+	MoveAffectedIssuesTo string `url:"moveAffectedIssuesTo,omitempty"`
+}
+
+// VersionMoveOptions specifies where VersionService.Move puts a version in the ordered list of the project versions.
+// Either Position or After must be set.
+type VersionMoveOptions struct {
+	// Position is one of "Earlier", "Later", "First" or "Last".
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+	// After is the self URL of the version to place the version after.
+	After string `json:"after,omitempty" structs:"after,omitempty"`
+}
+
+// VersionIssueCounts holds the number of issues related to a version
+type VersionIssueCounts struct {
+	Self                                     string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesFixedCount                         int    `json:"issuesFixedCount" structs:"issuesFixedCount"`
+	IssuesAffectedCount                      int    `json:"issuesAffectedCount" structs:"issuesAffectedCount"`
+	IssueCountWithCustomFieldsShowingVersion int    `json:"issueCountWithCustomFieldsShowingVersion" structs:"issueCountWithCustomFieldsShowingVersion"`
+}
+
+// GetWithContext gets version info from JIRA
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-get
-func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
+func (s *VersionService) GetWithContext(ctx context.Context, versionID int) (*Version, *Response, error) {
 	apiEndpoint := fmt.Sprintf("/rest/api/2/version/%v", versionID)
-	req, err := s.client.NewRequest("GET", apiEndpoint, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -44,12 +73,17 @@ func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
 	return version, resp, nil
 }
 
-// Create creates a version in JIRA.
+// Get wraps GetWithContext using the background context.
+func (s *VersionService) Get(versionID int) (*Version, *Response, error) {
+	return s.GetWithContext(context.Background(), versionID)
+}
+
+// CreateWithContext creates a version in JIRA.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-post
-func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
+func (s *VersionService) CreateWithContext(ctx context.Context, version *Version) (*Version, *Response, error) {
 	apiEndpoint := "/rest/api/2/version"
-	req, err := s.client.NewRequest("POST", apiEndpoint, version)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,12 +108,17 @@ func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
 	return responseVersion, resp, nil
 }
 
-// Update updates a version from a JSON representation.
+// Create wraps CreateWithContext using the background context.
+func (s *VersionService) Create(version *Version) (*Version, *Response, error) {
+	return s.CreateWithContext(context.Background(), version)
+}
+
+// UpdateWithContext updates a version from a JSON representation.
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-put
-func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
+func (s *VersionService) UpdateWithContext(ctx context.Context, version *Version) (*Version, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", version.ID)
-	req, err := s.client.NewRequest("PUT", apiEndpoint, version)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,3 +133,160 @@ func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
 	ret := *version
 	return &ret, resp, nil
 }
+
+// Update wraps UpdateWithContext using the background context.
+func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
+	return s.UpdateWithContext(context.Background(), version)
+}
+
+// GetListWithContext gets all versions of a project, for a given project id or key.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-project-projectIdOrKey-versions-get
+func (s *VersionService) GetListWithContext(ctx context.Context, projectIDOrKey string) ([]Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/versions", projectIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions := []Version{}
+	resp, err := s.client.Do(req, &versions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return versions, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *VersionService) GetList(projectIDOrKey string) ([]Version, *Response, error) {
+	return s.GetListWithContext(context.Background(), projectIDOrKey)
+}
+
+// ReleaseWithContext marks the version for a given version id as released on releaseDate, formatted as "2006-01-02".
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-put
+func (s *VersionService) ReleaseWithContext(ctx context.Context, versionID int, releaseDate string) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", versionID)
+	payload := struct {
+		Released    bool   `json:"released"`
+		ReleaseDate string `json:"releaseDate,omitempty"`
+	}{
+		Released:    true,
+		ReleaseDate: releaseDate,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// Release wraps ReleaseWithContext using the background context.
+func (s *VersionService) Release(versionID int, releaseDate string) (*Version, *Response, error) {
+	return s.ReleaseWithContext(context.Background(), versionID, releaseDate)
+}
+
+// DeleteWithContext deletes the version for a given version id.
+// The issues of the version can be moved to other versions with options, which may be nil.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-delete
+func (s *VersionService) DeleteWithContext(ctx context.Context, versionID int, options *VersionDeleteOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", versionID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *VersionService) Delete(versionID int, options *VersionDeleteOptions) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), versionID, options)
+}
+
+// MoveWithContext changes the position of the version for a given version id in the list of the project versions.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-move-post
+func (s *VersionService) MoveWithContext(ctx context.Context, versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/move", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// Move wraps MoveWithContext using the background context.
+func (s *VersionService) Move(versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	return s.MoveWithContext(context.Background(), versionID, options)
+}
+
+// GetRelatedIssueCountsWithContext returns the number of issues fixed in and affecting the version for a given version id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-relatedIssueCounts-get
+func (s *VersionService) GetRelatedIssueCountsWithContext(ctx context.Context, versionID int) (*VersionIssueCounts, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/relatedIssueCounts", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := new(VersionIssueCounts)
+	resp, err := s.client.Do(req, counts)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return counts, resp, nil
+}
+
+// GetRelatedIssueCounts wraps GetRelatedIssueCountsWithContext using the background context.
+func (s *VersionService) GetRelatedIssueCounts(versionID int) (*VersionIssueCounts, *Response, error) {
+	return s.GetRelatedIssueCountsWithContext(context.Background(), versionID)
+}
+
+// GetUnresolvedIssueCountWithContext returns the number of unresolved issues fixed in the version for a given version id.
+// Releases are usually blocked until it is zero.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-unresolvedIssueCount-get
+func (s *VersionService) GetUnresolvedIssueCountWithContext(ctx context.Context, versionID int) (int, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v/unresolvedIssueCount", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := new(struct {
+		IssuesUnresolvedCount int `json:"issuesUnresolvedCount"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+	return result.IssuesUnresolvedCount, resp, nil
+}
+
+// GetUnresolvedIssueCount wraps GetUnresolvedIssueCountWithContext using the background context.
+func (s *VersionService) GetUnresolvedIssueCount(versionID int) (int, *Response, error) {
+	return s.GetUnresolvedIssueCountWithContext(context.Background(), versionID)
+}
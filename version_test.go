@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -106,3 +107,116 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/PXA/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/project/PXA/versions")
+		fmt.Fprint(w, `[{"id":"10000","name":"1.0","released":true,"releaseDate":"2010-07-06","projectId":10000},{"id":"10010","name":"1.1","overdue":true,"projectId":10000}]`)
+	})
+
+	versions, _, err := testClient.Version.GetList("PXA")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(versions) != 2 || !versions[1].Overdue {
+		t.Errorf("Expected 2 versions, the second overdue. Got %+v", versions)
+	}
+}
+
+func TestVersionService_Release(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/version/10010")
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["released"] != true || payload["releaseDate"] != "2010-07-20" {
+			t.Errorf("Expected released version in payload, got %v instead", payload)
+		}
+
+		fmt.Fprint(w, `{"id":"10010","name":"1.1","released":true,"releaseDate":"2010-07-20","projectId":10000}`)
+	})
+
+	version, _, err := testClient.Version.Release(10010, "2010-07-20")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version == nil || !version.Released {
+		t.Errorf("Expected released version. Got %+v", version)
+	}
+}
+
+func TestVersionService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/version/10010?moveFixIssuesTo=10020")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Version.Delete(10010, &VersionDeleteOptions{MoveFixIssuesTo: "10020"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/version/10010/move")
+		fmt.Fprint(w, `{"id":"10010","name":"1.1","projectId":10000}`)
+	})
+
+	version, _, err := testClient.Version.Move(10010, &VersionMoveOptions{Position: "First"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version == nil || version.ID != "10010" {
+		t.Errorf("Expected version 10010. Got %+v", version)
+	}
+}
+
+func TestVersionService_GetRelatedIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10010/relatedIssueCounts")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10010","issuesFixedCount":23,"issuesAffectedCount":101,"issueCountWithCustomFieldsShowingVersion":54}`)
+	})
+
+	counts, _, err := testClient.Version.GetRelatedIssueCounts(10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if counts == nil || counts.IssuesFixedCount != 23 || counts.IssuesAffectedCount != 101 {
+		t.Errorf("Expected 23 fixed and 101 affected issues. Got %+v", counts)
+	}
+}
+
+func TestVersionService_GetUnresolvedIssueCount(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/version/10010/unresolvedIssueCount")
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10010","issuesUnresolvedCount":2}`)
+	})
+
+	count, _, err := testClient.Version.GetUnresolvedIssueCount(10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 unresolved issues. Got %d", count)
+	}
+}
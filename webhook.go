@@ -0,0 +1,305 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// WebhookService handles webhooks for the JIRA instance / API.
+// JIRA Server manages webhooks with the Create, GetList, Get, Update and Delete methods,
+// while JIRA Cloud apps register dynamic webhooks with Register, GetRegistered, Refresh and Unregister.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/
+type WebhookService struct {
+	client *Client
+}
+
+// Webhook represents a webhook of JIRA Server.
+// The id of the webhook is the last path segment of Self.
+type Webhook struct {
+	Self        string         `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string         `json:"name,omitempty" structs:"name,omitempty"`
+	URL         string         `json:"url,omitempty" structs:"url,omitempty"`
+	Events      []string       `json:"events,omitempty" structs:"events,omitempty"`
+	Filters     WebhookFilters `json:"filters" structs:"filters"`
+	ExcludeBody bool           `json:"excludeBody" structs:"excludeBody"`
+	Enabled     bool           `json:"enabled,omitempty" structs:"enabled,omitempty"`
+}
+
+// WebhookFilters restricts the events a JIRA Server webhook is called for
+type WebhookFilters struct {
+	// IssueRelatedEvents is a JQL query issues have to match for issue related events to be sent.
+	IssueRelatedEvents string `json:"issue-related-events-section,omitempty" structs:"issue-related-events-section,omitempty"`
+}
+
+// WebhookDetails represents a dynamic webhook registered by a JIRA Cloud app
+type WebhookDetails struct {
+	ID             int      `json:"id,omitempty" structs:"id,omitempty"`
+	JQLFilter      string   `json:"jqlFilter" structs:"jqlFilter"`
+	Events         []string `json:"events" structs:"events"`
+	FieldIDsFilter []string `json:"fieldIdsFilter,omitempty" structs:"fieldIdsFilter,omitempty"`
+	ExpirationDate *Time    `json:"expirationDate,omitempty" structs:"expirationDate,omitempty"`
+}
+
+// WebhookRegistrationResult is the outcome of registering one of the webhooks passed to WebhookService.Register.
+// Either CreatedWebhookID or Errors is set.
+type WebhookRegistrationResult struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty" structs:"createdWebhookId,omitempty"`
+	Errors           []string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// webhookListResult is a single page of dynamic webhooks
+type webhookListResult struct {
+	StartAt    int              `json:"startAt" structs:"startAt"`
+	MaxResults int              `json:"maxResults" structs:"maxResults"`
+	Total      int              `json:"total" structs:"total"`
+	IsLast     bool             `json:"isLast" structs:"isLast"`
+	Values     []WebhookDetails `json:"values" structs:"values"`
+}
+
+// webhookIDList is the payload of the JIRA Cloud calls acting on a set of dynamic webhooks
+type webhookIDList struct {
+	WebhookIDs []int `json:"webhookIds"`
+}
+
+// CreateWithContext creates a webhook on JIRA Server.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) CreateWithContext(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error) {
+	apiEndpoint := "rest/webhooks/1.0/webhook"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Webhook)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return created, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *WebhookService) Create(webhook *Webhook) (*Webhook, *Response, error) {
+	return s.CreateWithContext(context.Background(), webhook)
+}
+
+// GetListWithContext returns all webhooks of JIRA Server.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#querying-a-webhook
+func (s *WebhookService) GetListWithContext(ctx context.Context) ([]Webhook, *Response, error) {
+	apiEndpoint := "rest/webhooks/1.0/webhook"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks := []Webhook{}
+	resp, err := s.client.Do(req, &webhooks)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return webhooks, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *WebhookService) GetList() ([]Webhook, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// GetWithContext returns the JIRA Server webhook for a given webhook id.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#querying-a-webhook
+func (s *WebhookService) GetWithContext(ctx context.Context, webhookID int) (*Webhook, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%d", webhookID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.Do(req, webhook)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return webhook, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *WebhookService) Get(webhookID int) (*Webhook, *Response, error) {
+	return s.GetWithContext(context.Background(), webhookID)
+}
+
+// UpdateWithContext replaces the JIRA Server webhook for a given webhook id.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#updating-a-webhook
+func (s *WebhookService) UpdateWithContext(ctx context.Context, webhookID int, webhook *Webhook) (*Webhook, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%d", webhookID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Webhook)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return updated, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *WebhookService) Update(webhookID int, webhook *Webhook) (*Webhook, *Response, error) {
+	return s.UpdateWithContext(context.Background(), webhookID, webhook)
+}
+
+// DeleteWithContext deletes the JIRA Server webhook for a given webhook id.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#deleting-a-webhook
+func (s *WebhookService) DeleteWithContext(ctx context.Context, webhookID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%d", webhookID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *WebhookService) Delete(webhookID int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), webhookID)
+}
+
+// RegisterWithContext registers dynamic webhooks calling url for a JIRA Cloud app.
+// The results are in the same order as webhooks.
+// Dynamic webhooks expire after 30 days unless they are refreshed.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-webhook-post
+func (s *WebhookService) RegisterWithContext(ctx context.Context, url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error) {
+	apiEndpoint := restAPIBase + "/webhook"
+	payload := struct {
+		URL      string           `json:"url"`
+		Webhooks []WebhookDetails `json:"webhooks"`
+	}{
+		URL:      url,
+		Webhooks: webhooks,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		WebhookRegistrationResult []WebhookRegistrationResult `json:"webhookRegistrationResult"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.WebhookRegistrationResult, resp, nil
+}
+
+// Register wraps RegisterWithContext using the background context.
+func (s *WebhookService) Register(url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error) {
+	return s.RegisterWithContext(context.Background(), url, webhooks)
+}
+
+// GetRegisteredWithContext returns a page of the dynamic webhooks registered by the calling JIRA Cloud app.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-webhook-get
+func (s *WebhookService) GetRegisteredWithContext(ctx context.Context, options *SearchOptions) ([]WebhookDetails, *Response, error) {
+	apiEndpoint := restAPIBase + "/webhook"
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(webhookListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.Values, resp, nil
+}
+
+// GetRegistered wraps GetRegisteredWithContext using the background context.
+func (s *WebhookService) GetRegistered(options *SearchOptions) ([]WebhookDetails, *Response, error) {
+	return s.GetRegisteredWithContext(context.Background(), options)
+}
+
+// RefreshWithContext extends the life of the given dynamic webhooks of a JIRA Cloud app
+// and returns their new expiration date.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-webhook-refresh-put
+func (s *WebhookService) RefreshWithContext(ctx context.Context, webhookIDs ...int) (*Time, *Response, error) {
+	apiEndpoint := restAPIBase + "/webhook/refresh"
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, webhookIDList{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		ExpirationDate *Time `json:"expirationDate"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return result.ExpirationDate, resp, nil
+}
+
+// Refresh wraps RefreshWithContext using the background context.
+func (s *WebhookService) Refresh(webhookIDs ...int) (*Time, *Response, error) {
+	return s.RefreshWithContext(context.Background(), webhookIDs...)
+}
+
+// UnregisterWithContext deletes the given dynamic webhooks of a JIRA Cloud app.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-webhook-delete
+func (s *WebhookService) UnregisterWithContext(ctx context.Context, webhookIDs ...int) (*Response, error) {
+	apiEndpoint := restAPIBase + "/webhook"
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, webhookIDList{WebhookIDs: webhookIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// Unregister wraps UnregisterWithContext using the background context.
+func (s *WebhookService) Unregister(webhookIDs ...int) (*Response, error) {
+	return s.UnregisterWithContext(context.Background(), webhookIDs...)
+}
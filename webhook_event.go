@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// These constants are the names of the events JIRA sends to webhooks, as found in WebhookEvent.WebhookEvent
+const (
+	WebhookEventIssueCreated   = "jira:issue_created"
+	WebhookEventIssueUpdated   = "jira:issue_updated"
+	WebhookEventIssueDeleted   = "jira:issue_deleted"
+	WebhookEventCommentCreated = "comment_created"
+	WebhookEventCommentUpdated = "comment_updated"
+	WebhookEventCommentDeleted = "comment_deleted"
+	WebhookEventWorklogCreated = "worklog_created"
+	WebhookEventWorklogUpdated = "worklog_updated"
+	WebhookEventWorklogDeleted = "worklog_deleted"
+	WebhookEventSprintCreated  = "sprint_created"
+	WebhookEventSprintUpdated  = "sprint_updated"
+	WebhookEventSprintStarted  = "sprint_started"
+	WebhookEventSprintClosed   = "sprint_closed"
+	WebhookEventSprintDeleted  = "sprint_deleted"
+)
+
+// WebhookEvent represents the payload JIRA posts to a webhook.
+// Which of the pointer fields are set depends on the kind of event:
+// issue events carry the Issue and, when updated, the Changelog;
+// comment, worklog and sprint events carry the Comment, Worklog or Sprint.
+//
+// JIRA docs: https://developer.atlassian.com/server/jira/platform/webhooks/#example-callback-for-an-issue-related-event
+type WebhookEvent struct {
+	Timestamp          int64             `json:"timestamp" structs:"timestamp"`
+	WebhookEvent       string            `json:"webhookEvent" structs:"webhookEvent"`
+	IssueEventTypeName string            `json:"issue_event_type_name,omitempty" structs:"issue_event_type_name,omitempty"`
+	User               *User             `json:"user,omitempty" structs:"user,omitempty"`
+	Issue              *Issue            `json:"issue,omitempty" structs:"issue,omitempty"`
+	Changelog          *WebhookChangelog `json:"changelog,omitempty" structs:"changelog,omitempty"`
+	Comment            *Comment          `json:"comment,omitempty" structs:"comment,omitempty"`
+	Worklog            *WorklogRecord    `json:"worklog,omitempty" structs:"worklog,omitempty"`
+	Sprint             *Sprint           `json:"sprint,omitempty" structs:"sprint,omitempty"`
+	OldValue           *Sprint           `json:"oldValue,omitempty" structs:"oldValue,omitempty"`
+}
+
+// WebhookChangelog holds the fields changed by the update an issue webhook event was sent for
+type WebhookChangelog struct {
+	ID    string           `json:"id" structs:"id"`
+	Items []ChangelogItems `json:"items" structs:"items"`
+}
+
+// ParseWebhookEvent decodes a webhook payload sent by JIRA, e.g. the body of the incoming request.
+// An error is returned if r does not hold a JSON document naming the webhook event.
+func ParseWebhookEvent(r io.Reader) (*WebhookEvent, error) {
+	event := new(WebhookEvent)
+	if err := json.NewDecoder(r).Decode(event); err != nil {
+		return nil, err
+	}
+	if event.WebhookEvent == "" {
+		return nil, fmt.Errorf("webhook payload does not name an event")
+	}
+	return event, nil
+}
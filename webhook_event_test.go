@@ -0,0 +1,78 @@
+package jira
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseWebhookEvent_IssueUpdated(t *testing.T) {
+	payload := `{
+		"timestamp": 1525698237764,
+		"webhookEvent": "jira:issue_updated",
+		"issue_event_type_name": "issue_assigned",
+		"user": {"self": "http://www.example.com/jira/rest/api/2/user?username=fred", "name": "fred", "displayName": "Fred F. User", "active": true},
+		"issue": {"id": "10002", "self": "http://www.example.com/jira/rest/api/2/issue/10002", "key": "EX-1", "fields": {"summary": "Build fails", "labels": ["ci"]}},
+		"changelog": {"id": "10100", "items": [{"field": "assignee", "fieldtype": "jira", "from": null, "fromString": null, "to": "fred", "toString": "Fred F. User"}]}
+	}`
+
+	event, err := ParseWebhookEvent(strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if event.WebhookEvent != WebhookEventIssueUpdated || event.IssueEventTypeName != "issue_assigned" {
+		t.Errorf("Expected issue assigned event. Got %s (%s)", event.WebhookEvent, event.IssueEventTypeName)
+	}
+	if event.Issue == nil || event.Issue.Key != "EX-1" || event.Issue.Fields.Summary != "Build fails" {
+		t.Errorf("Expected issue EX-1. Got %+v", event.Issue)
+	}
+	if event.Changelog == nil || len(event.Changelog.Items) != 1 || event.Changelog.Items[0].ToString != "Fred F. User" {
+		t.Errorf("Expected assignee change. Got %+v", event.Changelog)
+	}
+	if event.User == nil || event.User.Name != "fred" {
+		t.Errorf("Expected user fred. Got %+v", event.User)
+	}
+}
+
+func TestParseWebhookEvent_Comment(t *testing.T) {
+	payload := `{
+		"timestamp": 1525698237764,
+		"webhookEvent": "comment_created",
+		"comment": {"self": "http://www.example.com/jira/rest/api/2/issue/10002/comment/10000", "id": "10000", "body": "Fixed in master", "visibility": {"type": "role", "value": "Developers"}}
+	}`
+
+	event, err := ParseWebhookEvent(strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if event.WebhookEvent != WebhookEventCommentCreated || event.Comment == nil || event.Comment.Body != "Fixed in master" {
+		t.Errorf("Expected created comment. Got %+v", event)
+	}
+	if event.Issue != nil {
+		t.Errorf("Expected no issue. Got %+v", event.Issue)
+	}
+}
+
+func TestParseWebhookEvent_Sprint(t *testing.T) {
+	payload := `{
+		"timestamp": 1525698237764,
+		"webhookEvent": "sprint_started",
+		"sprint": {"id": 37, "self": "http://www.example.com/jira/rest/agile/1.0/sprint/37", "state": "active", "name": "sprint 1", "originBoardId": 5}
+	}`
+
+	event, err := ParseWebhookEvent(strings.NewReader(payload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if event.WebhookEvent != WebhookEventSprintStarted || event.Sprint == nil || event.Sprint.ID != 37 {
+		t.Errorf("Expected started sprint 37. Got %+v", event)
+	}
+}
+
+func TestParseWebhookEvent_Invalid(t *testing.T) {
+	if _, err := ParseWebhookEvent(strings.NewReader(`<html>Not JSON</html>`)); err == nil {
+		t.Error("Expected an error for a payload which is not JSON. Got none")
+	}
+	if _, err := ParseWebhookEvent(strings.NewReader(`{"timestamp": 1525698237764}`)); err == nil {
+		t.Error("Expected an error for a payload without event. Got none")
+	}
+}
@@ -0,0 +1,182 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWebhookService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/webhooks/1.0/webhook"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload Webhook
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.Filters.IssueRelatedEvents != "project = EX" {
+			t.Errorf("Expected JQL filter in payload, got %+v instead", payload.Filters)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/webhooks/1.0/webhook/7","name":"ci","url":"https://ci.example.com/hook","events":["jira:issue_created"],"filters":{"issue-related-events-section":"project = EX"},"excludeBody":false,"enabled":true}`)
+	})
+
+	webhook, _, err := testClient.Webhook.Create(&Webhook{
+		Name:    "ci",
+		URL:     "https://ci.example.com/hook",
+		Events:  []string{"jira:issue_created"},
+		Filters: WebhookFilters{IssueRelatedEvents: "project = EX"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if webhook == nil || webhook.Self != "http://www.example.com/jira/rest/webhooks/1.0/webhook/7" || !webhook.Enabled {
+		t.Errorf("Expected enabled webhook 7. Got %+v", webhook)
+	}
+}
+
+func TestWebhookService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/webhooks/1.0/webhook"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"self":"http://www.example.com/jira/rest/webhooks/1.0/webhook/7","name":"ci","events":["jira:issue_created"],"filters":{}}]`)
+	})
+
+	webhooks, _, err := testClient.Webhook.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 || webhooks[0].Name != "ci" {
+		t.Errorf("Expected webhook ci. Got %+v", webhooks)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/webhooks/1.0/webhook/7"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Webhook.Delete(7); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWebhookService_Register(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/webhook"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			URL      string           `json:"url"`
+			Webhooks []WebhookDetails `json:"webhooks"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.URL != "https://app.example.com/hook" || len(payload.Webhooks) != 2 {
+			t.Errorf("Expected url and 2 webhooks in payload, got %+v instead", payload)
+		}
+
+		fmt.Fprint(w, `{"webhookRegistrationResult":[{"createdWebhookId":1000},{"errors":["The clause watchCount is unsupported"]}]}`)
+	})
+
+	results, _, err := testClient.Webhook.Register("https://app.example.com/hook", []WebhookDetails{
+		{JQLFilter: "project = EX", Events: []string{"jira:issue_created"}},
+		{JQLFilter: "watchCount > 1", Events: []string{"jira:issue_updated"}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(results) != 2 || results[0].CreatedWebhookID != 1000 || len(results[1].Errors) != 1 {
+		t.Errorf("Expected one created and one failed webhook. Got %+v", results)
+	}
+}
+
+func TestWebhookService_GetRegistered(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/webhook"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=1")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":1000,"jqlFilter":"project = EX","events":["jira:issue_created"],"expirationDate":"2019-06-01T12:42:30.000+0000"}]}`)
+	})
+
+	webhooks, resp, err := testClient.Webhook.GetRegistered(&SearchOptions{MaxResults: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 || webhooks[0].ID != 1000 || webhooks[0].ExpirationDate == nil {
+		t.Errorf("Expected webhook 1000 with an expiration date. Got %+v", webhooks)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total of 2. Got %d", resp.Total)
+	}
+}
+
+func TestWebhookService_Refresh(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/webhook/refresh"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload webhookIDList
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload.WebhookIDs) != 2 {
+			t.Errorf("Expected 2 webhook ids in payload, got %v instead", payload.WebhookIDs)
+		}
+
+		fmt.Fprint(w, `{"expirationDate":"2019-06-01T12:42:30.000+0000"}`)
+	})
+
+	expiration, _, err := testClient.Webhook.Refresh(1000, 1001)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if expiration == nil {
+		t.Error("Expected expiration date. Expiration date is nil")
+	}
+}
+
+func TestWebhookService_Unregister(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/webhook"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Webhook.Unregister(1000); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,245 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// WikiToMarkdown and MarkdownToWiki convert rich text between the JIRA wiki markup,
+// which is used by descriptions and comments on JIRA Server, and Markdown as used by e.g. GitHub and GitLab.
+// They handle headings, lists, block quotes, code blocks, tables, links and the common text effects.
+// Anything else, like colors or panels, is left as it is.
+
+var (
+	wikiHeadingPattern     = regexp.MustCompile(`^h([1-6])\.\s+(.*)$`)
+	wikiListPattern        = regexp.MustCompile(`^([*#-]+)\s+(.*)$`)
+	wikiQuotePattern       = regexp.MustCompile(`^bq\.\s+(.*)$`)
+	wikiCodeStartPattern   = regexp.MustCompile(`^\{(code|noformat)(?::(?:language=)?([\w+#-]*)[^}]*)?\}\s*$`)
+	wikiCodeEndPattern     = regexp.MustCompile(`^\{(code|noformat)\}\s*$`)
+	wikiMonospacePattern   = regexp.MustCompile(`\{\{(.+?)\}\}`)
+	wikiBoldPattern        = regexp.MustCompile(`(^|[^\w*])\*(\S|\S[^*]*\S)\*($|[^\w*])`)
+	wikiStrikePattern      = regexp.MustCompile(`(^|\s)-(\S|\S[^-]*\S)-($|\s|[.,;:!?])`)
+	wikiLinkPattern        = regexp.MustCompile(`\[([^|\]]+)\|([^\]]+)\]`)
+	wikiMentionPattern     = regexp.MustCompile(`\[~([^\]]+)\]`)
+	wikiURLPattern         = regexp.MustCompile(`\[((?:https?|mailto|ftp):[^\]]+)\]`)
+	markdownHeadingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	markdownListPattern    = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	markdownQuotePattern   = regexp.MustCompile(`^>\s?(.*)$`)
+	markdownFencePattern   = regexp.MustCompile("^\\s*(```|~~~)\\s*([\\w+#-]*)")
+	markdownRulePattern    = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	markdownTableSeparator = regexp.MustCompile(`^\s*\|?(\s*:?-{3,}:?\s*\|)+\s*(:?-{3,}:?)?\s*$`)
+	markdownCodePattern    = regexp.MustCompile("`([^`]+)`")
+	markdownBoldPattern    = regexp.MustCompile(`(\*\*|__)(\S|\S.*?\S)(\*\*|__)`)
+	markdownItalicPattern  = regexp.MustCompile(`(^|[^\w*])\*(\S|\S[^*]*\S)\*($|[^\w*])`)
+	markdownStrikePattern  = regexp.MustCompile(`~~(\S|\S.*?\S)~~`)
+	markdownLinkPattern    = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownURLPattern     = regexp.MustCompile(`<((?:https?|mailto|ftp):[^>]+)>`)
+)
+
+// These placeholders stand in for text while the rest of a line is converted
+const (
+	// boldPlaceholder marks bold text while the italic text is converted, so that both can use asterisks.
+	boldPlaceholder = "\x00"
+	// pipePlaceholder is an escaped pipe in a Markdown table cell, which must not split the cell.
+	pipePlaceholder = "\x01"
+)
+
+// WikiToMarkdown converts JIRA wiki markup to Markdown.
+func WikiToMarkdown(wiki string) string {
+	lines := strings.Split(strings.Replace(wiki, "\r\n", "\n", -1), "\n")
+	out := make([]string, 0, len(lines))
+	inCode, inQuote := false, false
+	for _, line := range lines {
+		if inCode {
+			if wikiCodeEndPattern.MatchString(line) {
+				out = append(out, "```")
+				inCode = false
+				continue
+			}
+			out = append(out, line)
+			continue
+		}
+		if m := wikiCodeStartPattern.FindStringSubmatch(line); m != nil {
+			out = append(out, "```"+m[2])
+			inCode = true
+			continue
+		}
+		if strings.TrimSpace(line) == "{quote}" {
+			inQuote = !inQuote
+			continue
+		}
+
+		for _, converted := range wikiLineToMarkdown(line) {
+			if inQuote {
+				converted = strings.TrimRight("> "+converted, " ")
+			}
+			out = append(out, converted)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// wikiLineToMarkdown converts a line of wiki markup outside of code blocks.
+// A table header returns two lines, the header and the separator Markdown needs below it.
+func wikiLineToMarkdown(line string) []string {
+	if m := wikiHeadingPattern.FindStringSubmatch(line); m != nil {
+		level := int(m[1][0] - '0')
+		return []string{strings.Repeat("#", level) + " " + wikiInlineToMarkdown(m[2])}
+	}
+	if m := wikiQuotePattern.FindStringSubmatch(line); m != nil {
+		return []string{"> " + wikiInlineToMarkdown(m[1])}
+	}
+	if strings.TrimSpace(line) == "----" {
+		return []string{"---"}
+	}
+	if m := wikiListPattern.FindStringSubmatch(line); m != nil {
+		markers := m[1]
+		if markers[len(markers)-1] == '#' {
+			return []string{strings.Repeat("   ", len(markers)-1) + "1. " + wikiInlineToMarkdown(m[2])}
+		}
+		return []string{strings.Repeat("  ", len(markers)-1) + "- " + wikiInlineToMarkdown(m[2])}
+	}
+
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "||") {
+		cells := splitTableRow(wikiInlineToMarkdown(trimmed), "||")
+		return []string{
+			"| " + strings.Join(cells, " | ") + " |",
+			"|" + strings.Repeat(" --- |", len(cells)),
+		}
+	}
+	if strings.HasPrefix(trimmed, "|") {
+		cells := splitTableRow(wikiInlineToMarkdown(trimmed), "|")
+		return []string{"| " + strings.Join(cells, " | ") + " |"}
+	}
+	return []string{wikiInlineToMarkdown(line)}
+}
+
+// wikiInlineToMarkdown converts the text effects and links of wiki markup.
+// Monospaced text is left as it is.
+func wikiInlineToMarkdown(text string) string {
+	return convertOutsideCode(text, wikiMonospacePattern, func(code string) string {
+		return "`" + wikiMonospacePattern.FindStringSubmatch(code)[1] + "`"
+	}, func(s string) string {
+		s = wikiLinkPattern.ReplaceAllString(s, "[$1]($2)")
+		s = wikiMentionPattern.ReplaceAllString(s, "@$1")
+		s = wikiURLPattern.ReplaceAllString(s, "<$1>")
+		s = wikiBoldPattern.ReplaceAllString(s, "$1**$2**$3")
+		s = wikiStrikePattern.ReplaceAllString(s, "$1~~$2~~$3")
+		return s
+	})
+}
+
+// MarkdownToWiki converts Markdown to JIRA wiki markup.
+func MarkdownToWiki(markdown string) string {
+	lines := strings.Split(strings.Replace(markdown, "\r\n", "\n", -1), "\n")
+	out := make([]string, 0, len(lines))
+	fence := ""
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if fence != "" {
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				out = append(out, "{code}")
+				fence = ""
+				continue
+			}
+			out = append(out, line)
+			continue
+		}
+		if m := markdownFencePattern.FindStringSubmatch(line); m != nil {
+			fence = m[1]
+			if m[2] != "" {
+				out = append(out, "{code:"+m[2]+"}")
+			} else {
+				out = append(out, "{code}")
+			}
+			continue
+		}
+
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			header := i+1 < len(lines) && markdownTableSeparator.MatchString(lines[i+1])
+			out = append(out, markdownTableRowToWiki(line, header))
+			if header {
+				i++
+			}
+			continue
+		}
+		out = append(out, markdownLineToWiki(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+// markdownLineToWiki converts a line of Markdown outside of code blocks and tables.
+func markdownLineToWiki(line string) string {
+	if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+		return fmt.Sprintf("h%d. %s", len(m[1]), markdownInlineToWiki(m[2]))
+	}
+	if markdownRulePattern.MatchString(line) {
+		return "----"
+	}
+	if m := markdownQuotePattern.FindStringSubmatch(line); m != nil {
+		return "bq. " + markdownInlineToWiki(m[1])
+	}
+	if m := markdownListPattern.FindStringSubmatch(line); m != nil {
+		indent := len(strings.Replace(m[1], "\t", "    ", -1))
+		if m[2][0] >= '0' && m[2][0] <= '9' {
+			return strings.Repeat("#", indent/3+1) + " " + markdownInlineToWiki(m[3])
+		}
+		return strings.Repeat("*", indent/2+1) + " " + markdownInlineToWiki(m[3])
+	}
+	return markdownInlineToWiki(line)
+}
+
+// markdownTableRowToWiki converts a row of a Markdown table.
+// The cells are split before the inline conversion, as wiki links contain pipes themselves.
+func markdownTableRowToWiki(line string, header bool) string {
+	line = strings.Replace(line, `\|`, pipePlaceholder, -1)
+	cells := splitTableRow(strings.TrimSpace(line), "|")
+	for i, cell := range cells {
+		cells[i] = strings.Replace(markdownInlineToWiki(cell), pipePlaceholder, `\|`, -1)
+	}
+	if header {
+		return "||" + strings.Join(cells, "||") + "||"
+	}
+	return "|" + strings.Join(cells, "|") + "|"
+}
+
+// markdownInlineToWiki converts the text effects and links of Markdown.
+// Inline code is left as it is.
+func markdownInlineToWiki(text string) string {
+	return convertOutsideCode(text, markdownCodePattern, func(code string) string {
+		return "{{" + markdownCodePattern.FindStringSubmatch(code)[1] + "}}"
+	}, func(s string) string {
+		s = markdownLinkPattern.ReplaceAllString(s, "[$1|$2]")
+		s = markdownURLPattern.ReplaceAllString(s, "[$1]")
+		s = markdownBoldPattern.ReplaceAllString(s, boldPlaceholder+"$2"+boldPlaceholder)
+		s = markdownItalicPattern.ReplaceAllString(s, "${1}_${2}_$3")
+		s = markdownStrikePattern.ReplaceAllString(s, "-$1-")
+		return strings.Replace(s, boldPlaceholder, "*", -1)
+	})
+}
+
+// convertOutsideCode applies convert to the parts of text which are not matched by codePattern,
+// and convertCode to the parts which are.
+func convertOutsideCode(text string, codePattern *regexp.Regexp, convertCode, convert func(string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range codePattern.FindAllStringIndex(text, -1) {
+		b.WriteString(convert(text[last:loc[0]]))
+		b.WriteString(convertCode(text[loc[0]:loc[1]]))
+		last = loc[1]
+	}
+	b.WriteString(convert(text[last:]))
+	return b.String()
+}
+
+// splitTableRow returns the trimmed cells of a table row, whose cells are separated by separator.
+func splitTableRow(row, separator string) []string {
+	row = strings.TrimPrefix(strings.TrimSuffix(row, separator), separator)
+	cells := strings.Split(row, separator)
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
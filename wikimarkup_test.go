@@ -0,0 +1,73 @@
+package jira
+
+import "testing"
+
+func TestWikiToMarkdown(t *testing.T) {
+	wiki := "h1. Outage\n" +
+		"The *payment* service is -up- down, see [the log|https://ci.example.com/42] and [https://status.example.com].\n" +
+		"Assigned to [~fred], run {{make *deploy*}}.\n" +
+		"* first\n" +
+		"** nested\n" +
+		"# step\n" +
+		"bq. quoted\n" +
+		"{code:java}\n" +
+		"int *x* = 1;\n" +
+		"{code}\n" +
+		"||Service||Status||\n" +
+		"|api|[ok|https://api.example.com]|\n" +
+		"----"
+	expected := "# Outage\n" +
+		"The **payment** service is ~~up~~ down, see [the log](https://ci.example.com/42) and <https://status.example.com>.\n" +
+		"Assigned to @fred, run `make *deploy*`.\n" +
+		"- first\n" +
+		"  - nested\n" +
+		"1. step\n" +
+		"> quoted\n" +
+		"```java\n" +
+		"int *x* = 1;\n" +
+		"```\n" +
+		"| Service | Status |\n" +
+		"| --- | --- |\n" +
+		"| api | [ok](https://api.example.com) |\n" +
+		"---"
+	if markdown := WikiToMarkdown(wiki); markdown != expected {
+		t.Errorf("Expected\n%s\nGot\n%s", expected, markdown)
+	}
+}
+
+func TestMarkdownToWiki(t *testing.T) {
+	markdown := "## Outage\n" +
+		"The **payment** service is ~~up~~ down, _really_ *down*, see [the log](https://ci.example.com/42) and <https://status.example.com>.\n" +
+		"Run `make **deploy**`.\n" +
+		"- first\n" +
+		"  * nested\n" +
+		"1. step\n" +
+		"   2. sub step\n" +
+		"> quoted\n" +
+		"```go\n" +
+		"x := **y**\n" +
+		"```\n" +
+		"| Service | Status |\n" +
+		"|---|:---:|\n" +
+		"| api | [ok](https://api.example.com) |\n" +
+		"| worker | failed \\| retried |\n" +
+		"***"
+	expected := "h2. Outage\n" +
+		"The *payment* service is -up- down, _really_ _down_, see [the log|https://ci.example.com/42] and [https://status.example.com].\n" +
+		"Run {{make **deploy**}}.\n" +
+		"* first\n" +
+		"** nested\n" +
+		"# step\n" +
+		"## sub step\n" +
+		"bq. quoted\n" +
+		"{code:go}\n" +
+		"x := **y**\n" +
+		"{code}\n" +
+		"||Service||Status||\n" +
+		"|api|[ok|https://api.example.com]|\n" +
+		"|worker|failed \\| retried|\n" +
+		"----"
+	if wiki := MarkdownToWiki(markdown); wiki != expected {
+		t.Errorf("Expected\n%s\nGot\n%s", expected, wiki)
+	}
+}
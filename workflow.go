@@ -0,0 +1,503 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowService handles workflows and workflow schemes for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow
+type WorkflowService struct {
+	client *Client
+}
+
+// Workflow represents a JIRA workflow.
+// The statuses and transitions are only returned by WorkflowService.Search when they are expanded.
+type Workflow struct {
+	ID               *WorkflowID          `json:"id,omitempty" structs:"id,omitempty"`
+	Name             string               `json:"name,omitempty" structs:"name,omitempty"`
+	Description      string               `json:"description,omitempty" structs:"description,omitempty"`
+	LastModifiedDate string               `json:"lastModifiedDate,omitempty" structs:"lastModifiedDate,omitempty"`
+	LastModifiedUser string               `json:"lastModifiedUser,omitempty" structs:"lastModifiedUser,omitempty"`
+	Steps            int                  `json:"steps,omitempty" structs:"steps,omitempty"`
+	Default          bool                 `json:"default,omitempty" structs:"default,omitempty"`
+	IsDefault        bool                 `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	Statuses         []WorkflowStatus     `json:"statuses,omitempty" structs:"statuses,omitempty"`
+	Transitions      []WorkflowTransition `json:"transitions,omitempty" structs:"transitions,omitempty"`
+}
+
+// WorkflowID identifies a workflow returned by WorkflowService.Search
+type WorkflowID struct {
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	EntityID string `json:"entityId,omitempty" structs:"entityId,omitempty"`
+}
+
+// WorkflowStatus represents a status used in a workflow
+type WorkflowStatus struct {
+	ID         string                 `json:"id,omitempty" structs:"id,omitempty"`
+	Name       string                 `json:"name,omitempty" structs:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowTransition represents a transition of a workflow.
+// From holds the ids of the statuses the transition starts at, To the id of the status it ends at.
+type WorkflowTransition struct {
+	ID          string   `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string   `json:"name,omitempty" structs:"name,omitempty"`
+	Description string   `json:"description,omitempty" structs:"description,omitempty"`
+	From        []string `json:"from,omitempty" structs:"from,omitempty"`
+	To          string   `json:"to,omitempty" structs:"to,omitempty"`
+	Type        string   `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// WorkflowSearchOptions specifies the optional parameters to the WorkflowService.Search method
+type WorkflowSearchOptions struct {
+	// WorkflowNames restricts the result to the workflows with these names
+	WorkflowNames []string `url:"workflowName,omitempty"`
+	// Expand is a comma separated list of additional information to include, e.g. "statuses,transitions"
+	Expand string `url:"expand,omitempty"`
+	// StartAt is the index of the first workflow to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of workflows to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// workflowSearchResult is a page of workflows
+type workflowSearchResult struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	IsLast     bool       `json:"isLast"`
+	Workflows  []Workflow `json:"values"`
+}
+
+// WorkflowScheme represents a workflow scheme, which maps issue types to workflows.
+// IssueTypeMappings maps issue type ids to workflow names, all other issue types use the DefaultWorkflow.
+// UpdateDraftIfNeeded makes WorkflowService.UpdateScheme change the draft of a scheme which is used by a project.
+type WorkflowScheme struct {
+	ID                  int               `json:"id,omitempty" structs:"id,omitempty"`
+	Self                string            `json:"self,omitempty" structs:"self,omitempty"`
+	Name                string            `json:"name,omitempty" structs:"name,omitempty"`
+	Description         string            `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultWorkflow     string            `json:"defaultWorkflow,omitempty" structs:"defaultWorkflow,omitempty"`
+	IssueTypeMappings   map[string]string `json:"issueTypeMappings,omitempty" structs:"issueTypeMappings,omitempty"`
+	Draft               bool              `json:"draft,omitempty" structs:"draft,omitempty"`
+	UpdateDraftIfNeeded bool              `json:"updateDraftIfNeeded,omitempty" structs:"updateDraftIfNeeded,omitempty"`
+}
+
+// WorkflowSchemeListOptions specifies the optional parameters to the WorkflowService.GetSchemes method
+type WorkflowSchemeListOptions struct {
+	// StartAt is the index of the first workflow scheme to return (0-based)
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of workflow schemes to return
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// workflowSchemeListResult is a page of workflow schemes
+type workflowSchemeListResult struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Schemes    []WorkflowScheme `json:"values"`
+}
+
+// WorkflowSchemeProjectAssociation lists the projects which use a workflow scheme
+type WorkflowSchemeProjectAssociation struct {
+	ProjectIDs     []string        `json:"projectIds,omitempty" structs:"projectIds,omitempty"`
+	WorkflowScheme *WorkflowScheme `json:"workflowScheme,omitempty" structs:"workflowScheme,omitempty"`
+}
+
+// workflowSchemeProjectAssociationList is the list of workflow scheme associations returned by JIRA
+type workflowSchemeProjectAssociationList struct {
+	Associations []WorkflowSchemeProjectAssociation `json:"values"`
+}
+
+// workflowSchemeProjectOptions specifies the projects to look up the workflow schemes for
+type workflowSchemeProjectOptions struct {
+	ProjectIDs []string `url:"projectId"`
+}
+
+// workflowSchemeProject assigns a workflow scheme to a project
+type workflowSchemeProject struct {
+	WorkflowSchemeID string `json:"workflowSchemeId" structs:"workflowSchemeId"`
+	ProjectID        string `json:"projectId" structs:"projectId"`
+}
+
+// GetListWithContext gets all workflows from JIRA
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow-getAllWorkflows
+func (s *WorkflowService) GetListWithContext(ctx context.Context) ([]Workflow, *Response, error) {
+	apiEndpoint := "rest/api/2/workflow"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflowList := []Workflow{}
+	resp, err := s.client.Do(req, &workflowList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return workflowList, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *WorkflowService) GetList() ([]Workflow, *Response, error) {
+	return s.GetListWithContext(context.Background())
+}
+
+// SearchWithContext returns a page of the workflows, optionally with their statuses and transitions.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+// Searching workflows is only available on Jira Cloud.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-workflow-search-get
+func (s *WorkflowService) SearchWithContext(ctx context.Context, options *WorkflowSearchOptions) ([]Workflow, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/workflow/search", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(workflowSearchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Workflows, resp, nil
+}
+
+// Search wraps SearchWithContext using the background context.
+func (s *WorkflowService) Search(options *WorkflowSearchOptions) ([]Workflow, *Response, error) {
+	return s.SearchWithContext(context.Background(), options)
+}
+
+// GetWithContext returns the workflow with the given name, including its statuses and transitions.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-workflow-search-get
+func (s *WorkflowService) GetWithContext(ctx context.Context, name string) (*Workflow, *Response, error) {
+	options := &WorkflowSearchOptions{
+		WorkflowNames: []string{name},
+		Expand:        "statuses,transitions",
+	}
+	workflows, resp, err := s.SearchWithContext(ctx, options)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(workflows) == 0 {
+		return nil, resp, fmt.Errorf("no workflow with name %s found", name)
+	}
+	return &workflows[0], resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *WorkflowService) Get(name string) (*Workflow, *Response, error) {
+	return s.GetWithContext(context.Background(), name)
+}
+
+// GetSchemesWithContext returns a page of the workflow schemes.
+// The StartAt, MaxResults and Total of the returned Response describe the page that was fetched.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-workflowscheme-get
+func (s *WorkflowService) GetSchemesWithContext(ctx context.Context, options *WorkflowSchemeListOptions) ([]WorkflowScheme, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/workflowscheme", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(workflowSchemeListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Schemes, resp, nil
+}
+
+// GetSchemes wraps GetSchemesWithContext using the background context.
+func (s *WorkflowService) GetSchemes(options *WorkflowSchemeListOptions) ([]WorkflowScheme, *Response, error) {
+	return s.GetSchemesWithContext(context.Background(), options)
+}
+
+// GetSchemeWithContext returns the workflow scheme for a given workflow scheme id.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflowscheme-getById
+func (s *WorkflowService) GetSchemeWithContext(ctx context.Context, schemeID int) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(WorkflowScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return scheme, resp, nil
+}
+
+// GetScheme wraps GetSchemeWithContext using the background context.
+func (s *WorkflowService) GetScheme(schemeID int) (*WorkflowScheme, *Response, error) {
+	return s.GetSchemeWithContext(context.Background(), schemeID)
+}
+
+// CreateSchemeWithContext creates a new workflow scheme.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflowscheme-createScheme
+func (s *WorkflowService) CreateSchemeWithContext(ctx context.Context, scheme *WorkflowScheme) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := "rest/api/2/workflowscheme"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(WorkflowScheme)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// CreateScheme wraps CreateSchemeWithContext using the background context.
+func (s *WorkflowService) CreateScheme(scheme *WorkflowScheme) (*WorkflowScheme, *Response, error) {
+	return s.CreateSchemeWithContext(context.Background(), scheme)
+}
+
+// UpdateSchemeWithContext updates the workflow scheme with the id of the given scheme.
+// A scheme which is used by a project can only be changed through its draft, see WorkflowScheme.UpdateDraftIfNeeded.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflowscheme-update
+func (s *WorkflowService) UpdateSchemeWithContext(ctx context.Context, scheme *WorkflowScheme) (*WorkflowScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d", scheme.ID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(WorkflowScheme)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// UpdateScheme wraps UpdateSchemeWithContext using the background context.
+func (s *WorkflowService) UpdateScheme(scheme *WorkflowScheme) (*WorkflowScheme, *Response, error) {
+	return s.UpdateSchemeWithContext(context.Background(), scheme)
+}
+
+// DeleteSchemeWithContext deletes the workflow scheme for a given workflow scheme id.
+// Workflow schemes which are used by a project cannot be deleted.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflowscheme-deleteScheme
+func (s *WorkflowService) DeleteSchemeWithContext(ctx context.Context, schemeID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/%d", schemeID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteScheme wraps DeleteSchemeWithContext using the background context.
+func (s *WorkflowService) DeleteScheme(schemeID int) (*Response, error) {
+	return s.DeleteSchemeWithContext(context.Background(), schemeID)
+}
+
+// GetSchemeProjectAssociationsWithContext returns the workflow schemes used by the projects with the given ids.
+// Projects which use the default workflow scheme are returned with a scheme without an id.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-workflowscheme-project-get
+func (s *WorkflowService) GetSchemeProjectAssociationsWithContext(ctx context.Context, projectIDs []string) ([]WorkflowSchemeProjectAssociation, *Response, error) {
+	apiEndpoint, err := addOptions(restAPIBase+"/workflowscheme/project", &workflowSchemeProjectOptions{ProjectIDs: projectIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(workflowSchemeProjectAssociationList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Associations, resp, nil
+}
+
+// GetSchemeProjectAssociations wraps GetSchemeProjectAssociationsWithContext using the background context.
+func (s *WorkflowService) GetSchemeProjectAssociations(projectIDs []string) ([]WorkflowSchemeProjectAssociation, *Response, error) {
+	return s.GetSchemeProjectAssociationsWithContext(context.Background(), projectIDs)
+}
+
+// AssignSchemeToProjectWithContext makes the project with the given id use the workflow scheme with the given id.
+// Only classic projects without issues can be assigned a workflow scheme this way.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-workflowscheme-project-put
+func (s *WorkflowService) AssignSchemeToProjectWithContext(ctx context.Context, schemeID, projectID string) (*Response, error) {
+	apiEndpoint := restAPIBase + "/workflowscheme/project"
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, &workflowSchemeProject{WorkflowSchemeID: schemeID, ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AssignSchemeToProject wraps AssignSchemeToProjectWithContext using the background context.
+func (s *WorkflowService) AssignSchemeToProject(schemeID, projectID string) (*Response, error) {
+	return s.AssignSchemeToProjectWithContext(context.Background(), schemeID, projectID)
+}
+
+// These constants are the versions of a workflow whose transition properties can be read and changed
+const (
+	WorkflowModeLive  = "live"
+	WorkflowModeDraft = "draft"
+)
+
+// WorkflowTransitionPropertyOptions specifies the parameters to the transition property methods of the WorkflowService
+type WorkflowTransitionPropertyOptions struct {
+	// WorkflowName is the name of the workflow the transition belongs to
+	WorkflowName string `url:"workflowName"`
+	// WorkflowMode is one of the WorkflowMode constants, the live workflow is used by default
+	WorkflowMode string `url:"workflowMode,omitempty"`
+	// IncludeReservedKeys includes the properties reserved by JIRA, e.g. jira.i18n.title
+	IncludeReservedKeys bool `url:"includeReservedKeys,omitempty"`
+	// Key restricts the properties to the one with this key.
+	// It is set from the key of the property by the methods which change a property.
+	Key string `url:"key,omitempty"`
+}
+
+// transitionPropertiesEndpoint returns the endpoint of the properties of a workflow transition for the given options and property key
+func transitionPropertiesEndpoint(transitionID int, key string, options *WorkflowTransitionPropertyOptions) (string, error) {
+	query := WorkflowTransitionPropertyOptions{}
+	if options != nil {
+		query = *options
+	}
+	if key != "" {
+		query.Key = key
+	}
+	return addOptions(fmt.Sprintf("rest/api/2/workflow/transitions/%d/properties", transitionID), &query)
+}
+
+// GetTransitionPropertiesWithContext returns the properties of a workflow transition.
+// Transition properties configure e.g. the conditions of a transition on JIRA Server, like jira.permission.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow-getProperties
+func (s *WorkflowService) GetTransitionPropertiesWithContext(ctx context.Context, transitionID int, options *WorkflowTransitionPropertyOptions) ([]EntityProperty, *Response, error) {
+	apiEndpoint, err := transitionPropertiesEndpoint(transitionID, "", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	properties := []EntityProperty{}
+	resp, err := s.client.Do(req, &properties)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return properties, resp, nil
+}
+
+// GetTransitionProperties wraps GetTransitionPropertiesWithContext using the background context.
+func (s *WorkflowService) GetTransitionProperties(transitionID int, options *WorkflowTransitionPropertyOptions) ([]EntityProperty, *Response, error) {
+	return s.GetTransitionPropertiesWithContext(context.Background(), transitionID, options)
+}
+
+// setTransitionProperty sends the property to the properties of a workflow transition with the given HTTP method
+func (s *WorkflowService) setTransitionProperty(ctx context.Context, method string, transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error) {
+	apiEndpoint, err := transitionPropertiesEndpoint(transitionID, property.Key, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, method, apiEndpoint, property)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(EntityProperty)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// AddTransitionPropertyWithContext adds a property to a workflow transition.
+// JIRA rejects the property if the transition already has a property with the same key.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow-createProperty
+func (s *WorkflowService) AddTransitionPropertyWithContext(ctx context.Context, transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error) {
+	return s.setTransitionProperty(ctx, "POST", transitionID, property, options)
+}
+
+// AddTransitionProperty wraps AddTransitionPropertyWithContext using the background context.
+func (s *WorkflowService) AddTransitionProperty(transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error) {
+	return s.AddTransitionPropertyWithContext(context.Background(), transitionID, property, options)
+}
+
+// UpdateTransitionPropertyWithContext changes the value of an existing property of a workflow transition.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow-updateProperty
+func (s *WorkflowService) UpdateTransitionPropertyWithContext(ctx context.Context, transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error) {
+	return s.setTransitionProperty(ctx, "PUT", transitionID, property, options)
+}
+
+// UpdateTransitionProperty wraps UpdateTransitionPropertyWithContext using the background context.
+func (s *WorkflowService) UpdateTransitionProperty(transitionID int, property *EntityProperty, options *WorkflowTransitionPropertyOptions) (*EntityProperty, *Response, error) {
+	return s.UpdateTransitionPropertyWithContext(context.Background(), transitionID, property, options)
+}
+
+// DeleteTransitionPropertyWithContext removes the property with the given key from a workflow transition.
+//
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/workflow-deleteProperty
+func (s *WorkflowService) DeleteTransitionPropertyWithContext(ctx context.Context, transitionID int, key string, options *WorkflowTransitionPropertyOptions) (*Response, error) {
+	apiEndpoint, err := transitionPropertiesEndpoint(transitionID, key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteTransitionProperty wraps DeleteTransitionPropertyWithContext using the background context.
+func (s *WorkflowService) DeleteTransitionProperty(transitionID int, key string, options *WorkflowTransitionPropertyOptions) (*Response, error) {
+	return s.DeleteTransitionPropertyWithContext(context.Background(), transitionID, key, options)
+}
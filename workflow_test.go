@@ -0,0 +1,326 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWorkflowService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"name":"jira","description":"The default JIRA workflow.","steps":5,"default":true},
+			{"name":"Approved Workflow","lastModifiedUser":"admin","steps":3}]`)
+	})
+
+	workflows, _, err := testClient.Workflow.GetList()
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(workflows) != 2 || !workflows[0].Default || workflows[1].Steps != 3 {
+		t.Errorf("Expected 2 workflows. Got %+v", workflows)
+	}
+}
+
+func TestWorkflowService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/workflow/search"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?expand=statuses%2Ctransitions&workflowName=Approved+Workflow")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"isLast":true,"values":[{
+			"id":{"name":"Approved Workflow","entityId":"5ed312c5-f7a6-4a78-a1f6-8ff7f307d063"},
+			"description":"",
+			"statuses":[{"id":"1","name":"Open"},{"id":"6","name":"Closed"}],
+			"transitions":[{"id":"1","name":"Create","from":[],"to":"1","type":"initial"},
+				{"id":"21","name":"Close","from":["1"],"to":"6","type":"directed"}]}]}`)
+	})
+
+	workflow, _, err := testClient.Workflow.Get("Approved Workflow")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if workflow == nil || workflow.ID.Name != "Approved Workflow" {
+		t.Fatalf("Expected workflow Approved Workflow. Got %+v", workflow)
+	}
+	if len(workflow.Statuses) != 2 || workflow.Statuses[1].Name != "Closed" {
+		t.Errorf("Expected 2 statuses. Got %+v", workflow.Statuses)
+	}
+	if len(workflow.Transitions) != 2 || workflow.Transitions[1].From[0] != "1" || workflow.Transitions[1].To != "6" {
+		t.Errorf("Expected 2 transitions. Got %+v", workflow.Transitions)
+	}
+}
+
+func TestWorkflowService_Get_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/workflow/search"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":0,"isLast":true,"values":[]}`)
+	})
+
+	workflow, _, err := testClient.Workflow.Get("Missing")
+	if err == nil {
+		t.Error("Expected an error for an unknown workflow")
+	}
+	if workflow != nil {
+		t.Errorf("Expected no workflow. Got %+v", workflow)
+	}
+}
+
+func TestWorkflowService_GetSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/workflowscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?maxResults=1")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[
+			{"id":101010,"name":"Approved","defaultWorkflow":"Approved Workflow","issueTypeMappings":{"10000":"jira"}}]}`)
+	})
+
+	schemes, resp, err := testClient.Workflow.GetSchemes(&WorkflowSchemeListOptions{MaxResults: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(schemes) != 1 || schemes[0].IssueTypeMappings["10000"] != "jira" {
+		t.Errorf("Expected 1 scheme. Got %+v", schemes)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected total 2. Got %d", resp.Total)
+	}
+}
+
+func TestWorkflowService_GetScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101010"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":101010,"name":"Approved","defaultWorkflow":"Approved Workflow"}`)
+	})
+
+	scheme, _, err := testClient.Workflow.GetScheme(101010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.DefaultWorkflow != "Approved Workflow" {
+		t.Errorf("Expected scheme Approved. Got %+v", scheme)
+	}
+}
+
+func TestWorkflowService_CreateScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		scheme := new(WorkflowScheme)
+		json.NewDecoder(r.Body).Decode(scheme)
+		if scheme.Name != "Approved" || scheme.DefaultWorkflow != "Approved Workflow" {
+			t.Errorf("Unexpected scheme %+v", scheme)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":101011,"name":"Approved","defaultWorkflow":"Approved Workflow"}`)
+	})
+
+	scheme, _, err := testClient.Workflow.CreateScheme(&WorkflowScheme{Name: "Approved", DefaultWorkflow: "Approved Workflow"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 101011 {
+		t.Errorf("Expected scheme 101011. Got %+v", scheme)
+	}
+}
+
+func TestWorkflowService_UpdateScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101011"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		scheme := new(WorkflowScheme)
+		json.NewDecoder(r.Body).Decode(scheme)
+		if !scheme.UpdateDraftIfNeeded {
+			t.Errorf("Expected updateDraftIfNeeded to be sent. Got %+v", scheme)
+		}
+		fmt.Fprint(w, `{"id":101012,"name":"Approved","draft":true}`)
+	})
+
+	scheme, _, err := testClient.Workflow.UpdateScheme(&WorkflowScheme{ID: 101011, Description: "Changed", UpdateDraftIfNeeded: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || !scheme.Draft {
+		t.Errorf("Expected a draft scheme. Got %+v", scheme)
+	}
+}
+
+func TestWorkflowService_DeleteScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflowscheme/101011"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Workflow.DeleteScheme(101011)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWorkflowService_GetSchemeProjectAssociations(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/workflowscheme/project"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?projectId=10010&projectId=10020")
+		fmt.Fprint(w, `{"values":[{"projectIds":["10010","10020"],"workflowScheme":{"id":101010,"name":"Approved"}}]}`)
+	})
+
+	associations, _, err := testClient.Workflow.GetSchemeProjectAssociations([]string{"10010", "10020"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(associations) != 1 || len(associations[0].ProjectIDs) != 2 || associations[0].WorkflowScheme.ID != 101010 {
+		t.Errorf("Expected 1 association. Got %+v", associations)
+	}
+}
+
+func TestWorkflowService_AssignSchemeToProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/workflowscheme/project"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		assignment := new(workflowSchemeProject)
+		json.NewDecoder(r.Body).Decode(assignment)
+		if assignment.WorkflowSchemeID != "101010" || assignment.ProjectID != "10010" {
+			t.Errorf("Unexpected assignment %+v", assignment)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Workflow.AssignSchemeToProject("101010", "10010")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWorkflowService_GetTransitionProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/transitions/21/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?includeReservedKeys=true&workflowName=Approved+Workflow")
+		fmt.Fprint(w, `[{"key":"jira.i18n.title","value":"close.title","id":"jira.i18n.title"},
+			{"key":"jira.permission","value":"ADMINISTER_PROJECTS","id":"jira.permission"}]`)
+	})
+
+	options := &WorkflowTransitionPropertyOptions{WorkflowName: "Approved Workflow", IncludeReservedKeys: true}
+	properties, _, err := testClient.Workflow.GetTransitionProperties(21, options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(properties) != 2 || properties[1].Value != "ADMINISTER_PROJECTS" {
+		t.Errorf("Expected 2 properties. Got %+v", properties)
+	}
+}
+
+func TestWorkflowService_AddTransitionProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/transitions/21/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint+"?key=jira.permission&workflowMode=draft&workflowName=Approved+Workflow")
+
+		property := new(EntityProperty)
+		json.NewDecoder(r.Body).Decode(property)
+		if property.Value != "ADMINISTER_PROJECTS" {
+			t.Errorf("Unexpected property %+v", property)
+		}
+		fmt.Fprint(w, `{"key":"jira.permission","value":"ADMINISTER_PROJECTS","id":"jira.permission"}`)
+	})
+
+	options := &WorkflowTransitionPropertyOptions{WorkflowName: "Approved Workflow", WorkflowMode: WorkflowModeDraft}
+	property, _, err := testClient.Workflow.AddTransitionProperty(21, &EntityProperty{Key: "jira.permission", Value: "ADMINISTER_PROJECTS"}, options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Key != "jira.permission" {
+		t.Errorf("Expected property jira.permission. Got %+v", property)
+	}
+	if options.Key != "" {
+		t.Errorf("Expected options not to be changed. Got %+v", options)
+	}
+}
+
+func TestWorkflowService_UpdateTransitionProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/transitions/21/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEndpoint+"?key=jira.permission&workflowName=Approved+Workflow")
+		fmt.Fprint(w, `{"key":"jira.permission","value":"BROWSE_PROJECTS","id":"jira.permission"}`)
+	})
+
+	options := &WorkflowTransitionPropertyOptions{WorkflowName: "Approved Workflow"}
+	property, _, err := testClient.Workflow.UpdateTransitionProperty(21, &EntityProperty{Key: "jira.permission", Value: "BROWSE_PROJECTS"}, options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Value != "BROWSE_PROJECTS" {
+		t.Errorf("Expected updated property. Got %+v", property)
+	}
+}
+
+func TestWorkflowService_DeleteTransitionProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/workflow/transitions/21/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEndpoint+"?key=jira.permission&workflowName=Approved+Workflow")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := testClient.Workflow.DeleteTransitionProperty(21, "jira.permission", &WorkflowTransitionPropertyOptions{WorkflowName: "Approved Workflow"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorklogService handles worklogs across issues for the JIRA instance / API.
+// The worklogs of a single issue are handled by the IssueService.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-group-Issue-worklogs
+type WorklogService struct {
+	client *Client
+}
+
+// WorklogChange is the id of a worklog that was updated or deleted, along with the time of the change
+type WorklogChange struct {
+	WorklogID   int   `json:"worklogId" structs:"worklogId"`
+	UpdatedTime int64 `json:"updatedTime" structs:"updatedTime"`
+}
+
+// WorklogChangeList is a page of worklog changes.
+// The next page starts at Until, and LastPage is set once there are no more changes.
+type WorklogChangeList struct {
+	Values   []WorklogChange `json:"values" structs:"values"`
+	Since    int64           `json:"since" structs:"since"`
+	Until    int64           `json:"until" structs:"until"`
+	Self     string          `json:"self" structs:"self"`
+	NextPage string          `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	LastPage bool            `json:"lastPage" structs:"lastPage"`
+}
+
+// GetUpdatedWithContext returns the ids of the worklogs updated since the given UNIX timestamp in milliseconds.
+// Use the Until of the returned list as since to fetch the next page.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-worklog-updated-get
+func (s *WorklogService) GetUpdatedWithContext(ctx context.Context, since int64) (*WorklogChangeList, *Response, error) {
+	return s.changes(ctx, "rest/api/2/worklog/updated", since)
+}
+
+// GetUpdated wraps GetUpdatedWithContext using the background context.
+func (s *WorklogService) GetUpdated(since int64) (*WorklogChangeList, *Response, error) {
+	return s.GetUpdatedWithContext(context.Background(), since)
+}
+
+// GetDeletedWithContext returns the ids of the worklogs deleted since the given UNIX timestamp in milliseconds.
+// Use the Until of the returned list as since to fetch the next page.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-worklog-deleted-get
+func (s *WorklogService) GetDeletedWithContext(ctx context.Context, since int64) (*WorklogChangeList, *Response, error) {
+	return s.changes(ctx, "rest/api/2/worklog/deleted", since)
+}
+
+// GetDeleted wraps GetDeletedWithContext using the background context.
+func (s *WorklogService) GetDeleted(since int64) (*WorklogChangeList, *Response, error) {
+	return s.GetDeletedWithContext(context.Background(), since)
+}
+
+func (s *WorklogService) changes(ctx context.Context, apiEndpoint string, since int64) (*WorklogChangeList, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?since=%d", apiEndpoint, since), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := new(WorklogChangeList)
+	resp, err := s.client.Do(req, changes)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return changes, resp, nil
+}
+
+// ListWithContext returns the worklogs for the given worklog ids.
+// At most 1000 ids can be requested at once.
+//
+// JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-worklog-list-post
+func (s *WorklogService) ListWithContext(ctx context.Context, worklogIDs []int) ([]WorklogRecord, *Response, error) {
+	apiEndpoint := "rest/api/2/worklog/list"
+	payload := struct {
+		IDs []int `json:"ids"`
+	}{
+		IDs: worklogIDs,
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var worklogs []WorklogRecord
+	resp, err := s.client.Do(req, &worklogs)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return worklogs, resp, nil
+}
+
+// List wraps ListWithContext using the background context.
+func (s *WorklogService) List(worklogIDs []int) ([]WorklogRecord, *Response, error) {
+	return s.ListWithContext(context.Background(), worklogIDs)
+}
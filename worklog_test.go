@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWorklogService_GetUpdated(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/updated"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?since=1438013671562")
+		fmt.Fprint(w, `{"values":[{"worklogId":103,"updatedTime":1438013671562},{"worklogId":104,"updatedTime":1438013672165}],"since":1438013671562,"until":1438013693136,"self":"http://www.example.com/jira/rest/api/2/worklog/updated?since=1438013671562","nextPage":"http://www.example.com/jira/rest/api/2/worklog/updated?since=1438013693136","lastPage":false}`)
+	})
+
+	changes, _, err := testClient.Worklog.GetUpdated(1438013671562)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if changes == nil || len(changes.Values) != 2 || changes.Values[1].WorklogID != 104 {
+		t.Errorf("Expected worklogs 103 and 104. Got %+v", changes)
+	}
+	if changes.Until != 1438013693136 || changes.LastPage {
+		t.Errorf("Expected a following page from 1438013693136. Got %+v", changes)
+	}
+}
+
+func TestWorklogService_GetDeleted(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/deleted"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, testAPIEndpoint+"?since=0")
+		fmt.Fprint(w, `{"values":[{"worklogId":105,"updatedTime":1438013671562}],"since":0,"until":1438013671562,"lastPage":true}`)
+	})
+
+	changes, _, err := testClient.Worklog.GetDeleted(0)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if changes == nil || len(changes.Values) != 1 || !changes.LastPage {
+		t.Errorf("Expected the last page with worklog 105. Got %+v", changes)
+	}
+}
+
+func TestWorklogService_List(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/worklog/list"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload.IDs) != 2 || payload.IDs[0] != 103 {
+			t.Errorf("Expected ids 103 and 104 in payload, got %v instead", payload.IDs)
+		}
+
+		fmt.Fprint(w, `[{"id":"103","issueId":"10002","timeSpent":"3h 20m","timeSpentSeconds":12000},{"id":"104","issueId":"10002","timeSpent":"1h","timeSpentSeconds":3600}]`)
+	})
+
+	worklogs, _, err := testClient.Worklog.List([]int{103, 104})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(worklogs) != 2 || worklogs[1].TimeSpentSeconds != 3600 {
+		t.Errorf("Expected 2 worklogs. Got %+v", worklogs)
+	}
+}